@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/keep-network/keep-common/pkg/logging"
+	"github.com/keep-network/keep-ecdsa/config"
+	"github.com/keep-network/keep-ecdsa/pkg/attribution"
+
+	"github.com/urfave/cli"
+)
+
+// AttributionCommand contains the definition of the `attribution`
+// command-line subcommand and its own subcommands.
+var AttributionCommand cli.Command
+
+const attributionReportDescription = `Summarizes failed key generation and
+	signing attempts recorded by the client, per operator the failures are
+	attributed to. An operator is a culprit if the protocol identified it as
+	misbehaving, e.g. sending messages with invalid proofs. An operator is
+	missing if the protocol timed out still waiting for its messages.
+	Failures can be limited to a single keep.`
+
+func init() {
+	AttributionCommand = cli.Command{
+		Name:  "attribution",
+		Usage: "Provides access to failures attributed to keep members",
+		Before: func(c *cli.Context) error {
+			// disable the regular logger
+			_ = logging.Configure("keep*=fatal")
+			return nil
+		},
+		Subcommands: []cli.Command{
+			{
+				Name:        "report",
+				Usage:       "Summarizes protocol failures per operator",
+				Description: attributionReportDescription,
+				Action:      AttributionReport,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "keep,k",
+						Usage: "include only failures of the keep with the given address",
+					},
+					cli.StringFlag{
+						Name:  "format,f",
+						Value: "csv",
+						Usage: "output format: csv or json",
+					},
+					cli.StringFlag{
+						Name:  "output-file,o",
+						Usage: "output file for the report",
+					},
+				},
+			},
+		},
+	}
+}
+
+// AttributionReport summarizes protocol failures recorded by the operator.
+func AttributionReport(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	attributionStore, err := attribution.Open(config.Storage.DataDir)
+	if err != nil {
+		return fmt.Errorf("could not open attribution store: [%v]", err)
+	}
+
+	failures, err := attributionStore.AllFailures()
+	if err != nil {
+		return fmt.Errorf("could not read attribution store: [%v]", err)
+	}
+
+	if keepID := c.String("keep"); len(keepID) > 0 {
+		keepFailures := []*attribution.Failure{}
+		for _, failure := range failures {
+			if strings.EqualFold(failure.KeepID, keepID) {
+				keepFailures = append(keepFailures, failure)
+			}
+		}
+		failures = keepFailures
+	}
+
+	summaries := attribution.SummarizeByOperator(failures)
+
+	report := &bytes.Buffer{}
+	switch format := c.String("format"); format {
+	case "csv":
+		err = attribution.WriteCSV(report, summaries)
+	case "json":
+		err = attribution.WriteJSON(report, summaries)
+	default:
+		return fmt.Errorf("unsupported output format: [%v]", format)
+	}
+	if err != nil {
+		return fmt.Errorf("could not write report: [%v]", err)
+	}
+
+	return outputData(c, report.Bytes(), 0644)
+}
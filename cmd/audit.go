@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/keep-network/keep-common/pkg/logging"
+	"github.com/keep-network/keep-ecdsa/config"
+	"github.com/keep-network/keep-ecdsa/pkg/audit"
+
+	"github.com/urfave/cli"
+)
+
+// AuditCommand contains the definition of the `audit` command-line subcommand
+// and its own subcommands.
+var AuditCommand cli.Command
+
+const auditVerifyDescription = `Verifies the audit log of signatures produced
+	with key shares of the operator. Each entry of the log holds the hash of
+	the previous entry; the command fails pointing to the first entry breaking
+	the chain of hashes if any entry has been removed, modified or reordered.`
+
+const auditExportDescription = `Exports signatures recorded in the audit log,
+	e.g. for compliance reviews. The chain of hashes of the log is verified
+	before the export. Signatures can be limited to a single keep.`
+
+func init() {
+	AuditCommand = cli.Command{
+		Name:  "audit",
+		Usage: "Provides access to the audit log of produced signatures",
+		Before: func(c *cli.Context) error {
+			// disable the regular logger
+			_ = logging.Configure("keep*=fatal")
+			return nil
+		},
+		Subcommands: []cli.Command{
+			{
+				Name:        "verify",
+				Usage:       "Verifies the chain of hashes of the audit log",
+				Description: auditVerifyDescription,
+				Action:      AuditVerify,
+			},
+			{
+				Name:        "export",
+				Usage:       "Exports signatures recorded in the audit log",
+				Description: auditExportDescription,
+				Action:      AuditExport,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "keep,k",
+						Usage: "include only signatures of the keep with the given address",
+					},
+					cli.StringFlag{
+						Name:  "format,f",
+						Value: "csv",
+						Usage: "output format: csv or json",
+					},
+					cli.StringFlag{
+						Name:  "output-file,o",
+						Usage: "output file for the export",
+					},
+				},
+			},
+		},
+	}
+}
+
+// AuditVerify verifies the audit log of the operator.
+func AuditVerify(c *cli.Context) error {
+	auditLog, err := openAuditLog(c)
+	if err != nil {
+		return err
+	}
+
+	count, err := auditLog.Verify()
+	if err != nil {
+		return fmt.Errorf("audit log verification failed: [%v]", err)
+	}
+
+	fmt.Printf("audit log verified; [%d] entries\n", count)
+
+	return nil
+}
+
+// AuditExport exports signatures recorded in the audit log of the operator.
+func AuditExport(c *cli.Context) error {
+	auditLog, err := openAuditLog(c)
+	if err != nil {
+		return err
+	}
+
+	entries, err := auditLog.Entries()
+	if err != nil {
+		return fmt.Errorf("could not read audit log: [%v]", err)
+	}
+
+	if keepID := c.String("keep"); len(keepID) > 0 {
+		keepEntries := []*audit.Entry{}
+		for _, entry := range entries {
+			if strings.EqualFold(entry.KeepID, keepID) {
+				keepEntries = append(keepEntries, entry)
+			}
+		}
+		entries = keepEntries
+	}
+
+	export := &bytes.Buffer{}
+	switch format := c.String("format"); format {
+	case "csv":
+		err = audit.WriteCSV(export, entries)
+	case "json":
+		err = audit.WriteJSON(export, entries)
+	default:
+		return fmt.Errorf("unsupported output format: [%v]", format)
+	}
+	if err != nil {
+		return fmt.Errorf("could not write export: [%v]", err)
+	}
+
+	return outputData(c, export.Bytes(), 0644)
+}
+
+func openAuditLog(c *cli.Context) (*audit.Log, error) {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return nil, fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	auditLog, err := audit.Open(config.Storage.DataDir)
+	if err != nil {
+		return nil, fmt.Errorf("could not open audit log: [%v]", err)
+	}
+
+	return auditLog, nil
+}
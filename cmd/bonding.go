@@ -0,0 +1,319 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum"
+	"github.com/keep-network/keep-common/pkg/logging"
+	"github.com/keep-network/keep-ecdsa/config"
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+
+	"github.com/urfave/cli"
+)
+
+// BondingCommand contains the definition of the `bonding` command-line
+// subcommand and its own subcommands.
+var BondingCommand cli.Command
+
+const bondingDescription = `Manages the operator's value available for bonds in
+	keeps opened by the bonded ECDSA keep factory. The value is held by the
+	KeepBonding contract whose address has to be configured alongside the
+	BondedECDSAKeepFactory address under ContractAddresses. Commands operate
+	on the chain configured in the top level sections of the config.`
+
+const bondingStatusDescription = `Shows the operator's unbonded value, authorizer
+	and beneficiary. For the tBTC application and each sanctioned application,
+	shows whether the application's signers' pool is authorized to use the
+	operator's bonds, the minimum bond required by the application's signers'
+	pool and how much unbonded value available for the application is
+	missing to meet it.`
+
+const bondingDepositDescription = `Deposits the given value from the operator's
+	account as the operator's unbonded value. The value can be given in wei,
+	Gwei or ether, e.g. "20 ether"; wei is the default unit.`
+
+const bondingWithdrawDescription = `Withdraws the given value of the operator's
+	unbonded value. The withdrawn value is transferred to the operator's
+	beneficiary. The value can be given in wei, Gwei or ether, e.g.
+	"20 ether"; wei is the default unit.`
+
+const bondingAuthorizePoolDescription = `Authorizes the signers' pool of the
+	application with the given address to use the operator's bonds. If no
+	address is given, the signers' pool of the tBTC application is authorized.
+	Pools can be authorized only by the operator's authorizer, so the command
+	succeeds only if the operator is its own authorizer.`
+
+func init() {
+	BondingCommand = cli.Command{
+		Name:        "bonding",
+		Usage:       "Manages the operator's value available for bonds",
+		Description: bondingDescription,
+		Before: func(c *cli.Context) error {
+			// disable the regular logger
+			_ = logging.Configure("keep*=fatal")
+			return nil
+		},
+		Subcommands: []cli.Command{
+			{
+				Name:        "status",
+				Usage:       "Shows the operator's unbonded value and authorized pools",
+				Description: bondingStatusDescription,
+				Action:      BondingStatus,
+			},
+			{
+				Name:        "deposit",
+				Usage:       "Deposits value available for bonds",
+				Description: bondingDepositDescription,
+				ArgsUsage:   "[value]",
+				Action:      BondingDeposit,
+			},
+			{
+				Name:        "withdraw",
+				Usage:       "Withdraws value available for bonds",
+				Description: bondingWithdrawDescription,
+				ArgsUsage:   "[value]",
+				Action:      BondingWithdraw,
+			},
+			{
+				Name:        "authorize-pool",
+				Usage:       "Authorizes an application's signers' pool to use bonds",
+				Description: bondingAuthorizePoolDescription,
+				ArgsUsage:   "[application-address]",
+				Action:      BondingAuthorizePool,
+			},
+		},
+	}
+}
+
+// BondingStatus shows the operator's unbonded value and its availability for
+// applications.
+func BondingStatus(c *cli.Context) error {
+	config, chainHandle, bonding, err := connectBonding(c)
+	if err != nil {
+		return err
+	}
+
+	unbondedValue, err := bonding.UnbondedValue()
+	if err != nil {
+		return fmt.Errorf("could not get unbonded value: [%v]", err)
+	}
+
+	authorizer, err := bonding.Authorizer()
+	if err != nil {
+		return fmt.Errorf("could not get authorizer: [%v]", err)
+	}
+
+	beneficiary, err := bonding.Beneficiary()
+	if err != nil {
+		return fmt.Errorf("could not get beneficiary: [%v]", err)
+	}
+
+	fmt.Printf("operator:       [%s]\n", chainHandle.OperatorID())
+	fmt.Printf("authorizer:     [%s]\n", authorizer)
+	fmt.Printf("beneficiary:    [%s]\n", beneficiary)
+	fmt.Printf("unbonded value: [%v] wei\n", unbondedValue)
+
+	applicationIDs, err := sanctionedApplicationIDs(chainHandle, config, 0)
+	if err != nil {
+		return err
+	}
+	if tbtcHandle, err := chainHandle.TBTCApplicationHandle(); err == nil {
+		applicationIDs = append(applicationIDs, tbtcHandle.ID())
+	}
+
+	for _, applicationID := range applicationIDs {
+		fmt.Printf("\napplication [%s]:\n", applicationID)
+
+		application, err := chainHandle.ApplicationHandle(applicationID)
+		if err != nil {
+			fmt.Printf("  could not look up application: [%v]\n", err)
+			continue
+		}
+
+		isAuthorized, err := bonding.IsSortitionPoolAuthorized(applicationID)
+		if err != nil {
+			fmt.Printf("  could not check pool authorization: [%v]\n", err)
+			continue
+		}
+
+		minimumBond, err := application.MinimumBond()
+		if err != nil {
+			fmt.Printf("  could not get minimum bond: [%v]\n", err)
+			continue
+		}
+
+		availableUnbondedValue, err := application.AvailableUnbondedValue()
+		if err != nil {
+			fmt.Printf("  could not get available unbonded value: [%v]\n", err)
+			continue
+		}
+
+		missingUnbondedValue := new(big.Int).Sub(
+			minimumBond,
+			availableUnbondedValue,
+		)
+		if missingUnbondedValue.Sign() < 0 {
+			missingUnbondedValue.SetInt64(0)
+		}
+
+		fmt.Printf("  signers' pool authorized: [%v]\n", isAuthorized)
+		fmt.Printf("  minimum bond:             [%v] wei\n", minimumBond)
+		fmt.Printf("  available unbonded value: [%v] wei\n", availableUnbondedValue)
+		fmt.Printf("  missing unbonded value:   [%v] wei\n", missingUnbondedValue)
+	}
+
+	return nil
+}
+
+// BondingDeposit deposits the given value as the operator's unbonded value.
+func BondingDeposit(c *cli.Context) error {
+	value, err := parseBondingValue(c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	_, _, bonding, err := connectBonding(c)
+	if err != nil {
+		return err
+	}
+
+	if err := bonding.Deposit(value); err != nil {
+		return fmt.Errorf("could not deposit unbonded value: [%v]", err)
+	}
+
+	fmt.Printf("submitted deposit of [%v] wei\n", value)
+
+	return nil
+}
+
+// BondingWithdraw withdraws the given value of the operator's unbonded value
+// to the operator's beneficiary.
+func BondingWithdraw(c *cli.Context) error {
+	value, err := parseBondingValue(c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	_, _, bonding, err := connectBonding(c)
+	if err != nil {
+		return err
+	}
+
+	unbondedValue, err := bonding.UnbondedValue()
+	if err != nil {
+		return fmt.Errorf("could not get unbonded value: [%v]", err)
+	}
+	if unbondedValue.Cmp(value) < 0 {
+		return fmt.Errorf(
+			"unbonded value [%v] wei is lower than the requested [%v] wei",
+			unbondedValue,
+			value,
+		)
+	}
+
+	if err := bonding.Withdraw(value); err != nil {
+		return fmt.Errorf("could not withdraw unbonded value: [%v]", err)
+	}
+
+	fmt.Printf("submitted withdrawal of [%v] wei\n", value)
+
+	return nil
+}
+
+// BondingAuthorizePool authorizes the signers' pool of the given application
+// to use the operator's bonds.
+func BondingAuthorizePool(c *cli.Context) error {
+	_, chainHandle, bonding, err := connectBonding(c)
+	if err != nil {
+		return err
+	}
+
+	var applicationID chain.ID
+	if c.Args().Present() {
+		applicationID, err = chainHandle.UnmarshalID(c.Args().First())
+		if err != nil {
+			return fmt.Errorf("could not interpret application ID: [%v]", err)
+		}
+	} else {
+		tbtcHandle, err := chainHandle.TBTCApplicationHandle()
+		if err != nil {
+			return fmt.Errorf("could not look up tBTC application: [%v]", err)
+		}
+		applicationID = tbtcHandle.ID()
+	}
+
+	isAuthorized, err := bonding.IsSortitionPoolAuthorized(applicationID)
+	if err != nil {
+		return fmt.Errorf("could not check pool authorization: [%v]", err)
+	}
+	if isAuthorized {
+		fmt.Printf(
+			"signers' pool of application [%s] is already authorized\n",
+			applicationID,
+		)
+		return nil
+	}
+
+	if err := bonding.AuthorizeSortitionPool(applicationID); err != nil {
+		return fmt.Errorf("could not authorize pool: [%v]", err)
+	}
+
+	fmt.Printf(
+		"submitted authorization of signers' pool of application [%s]\n",
+		applicationID,
+	)
+
+	return nil
+}
+
+// connectBonding reads the config, connects to the chain configured in the
+// top level sections of the config and returns the handle of its bonding
+// contract.
+func connectBonding(
+	c *cli.Context,
+) (*config.Config, chain.Handle, chain.BondingHandle, error) {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf(
+			"failed while reading config file: [%v]",
+			err,
+		)
+	}
+
+	chainHandles, _, err := connectChains(context.Background(), config)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	chainHandle := chainHandles[0]
+
+	bonding, err := chainHandle.Bonding()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf(
+			"bonding is not available on chain [%s]: [%v]",
+			chainHandle.Name(),
+			err,
+		)
+	}
+
+	return config, chainHandle, bonding, nil
+}
+
+// parseBondingValue parses the positive value given in wei, Gwei or ether.
+func parseBondingValue(text string) (*big.Int, error) {
+	if len(text) == 0 {
+		return nil, fmt.Errorf("value is required")
+	}
+
+	value := &ethereum.Wei{}
+	if err := value.UnmarshalText([]byte(text)); err != nil {
+		return nil, fmt.Errorf("could not parse value: [%v]", err)
+	}
+
+	if value.Int.Sign() <= 0 {
+		return nil, fmt.Errorf("value must be positive")
+	}
+
+	return value.Int, nil
+}
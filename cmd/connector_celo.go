@@ -10,6 +10,7 @@ import (
 	"github.com/keep-network/keep-ecdsa/config"
 	"github.com/keep-network/keep-ecdsa/pkg/chain"
 	"github.com/keep-network/keep-ecdsa/pkg/chain/celo"
+	"github.com/keep-network/keep-ecdsa/pkg/ledger"
 )
 
 func offlineChain(
@@ -30,10 +31,25 @@ func offlineChain(
 	return celo.Offline(celoKey, &config.Celo), nil
 }
 
-func connectChain(
+// offlineChains returns the offline handle of the Celo chain. Multi-chain mode
+// is not supported on Celo, so exactly one chain handle is returned.
+func offlineChains(
+	config *config.Config,
+) ([]chain.OfflineHandle, error) {
+	chainHandle, err := offlineChain(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return []chain.OfflineHandle{chainHandle}, nil
+}
+
+// connectChains connects to the Celo chain. Multi-chain mode is not supported
+// on Celo, so exactly one chain handle is returned.
+func connectChains(
 	ctx context.Context,
 	config *config.Config,
-) (chain.Handle, *operatorKeys, error) {
+) ([]chain.Handle, *operatorKeys, error) {
 	celoKey, err := celoutil.DecryptKeyFile(
 		config.Celo.Account.KeyFile,
 		config.Celo.Account.KeyFilePassword,
@@ -46,7 +62,30 @@ func connectChain(
 		)
 	}
 
-	celoChain, err := celo.Connect(ctx, celoKey, &config.Celo)
+	// Celo block headers and transactions differ from the EVM ones, so Celo
+	// can not be configured as an EVM network.
+	if config.Network != (chain.NetworkConfig{}) {
+		return nil, nil, fmt.Errorf("network configuration is not supported on celo")
+	}
+	if len(config.Chains) > 0 {
+		return nil, nil, fmt.Errorf("multi-chain mode is not supported on celo")
+	}
+
+	transactionLedger, err := ledger.Open(config.Storage.DataDir)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to open transaction ledger: [%v]",
+			err,
+		)
+	}
+
+	celoChain, err := celo.Connect(
+		ctx,
+		celoKey,
+		&config.Celo,
+		&config.TransactionUrgency,
+		transactionLedger,
+	)
 	if err != nil {
 		return nil, nil, fmt.Errorf(
 			"failed to connect to celo node: [%v]",
@@ -59,7 +98,7 @@ func connectChain(
 		private: celoKey.PrivateKey,
 	}
 
-	return celoChain, operatorKeys, nil
+	return []chain.Handle{celoChain}, operatorKeys, nil
 }
 
 func extractKeyFilePassword(config *config.Config) string {
@@ -6,15 +6,66 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
 	"github.com/keep-network/keep-ecdsa/config"
 	"github.com/keep-network/keep-ecdsa/pkg/chain"
 	"github.com/keep-network/keep-ecdsa/pkg/chain/ethereum"
+	"github.com/keep-network/keep-ecdsa/pkg/ledger"
 )
 
 func offlineChain(
 	config *config.Config,
 ) (chain.OfflineHandle, error) {
+	ethereumKey, err := decryptOperatorKey(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return ethereum.Offline(
+		ethereumKey,
+		&config.Ethereum,
+		&config.Network,
+	), nil
+}
+
+// offlineChains returns offline handles of the Ethereum or other EVM network
+// configured in the top level sections of the config and of all additional
+// networks configured in the multi-chain mode. The handle of the top level
+// network is returned first.
+func offlineChains(
+	config *config.Config,
+) ([]chain.OfflineHandle, error) {
+	if err := config.ValidateChains(); err != nil {
+		return nil, err
+	}
+
+	ethereumKey, err := decryptOperatorKey(config)
+	if err != nil {
+		return nil, err
+	}
+
+	chainHandles := []chain.OfflineHandle{
+		ethereum.Offline(ethereumKey, &config.Ethereum, &config.Network),
+	}
+
+	for i := range config.Chains {
+		chainConfig := &config.Chains[i]
+
+		chainHandles = append(
+			chainHandles,
+			ethereum.Offline(
+				ethereumKey,
+				&chainConfig.Ethereum,
+				&chainConfig.Network,
+			),
+		)
+	}
+
+	return chainHandles, nil
+}
+
+func decryptOperatorKey(config *config.Config) (*keystore.Key, error) {
 	ethereumKey, err := ethutil.DecryptKeyFile(
 		config.Ethereum.Account.KeyFile,
 		config.Ethereum.Account.KeyFilePassword,
@@ -27,23 +78,24 @@ func offlineChain(
 		)
 	}
 
-	return ethereum.Offline(ethereumKey, &config.Ethereum), nil
+	return ethereumKey, nil
 }
 
-func connectChain(
+// connectChains connects to the Ethereum or other EVM network configured in
+// the top level sections of the config and to all additional networks
+// configured in the multi-chain mode. The handle of the top level network is
+// returned first. The operator key is shared by all networks.
+func connectChains(
 	ctx context.Context,
 	config *config.Config,
-) (chain.Handle, *operatorKeys, error) {
-	ethereumKey, err := ethutil.DecryptKeyFile(
-		config.Ethereum.Account.KeyFile,
-		config.Ethereum.Account.KeyFilePassword,
-	)
+) ([]chain.Handle, *operatorKeys, error) {
+	if err := config.ValidateChains(); err != nil {
+		return nil, nil, err
+	}
+
+	ethereumKey, err := decryptOperatorKey(config)
 	if err != nil {
-		return nil, nil, fmt.Errorf(
-			"failed to read key file [%s]: [%v]",
-			config.Ethereum.Account.KeyFile,
-			err,
-		)
+		return nil, nil, err
 	}
 
 	// DEPRECATED: config.Ethereum.ContractAddresses is the correct container
@@ -81,11 +133,11 @@ func connectChain(
 	}
 
 	// DEPRECATED: config.Ethereum.ContractAddresses is the correct container
-	// for the TBTCSystem address from now on; read SanctionedApplications and
-	// assume it has a single entry that is TBTCSystem, warn if
-	// SanctionedApplications needs to be used.
+	// for the TBTCSystem address from now on; if it is not set, assume the
+	// first of SanctionedApplications is TBTCSystem, as it used to be, and
+	// warn.
 	applicationAddresses := config.SanctionedApplications.AddressesStrings
-	if len(applicationAddresses) != 0 {
+	if !exists && len(applicationAddresses) != 0 {
 		logger.Warn(
 			"TBTCSystem address configuration in SanctionedApplications.Addresses " +
 				"is DEPRECATED and will be removed. Please configure the " +
@@ -93,28 +145,25 @@ func connectChain(
 				"Ethereum.ContractAddresses.",
 		)
 
-		if !exists {
-			config.Ethereum.ContractAddresses[ethereum.TBTCSystemContractName] =
-				applicationAddresses[0]
-		} else {
-			if config.Ethereum.ContractAddresses[ethereum.TBTCSystemContractName] !=
-				applicationAddresses[0] {
-				panic(
-					"Configured TBTCSystem contract and SanctionedApplications list " +
-						"do not match. Failing to boot to avoid misconfiguration. " +
-						"Please ensure ethereum.ContractAddresses." +
-						ethereum.TBTCSystemContractName + "is set to the correct " +
-						"tBTC system contract and remove SanctionedApplications " +
-						"configuration list, then try starting again.",
-				)
-			}
-		}
+		config.Ethereum.ContractAddresses[ethereum.TBTCSystemContractName] =
+			applicationAddresses[0]
+	}
+
+	transactionLedger, err := ledger.Open(config.Storage.DataDir)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to open transaction ledger: [%v]",
+			err,
+		)
 	}
 
 	ethereumChain, err := ethereum.Connect(
 		ctx,
 		ethereumKey,
 		&config.Ethereum,
+		&config.Network,
+		&config.TransactionUrgency,
+		transactionLedger,
 	)
 	if err != nil {
 		return nil, nil, fmt.Errorf(
@@ -123,12 +172,36 @@ func connectChain(
 		)
 	}
 
+	chainHandles := []chain.Handle{ethereumChain}
+
+	for i := range config.Chains {
+		chainConfig := &config.Chains[i]
+
+		chainHandle, err := ethereum.Connect(
+			ctx,
+			ethereumKey,
+			&chainConfig.Ethereum,
+			&chainConfig.Network,
+			&chainConfig.TransactionUrgency,
+			transactionLedger,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf(
+				"failed to connect to [%s] node: [%v]",
+				chainConfig.Network.Name,
+				err,
+			)
+		}
+
+		chainHandles = append(chainHandles, chainHandle)
+	}
+
 	operatorKeys := &operatorKeys{
 		public:  &ethereumKey.PrivateKey.PublicKey,
 		private: ethereumKey.PrivateKey,
 	}
 
-	return ethereumChain, operatorKeys, nil
+	return chainHandles, operatorKeys, nil
 }
 
 func extractKeyFilePassword(config *config.Config) string {
@@ -5,10 +5,12 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/keep-network/keep-common/pkg/persistence"
 	"github.com/keep-network/keep-core/pkg/operator"
+	"github.com/keep-network/keep-ecdsa/config"
 	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
 )
 
 func nodeHeader(addrStrings []string, port int) {
@@ -78,25 +80,126 @@ func buildMultiLine(lineLength int, prefix, suffix, startPrefix string, lines []
 	return combinedLines
 }
 
-func buildPersistenceHandle(
+// storagePassword returns the password encrypting key shares. Unless
+// a dedicated storage password is configured, key shares are encrypted with
+// the chain key file password.
+func storagePassword(config *config.Config) string {
+	if len(config.Storage.Password) > 0 {
+		return config.Storage.Password
+	}
+
+	return extractKeyFilePassword(config)
+}
+
+// Storage backends of key shares which can be set in the storage
+// configuration.
+const (
+	diskStorageBackend = "disk"
+	boltStorageBackend = "bolt"
+)
+
+// buildKeepsStorage creates the storage of key shares of the given chain with
+// the backend selected in the storage configuration. The storage should be
+// released with closeKeepsStorage once it is no longer used.
+func buildKeepsStorage(
+	chainHandle chain.OfflineHandle,
+	password string,
+	storageConfig config.Storage,
+) (registry.Storage, error) {
+	storageDir, err := chainStorageDir(chainHandle, storageConfig.DataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	switch storageConfig.Backend {
+	case "", diskStorageBackend:
+		storage, err := registry.NewDiskStorage(storageDir, password)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed while creating a storage disk handler: [%v]",
+				err,
+			)
+		}
+
+		return storage, nil
+	case boltStorageBackend:
+		storage, err := registry.NewBoltStorage(storageDir, password)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed while opening a storage database: [%v]",
+				err,
+			)
+		}
+
+		return storage, nil
+	default:
+		return nil, fmt.Errorf(
+			"unsupported storage backend: [%v]; expected one of: [%v, %v]",
+			storageConfig.Backend,
+			diskStorageBackend,
+			boltStorageBackend,
+		)
+	}
+}
+
+// buildPrunePolicy creates the policy of pruning archived key shares of
+// the given chain configured in the storage configuration. It returns nil if
+// archived key shares are retained forever.
+func buildPrunePolicy(
+	chainHandle chain.OfflineHandle,
+	storageConfig config.Storage,
+) (*registry.PrunePolicy, error) {
+	if storageConfig.ArchiveRetentionDays <= 0 {
+		return nil, nil
+	}
+
+	storageDir, err := chainStorageDir(chainHandle, storageConfig.DataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	pruneLog, err := registry.OpenPruneLog(storageDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed while opening the prune log: [%v]", err)
+	}
+
+	return &registry.PrunePolicy{
+		Retention: time.Duration(storageConfig.ArchiveRetentionDays) *
+			24 * time.Hour,
+		Log: pruneLog,
+	}, nil
+}
+
+// closeKeepsStorage releases the storage of key shares if its backend holds
+// any resources.
+func closeKeepsStorage(storage registry.Storage) {
+	if boltStorage, ok := storage.(*registry.BoltStorage); ok {
+		if err := boltStorage.Close(); err != nil {
+			logger.Errorf("failed to close storage database: [%v]", err)
+		}
+	}
+}
+
+// chainStorageDir returns the directory in the data directory storing key
+// shares of the given chain.
+func chainStorageDir(
 	chainHandle chain.OfflineHandle,
-	keyFilePassword string,
 	dataDir string,
-) (persistence.Handle, error) {
+) (string, error) {
 	// Validate chain name to avoid issues with persistence later.
 	validChainName, err := regexp.MatchString(
 		"^[a-z][a-z0-9-_]*$",
 		chainHandle.Name(),
 	)
 	if err != nil {
-		return nil, fmt.Errorf(
+		return "", fmt.Errorf(
 			"failed to verify chain name [%v]: [%v]",
 			chainHandle.Name(),
 			err,
 		)
 	}
 	if !validChainName {
-		return nil, fmt.Errorf(
+		return "", fmt.Errorf(
 			"invalid chain name: [%v]; chain name must start with a lowercase "+
 				"letter and then consist solely of lowercase letters, numbers, "+
 				" -, or _",
@@ -113,22 +216,12 @@ func buildPersistenceHandle(
 	// Ethereum addresses, the validation above requiring a starting letter
 	// ensures there will be no clashes with existing Ethereum address
 	// directories.
-	diskPersistencePath := dataDir
+	storageDir := dataDir
 	if chainHandle.Name() != "ethereum" {
-		diskPersistencePath += "/" + strings.ToLower(chainHandle.Name())
-	}
-	handle, err := persistence.NewDiskHandle(diskPersistencePath)
-	if err != nil {
-		return nil, fmt.Errorf(
-			"failed while creating a storage disk handler: [%v]",
-			err,
-		)
+		storageDir += "/" + strings.ToLower(chainHandle.Name())
 	}
 
-	return persistence.NewEncryptedPersistence(
-		handle,
-		keyFilePassword,
-	), nil
+	return storageDir, nil
 }
 
 type operatorKeys struct {
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/keep-network/keep-common/pkg/logging"
+	"github.com/keep-network/keep-ecdsa/config"
+	"github.com/keep-network/keep-ecdsa/pkg/ledger"
+
+	"github.com/urfave/cli"
+)
+
+// LedgerCommand contains the definition of the `ledger` command-line
+// subcommand and its own subcommands.
+var LedgerCommand cli.Command
+
+const ledgerReportDescription = `Summarizes gas and fees spent on transactions
+	submitted by the client, as recorded in the local transaction ledger.
+	Transactions can be grouped per keep or per operation and limited to
+	a time range. Fees are reported in the smallest unit of the chain's native
+	token (e.g. wei). Fees of reverted transactions, e.g. signature submissions
+	that lost the race with other keep members, are reported as wasted.`
+
+func init() {
+	LedgerCommand = cli.Command{
+		Name:  "ledger",
+		Usage: "Provides access to the local transaction ledger",
+		Before: func(c *cli.Context) error {
+			// disable the regular logger
+			_ = logging.Configure("keep*=fatal")
+			return nil
+		},
+		Subcommands: []cli.Command{
+			{
+				Name:        "report",
+				Usage:       "Summarizes gas and fees spent on chain submissions",
+				Description: ledgerReportDescription,
+				Action:      LedgerReport,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "group-by,g",
+						Value: string(ledger.GroupByKeep),
+						Usage: "grouping of the report: keep or operation",
+					},
+					cli.StringFlag{
+						Name:  "format,f",
+						Value: "csv",
+						Usage: "output format: csv or json",
+					},
+					cli.StringFlag{
+						Name:  "from",
+						Usage: "include transactions submitted at or after the given RFC 3339 time",
+					},
+					cli.StringFlag{
+						Name:  "to",
+						Usage: "include transactions submitted before the given RFC 3339 time",
+					},
+					cli.StringFlag{
+						Name:  "output-file,o",
+						Usage: "output file for the report",
+					},
+				},
+			},
+		},
+	}
+}
+
+// LedgerReport summarizes the transaction ledger of the operator.
+func LedgerReport(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	timeRange := ledger.TimeRange{}
+	if from := c.String("from"); len(from) > 0 {
+		timeRange.From, err = time.Parse(time.RFC3339, from)
+		if err != nil {
+			return fmt.Errorf("could not parse from time: [%v]", err)
+		}
+	}
+	if to := c.String("to"); len(to) > 0 {
+		timeRange.To, err = time.Parse(time.RFC3339, to)
+		if err != nil {
+			return fmt.Errorf("could not parse to time: [%v]", err)
+		}
+	}
+
+	transactionLedger, err := ledger.Open(config.Storage.DataDir)
+	if err != nil {
+		return fmt.Errorf("could not open transaction ledger: [%v]", err)
+	}
+
+	entries, err := transactionLedger.Entries()
+	if err != nil {
+		return fmt.Errorf("could not read transaction ledger: [%v]", err)
+	}
+
+	summaries, err := ledger.Summarize(
+		entries,
+		ledger.GroupBy(c.String("group-by")),
+		timeRange,
+	)
+	if err != nil {
+		return err
+	}
+
+	report := &bytes.Buffer{}
+	switch format := c.String("format"); format {
+	case "csv":
+		err = ledger.WriteCSV(report, summaries)
+	case "json":
+		err = ledger.WriteJSON(report, summaries)
+	default:
+		return fmt.Errorf("unsupported output format: [%v]", format)
+	}
+	if err != nil {
+		return fmt.Errorf("could not write report: [%v]", err)
+	}
+
+	return outputData(c, report.Bytes(), 0644)
+}
@@ -0,0 +1,522 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/keep-network/keep-common/pkg/logging"
+	"github.com/keep-network/keep-ecdsa/config"
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+
+	"github.com/urfave/cli"
+)
+
+// RegistryCommand contains the definition of the `registry` command-line
+// subcommand and its own subcommands.
+var RegistryCommand cli.Command
+
+const registryMigrateDescription = `Rewrites key shares of the operator stored
+	with an outdated format version using the current format version. Before
+	a key share is overwritten, its original content is stored as a snapshot.
+	Key shares of archived keeps are not rewritten. The client migrates key
+	shares in memory whenever it loads them, so the command is not required
+	for the client to work, but it guarantees key shares remain readable by
+	future client versions which may drop migrations of old format versions.
+	Key shares are migrated on all configured chains.
+
+	The client must not be running while key shares are migrated.`
+
+const registryPruneDescription = `Destroys archived key shares of the operator
+	whose retention period configured as Storage.ArchiveRetentionDays has
+	passed. Archived key shares of closed keeps are retained for the period
+	after the keep has been archived. Archived key shares of terminated keeps,
+	keeps found inactive and keeps archived by older client versions are
+	retained until funds held by the keep are confirmed to be recovered with
+	the confirm-recovery command and for the period after.
+
+	Snapshots of the key shares are destroyed as well. Key shares are recorded
+	in the prune_log.jsonl file of the storage directory before they are
+	destroyed; each record holds the hash of the previous record, so removed
+	or modified records can be detected. Each key share file or database entry
+	is overwritten before it is removed. Archived key shares are pruned on all
+	configured chains.
+
+	The client prunes archived key shares itself once a day. The client must
+	not be running while key shares are pruned with the command.`
+
+const registryConfirmRecoveryDescription = `Confirms funds held by the archived
+	keep with the given address have been recovered, so that archived key
+	shares of the keep can be destroyed once the retention period configured
+	as Storage.ArchiveRetentionDays passes. Confirmation is not needed for
+	closed keeps. The keep is looked up on the chain with the network name
+	given with the --chain flag, or on the chain configured in the top level
+	sections of the config if the flag is not set.
+
+	The client must not be running while recovery is confirmed.`
+
+const registryDestroyImportedDescription = `Destroys key share files of
+	the operator which have been imported to the database of the "bolt"
+	storage backend. A file is destroyed only if the database holds the same
+	key share. Files not found in the database are left in place and listed.
+	The client leaves imported files in place, so that the "disk" storage
+	backend can be used again until the files are destroyed. Imported files
+	are destroyed on all configured chains holding a database.
+
+	The client must not be running while imported files are destroyed.`
+
+const registryExportDescription = `Exports key shares of the operator stored
+	in the database of the "bolt" storage backend to files of the "disk"
+	storage backend, including snapshots and archived keeps. The export
+	requires no key share files to be left in the data directory; files
+	imported to the database have to be destroyed with the destroy-imported
+	command first. Once all key shares have been exported and verified,
+	the database is destroyed and the client can be started with the "disk"
+	storage backend. Key shares are exported on all configured chains holding
+	a database.
+
+	The client must not be running while key shares are exported.`
+
+func init() {
+	RegistryCommand = cli.Command{
+		Name:  "registry",
+		Usage: "Provides tools to maintain key shares stored by the client",
+		Before: func(c *cli.Context) error {
+			// disable the regular logger
+			_ = logging.Configure("keep*=fatal")
+			return nil
+		},
+		Subcommands: []cli.Command{
+			{
+				Name:        "migrate",
+				Usage:       "Rewrites key shares with the current format version",
+				Description: registryMigrateDescription,
+				Action:      RegistryMigrate,
+			},
+			{
+				Name:        "prune",
+				Usage:       "Destroys archived key shares whose retention passed",
+				Description: registryPruneDescription,
+				Action:      RegistryPrune,
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "dry-run",
+						Usage: "only show when archived key shares expire",
+					},
+				},
+			},
+			{
+				Name:        "confirm-recovery",
+				Usage:       "Confirms funds held by an archived keep have been recovered",
+				Description: registryConfirmRecoveryDescription,
+				ArgsUsage:   "[keep-address]",
+				Action:      RegistryConfirmRecovery,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name: "chain",
+						Usage: "network name of the chain of the keep; " +
+							"defaults to the chain configured in the top " +
+							"level sections of the config",
+					},
+				},
+			},
+			{
+				Name:        "destroy-imported",
+				Usage:       "Destroys key share files imported to the database",
+				Description: registryDestroyImportedDescription,
+				Action:      RegistryDestroyImported,
+			},
+			{
+				Name:        "export",
+				Usage:       "Exports key shares from the database to files",
+				Description: registryExportDescription,
+				Action:      RegistryExport,
+			},
+		},
+	}
+}
+
+// RegistryMigrate rewrites key shares of the operator stored with an outdated
+// format version on all configured chains.
+func RegistryMigrate(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	chainHandles, err := offlineChains(config)
+	if err != nil {
+		return err
+	}
+
+	failedCount := 0
+	for _, chainHandle := range chainHandles {
+		migratedCount, errs, err := migrateChainRegistry(chainHandle, config)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to migrate key shares on chain [%s]: [%v]",
+				chainHandle.Name(),
+				err,
+			)
+		}
+
+		fmt.Printf(
+			"migrated [%d] key shares on chain [%s] to format version [%d]\n",
+			migratedCount,
+			chainHandle.Name(),
+			tss.SignerFormatVersion,
+		)
+
+		for _, err := range errs {
+			fmt.Printf("%v\n", err)
+		}
+
+		failedCount += len(errs)
+	}
+
+	if failedCount > 0 {
+		return fmt.Errorf("failed to migrate [%d] key shares", failedCount)
+	}
+
+	return nil
+}
+
+func migrateChainRegistry(
+	chainHandle chain.OfflineHandle,
+	config *config.Config,
+) (int, []error, error) {
+	keepsStorage, err := buildKeepsStorage(
+		chainHandle,
+		storagePassword(config),
+		config.Storage,
+	)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer closeKeepsStorage(keepsStorage)
+
+	keepRegistry := registry.NewKeepsRegistryWithStorage(
+		keepsStorage,
+		chainHandle.UnmarshalID,
+	)
+
+	migratedCount, errs := keepRegistry.MigrateSigners(chainHandle.Name())
+
+	return migratedCount, errs, nil
+}
+
+// RegistryPrune destroys archived key shares of the operator whose retention
+// period passed on all configured chains.
+func RegistryPrune(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	if config.Storage.ArchiveRetentionDays <= 0 {
+		return fmt.Errorf(
+			"retention of archived key shares is not configured; " +
+				"set Storage.ArchiveRetentionDays",
+		)
+	}
+
+	chainHandles, err := offlineChains(config)
+	if err != nil {
+		return err
+	}
+
+	failedCount := 0
+	for _, chainHandle := range chainHandles {
+		errs, err := pruneChainRegistry(chainHandle, config, c.Bool("dry-run"))
+		if err != nil {
+			return fmt.Errorf(
+				"failed to prune archived keeps on chain [%s]: [%v]",
+				chainHandle.Name(),
+				err,
+			)
+		}
+
+		for _, err := range errs {
+			fmt.Printf("%v\n", err)
+		}
+
+		failedCount += len(errs)
+	}
+
+	if failedCount > 0 {
+		return fmt.Errorf("failed to prune [%d] archived keeps", failedCount)
+	}
+
+	return nil
+}
+
+func pruneChainRegistry(
+	chainHandle chain.OfflineHandle,
+	config *config.Config,
+	dryRun bool,
+) ([]error, error) {
+	prunePolicy, err := buildPrunePolicy(chainHandle, config.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	keepsStorage, err := buildKeepsStorage(
+		chainHandle,
+		storagePassword(config),
+		config.Storage,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer closeKeepsStorage(keepsStorage)
+
+	keepRegistry := registry.NewKeepsRegistryWithStorage(
+		keepsStorage,
+		chainHandle.UnmarshalID,
+	)
+
+	if dryRun {
+		archivedKeeps, err := keepRegistry.ArchivedKeeps()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read archived keeps: [%v]", err)
+		}
+
+		for _, archivedKeep := range archivedKeeps {
+			expiresAt, expires := archivedKeep.ExpiresAt(prunePolicy.Retention)
+			if !expires {
+				fmt.Printf(
+					"keep [%s] on chain [%s] archived as [%s]: retained "+
+						"until recovery is confirmed\n",
+					archivedKeep.KeepID,
+					chainHandle.Name(),
+					archivedKeep.Reason,
+				)
+				continue
+			}
+
+			fmt.Printf(
+				"keep [%s] on chain [%s] archived as [%s]: expires at [%s]\n",
+				archivedKeep.KeepID,
+				chainHandle.Name(),
+				archivedKeep.Reason,
+				expiresAt.Format(time.RFC3339),
+			)
+		}
+
+		return nil, nil
+	}
+
+	pruned, errs := keepRegistry.PruneArchives(prunePolicy)
+
+	for _, archivedKeep := range pruned {
+		fmt.Printf(
+			"destroyed archived key shares of keep [%s] on chain [%s] "+
+				"archived as [%s]\n",
+			archivedKeep.KeepID,
+			chainHandle.Name(),
+			archivedKeep.Reason,
+		)
+	}
+
+	fmt.Printf(
+		"pruned [%d] archived keeps on chain [%s]\n",
+		len(pruned),
+		chainHandle.Name(),
+	)
+
+	return errs, nil
+}
+
+// RegistryConfirmRecovery confirms funds held by the archived keep have been
+// recovered. The keep is looked up on the chain selected with the chain flag,
+// or on the chain configured in the top level sections of the config if the
+// flag is not set.
+func RegistryConfirmRecovery(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	chainHandles, err := offlineChains(config)
+	if err != nil {
+		return err
+	}
+
+	chainHandle := chainHandles[0]
+	if chainName := c.String("chain"); len(chainName) > 0 {
+		chainHandle = nil
+		for _, handle := range chainHandles {
+			if handle.Name() == chainName {
+				chainHandle = handle
+				break
+			}
+		}
+
+		if chainHandle == nil {
+			return fmt.Errorf("chain [%s] is not configured", chainName)
+		}
+	}
+
+	keepID, err := chainHandle.UnmarshalID(c.Args().First())
+	if err != nil {
+		return fmt.Errorf("could not interpret keep ID: [%v]", err)
+	}
+
+	keepsStorage, err := buildKeepsStorage(
+		chainHandle,
+		storagePassword(config),
+		config.Storage,
+	)
+	if err != nil {
+		return err
+	}
+	defer closeKeepsStorage(keepsStorage)
+
+	keepRegistry := registry.NewKeepsRegistryWithStorage(
+		keepsStorage,
+		chainHandle.UnmarshalID,
+	)
+
+	if err := keepRegistry.ConfirmRecovery(keepID.String()); err != nil {
+		return fmt.Errorf("failed to confirm recovery: [%v]", err)
+	}
+
+	fmt.Printf(
+		"confirmed recovery of keep [%s] on chain [%s]\n",
+		keepID.String(),
+		chainHandle.Name(),
+	)
+
+	return nil
+}
+
+// RegistryDestroyImported destroys key share files of the operator imported to
+// the database of the bolt storage backend on all configured chains. Chains
+// with no database are skipped.
+func RegistryDestroyImported(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	storageDirs, err := databaseStorageDirs(config)
+	if err != nil {
+		return err
+	}
+
+	for _, storageDir := range storageDirs {
+		destroyed, remaining, err := registry.DestroyImportedFiles(
+			storageDir,
+			storagePassword(config),
+		)
+		for _, path := range destroyed {
+			fmt.Printf(
+				"destroyed imported file [%s]\n",
+				filepath.Join(storageDir, path),
+			)
+		}
+		if err != nil {
+			return fmt.Errorf(
+				"failed to destroy imported files in storage directory "+
+					"[%s]: [%v]",
+				storageDir,
+				err,
+			)
+		}
+
+		for _, path := range remaining {
+			fmt.Printf(
+				"file [%s] is not in the database; left in place\n",
+				filepath.Join(storageDir, path),
+			)
+		}
+
+		fmt.Printf(
+			"destroyed [%d] imported files in storage directory [%s]\n",
+			len(destroyed),
+			storageDir,
+		)
+	}
+
+	return nil
+}
+
+// RegistryExport exports key shares of the operator from the database of
+// the bolt storage backend to files of the disk storage backend on all
+// configured chains. Chains with no database are skipped.
+func RegistryExport(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	storageDirs, err := databaseStorageDirs(config)
+	if err != nil {
+		return err
+	}
+
+	for _, storageDir := range storageDirs {
+		exportedCount, err := registry.ExportBoltStorage(
+			storageDir,
+			storagePassword(config),
+		)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to export key shares in storage directory [%s]: [%v]",
+				storageDir,
+				err,
+			)
+		}
+
+		fmt.Printf(
+			"exported [%d] key shares to storage directory [%s]\n",
+			exportedCount,
+			storageDir,
+		)
+	}
+
+	return nil
+}
+
+// databaseStorageDirs returns storage directories of all configured chains
+// holding the database of the bolt storage backend.
+func databaseStorageDirs(config *config.Config) ([]string, error) {
+	chainHandles, err := offlineChains(config)
+	if err != nil {
+		return nil, err
+	}
+
+	storageDirs := []string{}
+	for _, chainHandle := range chainHandles {
+		storageDir, err := chainStorageDir(chainHandle, config.Storage.DataDir)
+		if err != nil {
+			return nil, err
+		}
+
+		databasePath := filepath.Join(storageDir, registry.BoltDatabaseFileName)
+		if _, err := os.Stat(databasePath); os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf(
+				"could not check database [%s]: [%v]",
+				databasePath,
+				err,
+			)
+		}
+
+		storageDirs = append(storageDirs, storageDir)
+	}
+
+	if len(storageDirs) == 0 {
+		return nil, fmt.Errorf(
+			"no database of the bolt storage backend found in data "+
+				"directory [%s]",
+			config.Storage.DataDir,
+		)
+	}
+
+	return storageDirs, nil
+}
@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum"
+	"github.com/keep-network/keep-common/pkg/logging"
+	"github.com/keep-network/keep-ecdsa/config"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+	"github.com/keep-network/keep-ecdsa/pkg/rewards"
+
+	"github.com/urfave/cli"
+)
+
+// RewardsCommand contains the definition of the `rewards` command-line
+// subcommand and its own subcommands.
+var RewardsCommand cli.Command
+
+const rewardsWithdrawDescription = `Withdraws the operator's ETH rewards held by
+	keeps the operator holds key shares for, including archived keeps and
+	keeps whose archived key shares have been pruned, to the operator's
+	beneficiary. A keep's reward is withdrawn only if it is not lower than
+	the threshold and the estimated fee of the withdrawal is lower than the
+	reward. The threshold defaults to Client.RewardWithdrawalThreshold or to
+	zero if it is not set; it can be given in wei, Gwei or ether, e.g.
+	"0.1 ether". Commands operate on the chain configured in the top level
+	sections of the config.
+
+	The client withdraws rewards itself once a day if
+	Client.RewardWithdrawalThreshold is set.`
+
+func init() {
+	RewardsCommand = cli.Command{
+		Name:  "rewards",
+		Usage: "Manages the operator's ETH rewards held by keeps",
+		Before: func(c *cli.Context) error {
+			// disable the regular logger
+			_ = logging.Configure("keep*=fatal")
+			return nil
+		},
+		Subcommands: []cli.Command{
+			{
+				Name:        "withdraw",
+				Usage:       "Withdraws rewards held by keeps",
+				Description: rewardsWithdrawDescription,
+				Action:      RewardsWithdraw,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "threshold,t",
+						Usage: "minimum reward withdrawn from a keep",
+					},
+					cli.BoolFlag{
+						Name:  "dry-run",
+						Usage: "only show rewards which would be withdrawn",
+					},
+				},
+			},
+		},
+	}
+}
+
+// RewardsWithdraw withdraws the operator's ETH rewards held by keeps to the
+// operator's beneficiary.
+func RewardsWithdraw(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	threshold := big.NewInt(0)
+	if configThreshold := config.Client.GetRewardWithdrawalThreshold(); configThreshold != nil {
+		threshold = configThreshold
+	}
+	if c.IsSet("threshold") {
+		value := &ethereum.Wei{}
+		if err := value.UnmarshalText([]byte(c.String("threshold"))); err != nil {
+			return fmt.Errorf("could not parse threshold: [%v]", err)
+		}
+		threshold = value.Int
+	}
+
+	chainHandles, _, err := connectChains(context.Background(), config)
+	if err != nil {
+		return err
+	}
+	chainHandle := chainHandles[0]
+
+	keepsStorage, err := buildKeepsStorage(
+		chainHandle,
+		storagePassword(config),
+		config.Storage,
+	)
+	if err != nil {
+		return err
+	}
+	defer closeKeepsStorage(keepsStorage)
+
+	keepRegistry := registry.NewKeepsRegistryWithStorage(
+		keepsStorage,
+		chainHandle.UnmarshalID,
+	)
+	keepRegistry.LoadExistingKeeps()
+
+	// Keeps whose archived key shares have been pruned may still hold
+	// rewards; they are read from the prune log.
+	storageDir, err := chainStorageDir(chainHandle, config.Storage.DataDir)
+	if err != nil {
+		return err
+	}
+	pruneLog, err := registry.OpenPruneLog(storageDir)
+	if err != nil {
+		return fmt.Errorf("failed while opening the prune log: [%v]", err)
+	}
+
+	keepIDs, errs := rewards.KeepIDs(chainHandle, keepRegistry, pruneLog)
+
+	results, withdrawalErrs := rewards.Withdraw(
+		chainHandle,
+		keepIDs,
+		threshold,
+		c.Bool("dry-run"),
+	)
+	errs = append(errs, withdrawalErrs...)
+
+	withdrawnCount := 0
+	for _, result := range results {
+		if result.Fee == nil {
+			fmt.Printf(
+				"keep [%s]: reward [%v] wei: %s\n",
+				result.KeepID,
+				result.Balance,
+				result.Outcome,
+			)
+		} else {
+			fmt.Printf(
+				"keep [%s]: reward [%v] wei, estimated fee [%v] wei: %s\n",
+				result.KeepID,
+				result.Balance,
+				result.Fee,
+				result.Outcome,
+			)
+		}
+
+		if result.Outcome == rewards.Withdrawn ||
+			result.Outcome == rewards.WouldWithdraw {
+			withdrawnCount++
+		}
+	}
+
+	if c.Bool("dry-run") {
+		fmt.Printf("would withdraw rewards from [%d] keeps\n", withdrawnCount)
+	} else {
+		fmt.Printf("submitted withdrawals from [%d] keeps\n", withdrawnCount)
+	}
+
+	for _, err := range errs {
+		fmt.Printf("%v\n", err)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to check [%d] keeps", len(errs))
+	}
+
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/keep-network/keep-core/pkg/net/key"
 	"github.com/keep-network/keep-core/pkg/net/local"
 	"github.com/keep-network/keep-ecdsa/config"
+	"github.com/keep-network/keep-ecdsa/pkg/audit"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
 	"github.com/keep-network/keep-ecdsa/pkg/registry"
@@ -29,6 +30,14 @@ import (
 // subcommand and its own subcommands.
 var SigningCommand cli.Command
 
+const signDigestDescription = `Signs the digest with all key shares of a keep
+	found in the key shares directory and prints the public key of the keep
+	and the signature. The signature is recorded in the audit log under the
+	data directory from the config file, with operators of all the key shares
+	as co-signers. The config file is not required; if it can not be read or
+	the audit log can not be opened, a warning is printed and the signature
+	is not recorded.`
+
 func init() {
 	SigningCommand = cli.Command{
 		Name:  "signing",
@@ -52,10 +61,11 @@ func init() {
 				},
 			},
 			{
-				Name:      "sign-digest",
-				Usage:     "Sign a given digest using provided key shares",
-				Action:    SignDigest,
-				ArgsUsage: "[unprefixed-hex-digest] [key-shares-dir]",
+				Name:        "sign-digest",
+				Usage:       "Sign a given digest using provided key shares",
+				Description: signDigestDescription,
+				Action:      SignDigest,
+				ArgsUsage:   "[unprefixed-hex-digest] [key-shares-dir]",
 			},
 			ChainSigningCommand,
 		},
@@ -80,17 +90,18 @@ func DecryptKeyShare(c *cli.Context) error {
 		return fmt.Errorf("could not interpret keep ID: [%v]", err)
 	}
 
-	persistence, err := buildPersistenceHandle(
+	keepsStorage, err := buildKeepsStorage(
 		chainHandle,
-		extractKeyFilePassword(config),
-		config.Storage.DataDir,
+		storagePassword(config),
+		config.Storage,
 	)
 	if err != nil {
 		return err
 	}
+	defer closeKeepsStorage(keepsStorage)
 
-	keepRegistry := registry.NewKeepsRegistry(
-		persistence,
+	keepRegistry := registry.NewKeepsRegistryWithStorage(
+		keepsStorage,
 		chainHandle.UnmarshalID,
 	)
 
@@ -118,7 +129,10 @@ func DecryptKeyShare(c *cli.Context) error {
 }
 
 // SignDigest signs a given digest using key shares from the provided directory.
+// The signature is recorded in the audit log if it is available.
 func SignDigest(c *cli.Context) error {
+	auditLog := openSignDigestAuditLog(c)
+
 	digest := c.Args().First()
 	if len(digest) == 0 {
 		return fmt.Errorf("invalid digest")
@@ -210,8 +224,10 @@ func SignDigest(c *cli.Context) error {
 			signature, err := signers[signerIndex].CalculateSignature(
 				ctx,
 				digestBytes,
+				1,
 				networkProviders[signerIndex],
 				pubKeyToAddressFn,
+				nil,
 			)
 
 			signingOutcomesChannel <- &signingOutcome{
@@ -226,6 +242,7 @@ func SignDigest(c *cli.Context) error {
 	close(signingOutcomesChannel)
 
 	signatures := make(map[string]int)
+	var producedSignature *ecdsa.Signature
 
 	for signingOutcome := range signingOutcomesChannel {
 		if signingOutcome.err != nil {
@@ -244,6 +261,7 @@ func SignDigest(c *cli.Context) error {
 			signingOutcome.signature.S.Text(16),
 		)
 		signatures[signature]++
+		producedSignature = signingOutcome.signature
 	}
 
 	if len(signatures) != 1 {
@@ -266,9 +284,65 @@ func SignDigest(c *cli.Context) error {
 		fmt.Println(hex.EncodeToString(publicKey[:]), "\t", signature)
 	}
 
+	if auditLog == nil {
+		return nil
+	}
+
+	coSigners := make([]string, len(signers))
+	for i := range signers {
+		operatorPublicKey, err := signers[i].MemberID().PublicKey()
+		if err != nil {
+			return fmt.Errorf("could not get operator public key: [%v]", err)
+		}
+		coSigners[i] = cryptoPubkeyToAddress(*operatorPublicKey).String()
+	}
+
+	err = auditLog.Append(&audit.Entry{
+		Timestamp: time.Now().UTC(),
+		Source:    audit.SourceSignDigest,
+		Chain:     signers[0].ChainName(),
+		KeepID:    signers[0].GroupID(),
+		Digest:    hex.EncodeToString(digestBytes),
+		Signature: audit.NewSignature(producedSignature),
+		CoSigners: coSigners,
+	})
+	if err != nil {
+		return fmt.Errorf("could not record signature in audit log: [%v]", err)
+	}
+
 	return nil
 }
 
+// openSignDigestAuditLog opens the audit log under the data directory from
+// the config file. Signing with key shares must be possible when the client
+// configuration is not available, so it returns nil and prints a warning if
+// the audit log could not be opened.
+func openSignDigestAuditLog(c *cli.Context) *audit.Log {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		fmt.Fprintf(
+			os.Stderr,
+			"warning: the signature will not be recorded in the audit log; "+
+				"failed while reading config file: [%v]\n",
+			err,
+		)
+		return nil
+	}
+
+	auditLog, err := audit.Open(config.Storage.DataDir)
+	if err != nil {
+		fmt.Fprintf(
+			os.Stderr,
+			"warning: the signature will not be recorded in the audit log; "+
+				"could not open audit log: [%v]\n",
+			err,
+		)
+		return nil
+	}
+
+	return auditLog
+}
+
 // If `output-file` flag is provided stores the output in a file.
 // `fileMode` determines the access permission for the output file. Sample values:
 // 	0444 - read-only for all
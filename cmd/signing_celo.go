@@ -20,6 +20,7 @@ type (
 	keystoreKey   = keystore.Key
 	commonAddress = common.Address
 )
+
 var (
 	decryptKeyFile        = celoutil.DecryptKeyFile
 	accountsTextHash      = accounts.TextHash
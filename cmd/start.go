@@ -19,10 +19,17 @@ import (
 	"github.com/keep-network/keep-core/pkg/net/libp2p"
 	"github.com/keep-network/keep-core/pkg/net/retransmission"
 	"github.com/keep-network/keep-ecdsa/config"
+	"github.com/keep-network/keep-ecdsa/pkg/attribution"
+	"github.com/keep-network/keep-ecdsa/pkg/audit"
+	ecdsaChain "github.com/keep-network/keep-ecdsa/pkg/chain"
 	"github.com/keep-network/keep-ecdsa/pkg/chain/bitcoin"
 	"github.com/keep-network/keep-ecdsa/pkg/client"
+	ecdsaDiagnostics "github.com/keep-network/keep-ecdsa/pkg/diagnostics"
 	"github.com/keep-network/keep-ecdsa/pkg/extensions/tbtc/recovery"
 	"github.com/keep-network/keep-ecdsa/pkg/firewall"
+	"github.com/keep-network/keep-ecdsa/pkg/node"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+	"github.com/keep-network/keep-ecdsa/pkg/reputation"
 
 	"github.com/urfave/cli"
 )
@@ -82,29 +89,34 @@ func Start(c *cli.Context) error {
 		return fmt.Errorf("failed while reading config file: [%v]", err)
 	}
 
+	if _, err := config.Client.GetSigningPolicyMode(); err != nil {
+		return fmt.Errorf("invalid client configuration: [%v]", err)
+	}
+
 	ctx := context.Background()
 
-	chainHandle, operatorKeys, err := connectChain(ctx, config)
+	chainHandles, operatorKeys, err := connectChains(ctx, config)
 	if err != nil {
 		return err
 	}
 
-	stakeMonitor, err := chainHandle.StakeMonitor()
-	if err != nil {
-		return fmt.Errorf("error obtaining stake monitor handle: [%v]", err)
-	}
-	hasMinimumStake, err := stakeMonitor.HasMinimumStake(
-		chainHandle.OperatorID().String(),
-	)
-	if err != nil {
-		return fmt.Errorf("could not check the stake: [%v]", err)
-	}
-	if !hasMinimumStake {
-		logger.Errorf(
-			"no minimum KEEP stake or operator is not authorized to use it; " +
-				"please make sure the operator address in the configuration " +
-				"is correct and it has KEEP tokens delegated and the operator " +
-				"contract has been authorized to operate on the stake",
+	// The first chain is the one configured in the top level chain sections.
+	// It is used for metrics; other chains are operated in the multi-chain
+	// mode and share the network provider with it.
+	primaryChain := chainHandles[0]
+
+	stakeMonitors := make([]chain.StakeMonitor, len(chainHandles))
+	firewallPolicies := make([]net.Firewall, len(chainHandles))
+	for i, chainHandle := range chainHandles {
+		stakeMonitor, err := checkStake(chainHandle)
+		if err != nil {
+			return err
+		}
+
+		stakeMonitors[i] = stakeMonitor
+		firewallPolicies[i] = firewall.NewStakeOrActiveKeepPolicy(
+			chainHandle,
+			stakeMonitor,
 		)
 	}
 
@@ -112,21 +124,33 @@ func Start(c *cli.Context) error {
 		operatorKeys.private, operatorKeys.public,
 	)
 
-	persistence, err := buildPersistenceHandle(
-		chainHandle,
-		extractKeyFilePassword(config),
-		config.Storage.DataDir,
-	)
-	if err != nil {
-		return err
+	keepsStorages := make([]registry.Storage, len(chainHandles))
+	prunePolicies := make([]*registry.PrunePolicy, len(chainHandles))
+	for i, chainHandle := range chainHandles {
+		keepsStorages[i], err = buildKeepsStorage(
+			chainHandle,
+			storagePassword(config),
+			config.Storage,
+		)
+		if err != nil {
+			return err
+		}
+		defer closeKeepsStorage(keepsStorages[i])
+
+		prunePolicies[i], err = buildPrunePolicy(chainHandle, config.Storage)
+		if err != nil {
+			return err
+		}
 	}
 
+	// A peer is let to connect if it meets the firewall policy of any of
+	// the chains.
 	networkProvider, err := libp2p.Connect(
 		ctx,
 		config.LibP2P,
 		networkPrivateKey,
 		libp2p.ProtocolECDSA,
-		firewall.NewStakeOrActiveKeepPolicy(chainHandle, stakeMonitor),
+		firewall.NewAnyOfPolicy(firewallPolicies...),
 		retransmission.NewTimeTicker(ctx, 1*time.Second),
 		libp2p.WithRoutingTableRefreshPeriod(routingTableRefreshPeriod),
 	)
@@ -141,6 +165,21 @@ func Start(c *cli.Context) error {
 		return err
 	}
 
+	attributionStore, err := attribution.Open(config.Storage.DataDir)
+	if err != nil {
+		return fmt.Errorf("failed to open attribution store: [%v]", err)
+	}
+
+	reputationStore, err := reputation.Open(config.Storage.DataDir)
+	if err != nil {
+		return fmt.Errorf("failed to open reputation store: [%v]", err)
+	}
+
+	auditLog, err := audit.Open(config.Storage.DataDir)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: [%v]", err)
+	}
+
 	err = config.Extensions.TBTC.Bitcoin.Validate()
 	if err != nil {
 		if (bitcoin.Config{}) == config.Extensions.TBTC.Bitcoin {
@@ -151,28 +190,71 @@ func Start(c *cli.Context) error {
 		}
 	}
 
-	clientHandle := client.Initialize(
-		ctx,
-		operatorKeys.public,
-		chainHandle,
-		networkProvider,
-		persistence,
-		derivationIndexPersistence,
-		&config.Client,
-		&config.Extensions.TBTC,
-		&config.TSS,
-	)
-	logger.Debugf("initialized operator with address: [%s]", chainHandle.OperatorID())
+	tssParamsPool := node.NewTSSPreParamsPool(&config.TSS)
+
+	var clientHandle *client.Handle
+	for i, chainHandle := range chainHandles {
+		sanctionedApplications, err := sanctionedApplicationIDs(
+			chainHandle,
+			config,
+			i,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Broadcast channels of the chain configured in the top level chain
+		// sections are named after bare keep identifiers, as they were before
+		// the multi-chain mode was introduced, so that members of existing
+		// keeps running older clients can still hear each other. Channels of
+		// chains added in the multi-chain mode are scoped to the chain.
+		chainNetworkProvider := networkProvider
+		if i > 0 {
+			chainNetworkProvider = node.ChainScopedProvider(
+				networkProvider,
+				chainHandle.Name(),
+			)
+		}
+
+		handle := client.Initialize(
+			ctx,
+			operatorKeys.public,
+			chainHandle,
+			sanctionedApplications,
+			chainNetworkProvider,
+			tssParamsPool,
+			keepsStorages[i],
+			prunePolicies[i],
+			derivationIndexPersistence,
+			attributionStore,
+			reputationStore,
+			auditLog,
+			&config.Client,
+			&config.Extensions.TBTC,
+			&config.TSS,
+		)
+		logger.Debugf(
+			"initialized operator with address [%s] on chain [%s]",
+			chainHandle.OperatorID(),
+			chainHandle.Name(),
+		)
+
+		if clientHandle == nil {
+			clientHandle = handle
+		}
+	}
 
 	initializeMetrics(
 		ctx,
 		config,
 		networkProvider,
-		stakeMonitor,
-		chainHandle.OperatorID().String(),
+		stakeMonitors[0],
+		primaryChain.OperatorID().String(),
 		clientHandle,
+		attributionStore,
+		reputationStore,
 	)
-	initializeDiagnostics(config, networkProvider)
+	initializeDiagnostics(config, networkProvider, reputationStore)
 
 	logger.Info("client started")
 
@@ -186,6 +268,67 @@ func Start(c *cli.Context) error {
 	}
 }
 
+// sanctionedApplicationIDs returns IDs of applications sanctioned by the
+// operator on the chain with the given index. Applications of the first chain
+// are configured in the top level section, applications of the additional
+// chains in the sections of the chains.
+func sanctionedApplicationIDs(
+	chainHandle ecdsaChain.Handle,
+	config *config.Config,
+	chainIndex int,
+) ([]ecdsaChain.ID, error) {
+	sanctionedApplications := config.SanctionedApplications
+	if chainIndex > 0 {
+		sanctionedApplications = config.Chains[chainIndex-1].SanctionedApplications
+	}
+
+	applicationIDs := make(
+		[]ecdsaChain.ID,
+		len(sanctionedApplications.AddressesStrings),
+	)
+	for i, application := range sanctionedApplications.AddressesStrings {
+		applicationID, err := chainHandle.UnmarshalID(application)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"invalid sanctioned application on chain [%s]: [%v]",
+				chainHandle.Name(),
+				err,
+			)
+		}
+
+		applicationIDs[i] = applicationID
+	}
+
+	return applicationIDs, nil
+}
+
+// checkStake returns the stake monitor of the chain and warns if the operator
+// has no minimum stake on it.
+func checkStake(chainHandle ecdsaChain.Handle) (chain.StakeMonitor, error) {
+	stakeMonitor, err := chainHandle.StakeMonitor()
+	if err != nil {
+		return nil, fmt.Errorf("error obtaining stake monitor handle: [%v]", err)
+	}
+	hasMinimumStake, err := stakeMonitor.HasMinimumStake(
+		chainHandle.OperatorID().String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not check the stake: [%v]", err)
+	}
+	if !hasMinimumStake {
+		logger.Errorf(
+			"no minimum KEEP stake on [%s] or operator is not authorized to "+
+				"use it; please make sure the operator address in the "+
+				"configuration is correct and it has KEEP tokens delegated "+
+				"and the operator contract has been authorized to operate "+
+				"on the stake",
+			chainHandle.Name(),
+		)
+	}
+
+	return stakeMonitor, nil
+}
+
 func initializeMetrics(
 	ctx context.Context,
 	config *config.Config,
@@ -193,6 +336,8 @@ func initializeMetrics(
 	stakeMonitor chain.StakeMonitor,
 	address string,
 	clientHandle *client.Handle,
+	attributionStore *attribution.Store,
+	reputationStore *reputation.Store,
 ) {
 	registry, isConfigured := coreMetrics.Initialize(
 		config.Metrics.Port,
@@ -237,11 +382,33 @@ func initializeMetrics(
 		clientHandle,
 		time.Duration(config.Metrics.ClientMetricsTick)*time.Second,
 	)
+
+	metrics.ObserveAttributedProtocolFailures(
+		ctx,
+		registry,
+		attributionStore,
+		time.Duration(config.Metrics.ClientMetricsTick)*time.Second,
+	)
+
+	metrics.ObserveTrackedPeers(
+		ctx,
+		registry,
+		reputationStore,
+		time.Duration(config.Metrics.ClientMetricsTick)*time.Second,
+	)
+
+	metrics.ObserveUnreliablePeers(
+		ctx,
+		registry,
+		reputationStore,
+		time.Duration(config.Metrics.ClientMetricsTick)*time.Second,
+	)
 }
 
 func initializeDiagnostics(
 	config *config.Config,
 	netProvider net.Provider,
+	reputationStore *reputation.Store,
 ) {
 	registry, isConfigured := diagnostics.Initialize(
 		config.Diagnostics.Port,
@@ -258,4 +425,5 @@ func initializeDiagnostics(
 
 	diagnostics.RegisterConnectedPeersSource(registry, netProvider)
 	diagnostics.RegisterClientInfoSource(registry, netProvider)
+	ecdsaDiagnostics.RegisterPeerReputationSource(registry, reputationStore)
 }
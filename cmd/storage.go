@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/keep-network/keep-common/pkg/logging"
+	"github.com/keep-network/keep-ecdsa/config"
+	"github.com/keep-network/keep-ecdsa/pkg/rekey"
+
+	"github.com/urfave/cli"
+)
+
+// newStoragePasswordEnvVariable is the environment variable name for the new
+// password of key shares used by the `storage rekey` command.
+const newStoragePasswordEnvVariable = "KEEP_STORAGE_NEW_PASSWORD" // #nosec G101 -- it's just env variable name
+
+// StorageCommand contains the definition of the `storage` command-line
+// subcommand and its own subcommands.
+var StorageCommand cli.Command
+
+const storageRekeyDescription = `Re-encrypts all key shares of the operator,
+	including snapshots and archived keeps, with a new password. Key shares of
+	all chains found in the data directory are re-encrypted. The current
+	password is the one the client uses: the value of KEEP_STORAGE_PASSWORD if
+	set, otherwise the value of KEEP_ETHEREUM_PASSWORD. The new password has
+	to be provided as KEEP_STORAGE_NEW_PASSWORD.
+
+	Key shares are replaced only once all of them have been re-encrypted. If
+	replacing any key share fails, the original key shares are restored.
+
+	To rotate the operator key file password, provide the new key file password
+	as KEEP_STORAGE_NEW_PASSWORD and start the client with the new value of
+	KEEP_ETHEREUM_PASSWORD afterwards. To encrypt key shares with a password
+	independent from the operator key file password, provide a dedicated
+	password as KEEP_STORAGE_NEW_PASSWORD and start the client with
+	KEEP_STORAGE_PASSWORD set to it afterwards.
+
+	The client must not be running while key shares are re-encrypted.`
+
+func init() {
+	StorageCommand = cli.Command{
+		Name:  "storage",
+		Usage: "Provides tools to manage encryption of stored key shares",
+		Before: func(c *cli.Context) error {
+			// disable the regular logger
+			_ = logging.Configure("keep*=fatal")
+			return nil
+		},
+		Subcommands: []cli.Command{
+			{
+				Name:        "rekey",
+				Usage:       "Re-encrypts key shares with a new password",
+				Description: storageRekeyDescription,
+				Action:      StorageRekey,
+			},
+		},
+	}
+}
+
+// StorageRekey re-encrypts key shares of the operator with a new password.
+func StorageRekey(c *cli.Context) error {
+	config, err := config.ReadConfig(c.GlobalString("config"))
+	if err != nil {
+		return fmt.Errorf("failed while reading config file: [%v]", err)
+	}
+
+	newPassword := os.Getenv(newStoragePasswordEnvVariable)
+	if len(newPassword) == 0 {
+		return fmt.Errorf(
+			"new password has to be provided as [%s] environment variable",
+			newStoragePasswordEnvVariable,
+		)
+	}
+
+	result, err := rekey.Rekey(
+		config.Storage.DataDir,
+		storagePassword(config),
+		newPassword,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to re-encrypt key shares: [%v]", err)
+	}
+
+	fmt.Printf(
+		"re-encrypted [%d] files in storage directories %v\n",
+		result.FilesCount,
+		result.StorageDirs,
+	)
+
+	return nil
+}
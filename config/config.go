@@ -9,6 +9,7 @@ import (
 	"github.com/keep-network/keep-common/pkg/chain/celo"
 	"github.com/keep-network/keep-common/pkg/chain/ethereum"
 	"github.com/keep-network/keep-core/pkg/net/libp2p"
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
 	"github.com/keep-network/keep-ecdsa/pkg/client"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
 	"github.com/keep-network/keep-ecdsa/pkg/extensions/tbtc"
@@ -17,10 +18,18 @@ import (
 // PasswordEnvVariable environment variable name for ethereum key password.
 const PasswordEnvVariable = "KEEP_ETHEREUM_PASSWORD" // #nosec G101 -- it's just env variable name
 
+// StoragePasswordEnvVariable environment variable name for the password
+// encrypting key shares. If not set, key shares are encrypted with the chain
+// key file password.
+const StoragePasswordEnvVariable = "KEEP_STORAGE_PASSWORD" // #nosec G101 -- it's just env variable name
+
 // Config is the top level config structure.
 type Config struct {
 	Ethereum               ethereum.Config
+	Network                chain.NetworkConfig
 	Celo                   celo.Config
+	TransactionUrgency     chain.UrgencyConfig
+	Chains                 []ChainConfig
 	SanctionedApplications SanctionedApplications
 	Storage                Storage
 	LibP2P                 libp2p.Config
@@ -31,6 +40,46 @@ type Config struct {
 	Extensions             Extensions
 }
 
+// ChainConfig stores configuration of an additional EVM network the client
+// operates on in the multi-chain mode, next to the network configured in the
+// top level Ethereum and Network sections. The operator account of the top
+// level Ethereum section is used on all networks, account set in the chain's
+// Ethereum section is ignored. The top level sanctioned applications are
+// sanctioned only on the network of the top level sections.
+type ChainConfig struct {
+	Ethereum               ethereum.Config
+	Network                chain.NetworkConfig
+	TransactionUrgency     chain.UrgencyConfig
+	SanctionedApplications SanctionedApplications
+}
+
+// ValidateChains checks whether the additional chains have valid network
+// settings and are named uniquely, so that each of them has its own data
+// directory and broadcast channels.
+func (c *Config) ValidateChains() error {
+	chainNames := map[string]bool{c.Network.NetworkName(): true}
+
+	for i, chainConfig := range c.Chains {
+		if len(chainConfig.Network.Name) == 0 {
+			return fmt.Errorf("network name of chain [%d] is not set", i)
+		}
+
+		if err := chainConfig.Network.Validate(); err != nil {
+			return fmt.Errorf("invalid network of chain [%d]: [%v]", i, err)
+		}
+
+		if chainNames[chainConfig.Network.Name] {
+			return fmt.Errorf(
+				"network [%s] is configured more than once",
+				chainConfig.Network.Name,
+			)
+		}
+		chainNames[chainConfig.Network.Name] = true
+	}
+
+	return nil
+}
+
 // SanctionedApplications contains addresses of applications approved by the
 // operator.
 type SanctionedApplications struct {
@@ -58,6 +107,21 @@ func (sa *SanctionedApplications) Addresses() ([]common.Address, error) {
 // Storage stores meta-info about keeping data on disk
 type Storage struct {
 	DataDir string
+	// Backend storing key shares: "disk" stores each key share in a file of
+	// the data directory; "bolt" stores key shares in an embedded database
+	// in the data directory. Defaults to "disk".
+	Backend string
+	// ArchiveRetentionDays is the number of days archived key shares are
+	// retained for before they are destroyed. Key shares of closed keeps are
+	// retained for the number of days after the keep has been archived. Key
+	// shares of other keeps are retained until the operator confirms funds
+	// held by the keep have been recovered and for the number of days after.
+	// Archived key shares are retained forever if not set.
+	ArchiveRetentionDays int
+	// Password encrypting key shares. It is expected to be provided as
+	// the KEEP_STORAGE_PASSWORD environment variable. If not set, key shares
+	// are encrypted with the chain key file password.
+	Password string `toml:"-"`
 }
 
 // Metrics stores meta-info about metrics.
@@ -79,7 +143,8 @@ type Extensions struct {
 }
 
 // ReadConfig reads in the configuration file in .toml format. Chain key file
-// password is expected to be provided as environment variable.
+// password and storage password are expected to be provided as environment
+// variables.
 func ReadConfig(filePath string) (*Config, error) {
 	config := &Config{}
 	if _, err := toml.DecodeFile(filePath, config); err != nil {
@@ -91,6 +156,8 @@ func ReadConfig(filePath string) (*Config, error) {
 	config.Ethereum.Account.KeyFilePassword = password
 	config.Celo.Account.KeyFilePassword = password
 
+	config.Storage.Password = os.Getenv(StoragePasswordEnvVariable)
+
 	return config, nil
 }
 
@@ -11,6 +11,10 @@ import (
 
 	"github.com/BurntSushi/toml"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum"
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/policy"
 )
 
 func TestReadConfig(t *testing.T) {
@@ -19,6 +23,11 @@ func TestReadConfig(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	err = os.Setenv("KEEP_STORAGE_PASSWORD", "not-my-storage-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	filepath := "../internal/testdata/config.toml"
 	cfg, err := ReadConfig(filepath)
 	if err != nil {
@@ -55,10 +64,97 @@ func TestReadConfig(t *testing.T) {
 				"TBTCSystem":             "0xda4c869B9073deac021344fd592c1BB0DC6Fc9a5",
 			},
 		},
+		"Network.Name": {
+			readValueFunc: func(c *Config) interface{} { return c.Network.Name },
+			expectedValue: "sepolia",
+		},
+		"Network.ChainID": {
+			readValueFunc: func(c *Config) interface{} { return c.Network.ChainID },
+			expectedValue: uint64(11155111),
+		},
+		"Network.FeeModel": {
+			readValueFunc: func(c *Config) interface{} { return c.Network.FeeModel },
+			expectedValue: chain.FeeModelEIP1559,
+		},
+		"Chains": {
+			readValueFunc: func(c *Config) interface{} { return len(c.Chains) },
+			expectedValue: 1,
+		},
+		"Chains[0].Ethereum.URL": {
+			readValueFunc: func(c *Config) interface{} { return c.Chains[0].Ethereum.URL },
+			expectedValue: "ws://192.168.0.159:8546",
+		},
+		"Chains[0].Ethereum.MaxGasFeeCap": {
+			readValueFunc: func(c *Config) interface{} { return c.Chains[0].Ethereum.MaxGasFeeCap.Int },
+			expectedValue: big.NewInt(2000000000),
+		},
+		"Chains[0].Ethereum.ContractAddresses": {
+			readValueFunc: func(c *Config) interface{} { return c.Chains[0].Ethereum.ContractAddresses },
+			expectedValue: map[string]string{
+				"BondedECDSAKeepFactory": "0x3CCE98119100D664eb6dEe5b8DB978aEEeAf42D7",
+			},
+		},
+		"Chains[0].Network": {
+			readValueFunc: func(c *Config) interface{} { return c.Chains[0].Network },
+			expectedValue: chain.NetworkConfig{
+				Name:     "arbitrum",
+				ChainID:  42161,
+				FeeModel: chain.FeeModelLegacy,
+			},
+		},
+		"Chains[0].TransactionUrgency.Critical.MaxGasFeeCap": {
+			readValueFunc: func(c *Config) interface{} { return c.Chains[0].TransactionUrgency.Critical.MaxGasFeeCap.Int },
+			expectedValue: big.NewInt(10000000000),
+		},
+		"Chains[0].SanctionedApplications": {
+			readValueFunc: func(c *Config) interface{} {
+				addresses, _ := c.Chains[0].SanctionedApplications.Addresses()
+				return addresses
+			},
+			expectedValue: []common.Address{
+				common.HexToAddress("0x4e09cadc7037afa36603138d1c0b76fe2aa5039c"),
+			},
+		},
+		"ValidateChains() error": {
+			readValueFunc: func(c *Config) interface{} { return c.ValidateChains() },
+			expectedValue: nil,
+		},
+		"TransactionUrgency.Critical.MaxGasFeeCap": {
+			readValueFunc: func(c *Config) interface{} { return c.TransactionUrgency.Critical.MaxGasFeeCap.Int },
+			expectedValue: big.NewInt(800000000000),
+		},
+		"TransactionUrgency.Critical.GasTipCap": {
+			readValueFunc: func(c *Config) interface{} { return c.TransactionUrgency.Critical.GasTipCap.Int },
+			expectedValue: big.NewInt(5000000000),
+		},
+		"TransactionUrgency.Critical.MiningCheckInterval": {
+			readValueFunc: func(c *Config) interface{} { return c.TransactionUrgency.Critical.MiningCheckInterval },
+			expectedValue: 30,
+		},
+		"TransactionUrgency.Normal": {
+			readValueFunc: func(c *Config) interface{} { return c.TransactionUrgency.Normal },
+			expectedValue: chain.UrgencyClassConfig{},
+		},
+		"TransactionUrgency.Low.MaxGasFeeCap": {
+			readValueFunc: func(c *Config) interface{} { return c.TransactionUrgency.Low.MaxGasFeeCap.Int },
+			expectedValue: big.NewInt(60000000000),
+		},
+		"TransactionUrgency.Low.GasTipCap": {
+			readValueFunc: func(c *Config) interface{} { return c.TransactionUrgency.Low.GasTipCap },
+			expectedValue: (*ethereum.Wei)(nil),
+		},
+		"TransactionUrgency.Low.MiningCheckInterval": {
+			readValueFunc: func(c *Config) interface{} { return c.TransactionUrgency.Low.MiningCheckInterval },
+			expectedValue: 300,
+		},
 		"Storage.DataDir": {
 			readValueFunc: func(c *Config) interface{} { return c.Storage.DataDir },
 			expectedValue: "/my/secure/location",
 		},
+		"Storage.Password": {
+			readValueFunc: func(c *Config) interface{} { return c.Storage.Password },
+			expectedValue: "not-my-storage-password",
+		},
 		"LibP2P.Port": {
 			readValueFunc: func(c *Config) interface{} { return c.LibP2P.Port },
 			expectedValue: 27001,
@@ -79,6 +175,13 @@ func TestReadConfig(t *testing.T) {
 			readValueFunc: func(c *Config) interface{} { return c.Client.GetSigningTimeout() },
 			expectedValue: time.Duration(12600000000000),
 		},
+		"Client.SigningPolicy": {
+			readValueFunc: func(c *Config) interface{} {
+				mode, _ := c.Client.GetSigningPolicyMode()
+				return mode
+			},
+			expectedValue: policy.ModeEnforce,
+		},
 		"TSS.PreParamsGenerationTimeout": {
 			readValueFunc: func(c *Config) interface{} { return c.TSS.GetPreParamsGenerationTimeout() },
 			expectedValue: time.Duration(397000000000),
@@ -141,6 +244,78 @@ func TestReadConfig(t *testing.T) {
 	}
 }
 
+func TestValidateChains_TwoEVMChains(t *testing.T) {
+	config := &Config{
+		Chains: []ChainConfig{
+			{Network: chain.NetworkConfig{Name: "polygon", ChainID: 137}},
+		},
+	}
+
+	if err := config.ValidateChains(); err != nil {
+		t.Errorf("unexpected error: [%v]", err)
+	}
+}
+
+func TestValidateChains_ExpectedFailure(t *testing.T) {
+	var tests = map[string]struct {
+		network       chain.NetworkConfig
+		chains        []ChainConfig
+		expectedError string
+	}{
+		"missing network name": {
+			chains: []ChainConfig{
+				{Network: chain.NetworkConfig{ChainID: 42161}},
+			},
+			expectedError: "network name of chain [0] is not set",
+		},
+		"invalid network": {
+			chains: []ChainConfig{
+				{Network: chain.NetworkConfig{Name: "Arbitrum"}},
+			},
+			expectedError: "invalid network of chain [0]: [invalid network " +
+				"name: [Arbitrum]; network name must start with a lowercase " +
+				"letter and then consist solely of lowercase letters, " +
+				"numbers, -, or _]",
+		},
+		"same as default network": {
+			chains: []ChainConfig{
+				{Network: chain.NetworkConfig{Name: "ethereum"}},
+			},
+			expectedError: "network [ethereum] is configured more than once",
+		},
+		"same as top level network": {
+			network: chain.NetworkConfig{Name: "arbitrum"},
+			chains: []ChainConfig{
+				{Network: chain.NetworkConfig{Name: "arbitrum"}},
+			},
+			expectedError: "network [arbitrum] is configured more than once",
+		},
+		"duplicated network": {
+			chains: []ChainConfig{
+				{Network: chain.NetworkConfig{Name: "arbitrum"}},
+				{Network: chain.NetworkConfig{Name: "optimism"}},
+				{Network: chain.NetworkConfig{Name: "arbitrum"}},
+			},
+			expectedError: "network [arbitrum] is configured more than once",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			config := &Config{Network: test.network, Chains: test.chains}
+
+			err := config.ValidateChains()
+			if err == nil || err.Error() != test.expectedError {
+				t.Errorf(
+					"unexpected error\nexpected: [%v]\nactual:   [%v]",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
+
 func TestParseChainParams(t *testing.T) {
 	var parseChainParamTests = map[string]struct {
 		chainName   []string
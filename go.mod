@@ -27,5 +27,6 @@ require (
 	github.com/keep-network/tbtc v1.1.1-0.20211005102550-e0f035c575a2
 	github.com/pkg/errors v0.9.1
 	github.com/urfave/cli v1.22.1
+	go.etcd.io/bbolt v1.3.6
 	gotest.tools/v3 v3.0.3
 )
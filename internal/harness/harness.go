@@ -0,0 +1,612 @@
+// Package harness provides an in-process harness running a group of ECDSA
+// clients on the local chain and the local network. It is meant to be used
+// in integration tests executed with `go test`.
+package harness
+
+import (
+	"context"
+	cecdsa "crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/binance-chain/tss-lib/ecdsa/keygen"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ipfs/go-log"
+
+	"github.com/keep-network/keep-common/pkg/persistence"
+	"github.com/keep-network/keep-core/pkg/net/key"
+	localNet "github.com/keep-network/keep-core/pkg/net/local"
+	"github.com/keep-network/keep-core/pkg/operator"
+
+	"github.com/keep-network/keep-ecdsa/internal/netfault"
+	"github.com/keep-network/keep-ecdsa/internal/testdata"
+	"github.com/keep-network/keep-ecdsa/pkg/attribution"
+	"github.com/keep-network/keep-ecdsa/pkg/audit"
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/local"
+	"github.com/keep-network/keep-ecdsa/pkg/client"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+	"github.com/keep-network/keep-ecdsa/pkg/extensions/tbtc"
+	"github.com/keep-network/keep-ecdsa/pkg/extensions/tbtc/recovery"
+	"github.com/keep-network/keep-ecdsa/pkg/node"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+	"github.com/keep-network/keep-ecdsa/pkg/reputation"
+)
+
+var logger = log.Logger("keep-harness")
+
+// MaxNodesCount is the maximum number of nodes the harness can run. Nodes use
+// precomputed TSS pre-parameters from the test fixtures and there is a fixture
+// for each of them.
+const MaxNodesCount = 5
+
+// awaitCheckTick is the interval in which await functions check the chain.
+const awaitCheckTick = 100 * time.Millisecond
+
+// Harness runs a group of ECDSA clients sharing one local chain and one local
+// network.
+type Harness struct {
+	chain local.Chain
+	tbtc  *local.TBTCLocalChain
+
+	nodes []*Node
+
+	// faultsMutex guards network faults of all nodes. Faults are injected
+	// by network providers of receiving nodes, so a change of faults of one
+	// node changes fault policies of all nodes.
+	faultsMutex sync.Mutex
+}
+
+// Node is a single ECDSA client run by the harness.
+type Node struct {
+	harness *Harness
+
+	operatorAddress common.Address
+	dataDir         string
+
+	chain            local.Chain
+	networkPublicKey *key.NetworkPublic
+	networkProvider  *netfault.Provider
+	client           *client.Handle
+
+	dropped bool
+	delay   time.Duration
+}
+
+// New starts the given number of ECDSA clients on a new local chain. Data of
+// the clients are stored in subdirectories of the given data directory.
+// Clients operate until the provided context is done.
+func New(ctx context.Context, nodesCount int, dataDir string) (*Harness, error) {
+	if nodesCount < 1 || nodesCount > MaxNodesCount {
+		return nil, fmt.Errorf(
+			"nodes count has to be between 1 and [%v]; got [%v]",
+			MaxNodesCount,
+			nodesCount,
+		)
+	}
+
+	fixtures, err := testdata.LoadKeygenTestFixtures(nodesCount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load key generation fixtures: [%v]", err)
+	}
+
+	localChain := local.Connect(ctx)
+
+	tbtcHandle, err := localChain.TBTCApplicationHandle()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tbtc application handle: [%v]", err)
+	}
+
+	harness := &Harness{
+		chain: localChain,
+		tbtc:  tbtcHandle.(*local.TBTCLocalChain),
+		nodes: make([]*Node, nodesCount),
+	}
+
+	for i := range harness.nodes {
+		nodeDataDir := filepath.Join(dataDir, fmt.Sprintf("node-%d", i))
+
+		harness.nodes[i], err = harness.startNode(
+			ctx,
+			nodeDataDir,
+			&fixtures[i].LocalPreParams,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start node [%v]: [%v]", i, err)
+		}
+	}
+
+	return harness, nil
+}
+
+func (h *Harness) startNode(
+	ctx context.Context,
+	dataDir string,
+	preParams *keygen.LocalPreParams,
+) (*Node, error) {
+	operatorPrivateKey, operatorPublicKey, err := operator.GenerateKeyPair()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate operator key: [%v]", err)
+	}
+
+	nodeChain := h.chain.WithOperator((*cecdsa.PrivateKey)(operatorPrivateKey))
+	h.chain.AuthorizeOperator(nodeChain.OperatorAddress())
+
+	networkPublicKey := key.NetworkPublic(*operatorPublicKey)
+	networkProvider := netfault.Connect(
+		localNet.ConnectWithKey(&networkPublicKey),
+		&netfault.Policy{},
+	)
+
+	if err := os.MkdirAll(dataDir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create data directory: [%v]", err)
+	}
+
+	persistenceHandle, err := persistence.NewDiskHandle(dataDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create persistence handle: [%v]", err)
+	}
+
+	derivationIndexStorage, err := recovery.NewDerivationIndexStorage(dataDir)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to create derivation index storage: [%v]",
+			err,
+		)
+	}
+
+	attributionStore, err := attribution.Open(dataDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open attribution store: [%v]", err)
+	}
+
+	reputationStore, err := reputation.Open(dataDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open reputation store: [%v]", err)
+	}
+
+	auditLog, err := audit.Open(dataDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: [%v]", err)
+	}
+
+	// Each node gets its own pre-parameters; the keys of all members of
+	// a keep have to be generated with different pre-parameters.
+	tssParamsPool := node.NewTSSPreParamsPoolWithSource(
+		1,
+		func() (*keygen.LocalPreParams, error) {
+			return preParams, nil
+		},
+	)
+
+	clientHandle := client.Initialize(
+		ctx,
+		operatorPublicKey,
+		nodeChain,
+		nil,
+		networkProvider,
+		tssParamsPool,
+		registry.NewPersistentStorage(persistenceHandle),
+		nil,
+		derivationIndexStorage,
+		attributionStore,
+		reputationStore,
+		auditLog,
+		&client.Config{},
+		&tbtc.Config{},
+		&tss.Config{},
+	)
+
+	return &Node{
+		harness:          h,
+		operatorAddress:  nodeChain.OperatorAddress(),
+		dataDir:          dataDir,
+		chain:            nodeChain,
+		networkPublicKey: &networkPublicKey,
+		networkProvider:  networkProvider,
+		client:           clientHandle,
+	}, nil
+}
+
+// Chain returns the local chain the harness operates on.
+func (h *Harness) Chain() local.Chain {
+	return h.chain
+}
+
+// TBTC returns the tBTC application of the local chain the harness operates
+// on.
+func (h *Harness) TBTC() *local.TBTCLocalChain {
+	return h.tbtc
+}
+
+// Nodes returns all nodes run by the harness.
+func (h *Harness) Nodes() []*Node {
+	return h.nodes
+}
+
+// Node returns the node with the given index.
+func (h *Harness) Node(index int) *Node {
+	return h.nodes[index]
+}
+
+// OperatorAddresses returns operator addresses of all nodes run by the
+// harness.
+func (h *Harness) OperatorAddresses() []common.Address {
+	addresses := make([]common.Address, len(h.nodes))
+	for i, n := range h.nodes {
+		addresses[i] = n.operatorAddress
+	}
+
+	return addresses
+}
+
+// OpenKeep opens a new keep with all nodes run by the harness as members.
+func (h *Harness) OpenKeep() common.Address {
+	return h.OpenKeepWithMembers(h.OperatorAddresses())
+}
+
+// OpenKeepWithMembers opens a new keep with the given members.
+func (h *Harness) OpenKeepWithMembers(members []common.Address) common.Address {
+	keepAddress := randomAddress()
+
+	h.chain.OpenKeep(keepAddress, randomAddress(), members)
+
+	return keepAddress
+}
+
+// RequestSignature requests a signature over the given digest from the keep.
+func (h *Harness) RequestSignature(
+	keepAddress common.Address,
+	digest [32]byte,
+) error {
+	return h.chain.RequestSignature(keepAddress, digest)
+}
+
+// CloseKeep closes the keep.
+func (h *Harness) CloseKeep(keepAddress common.Address) error {
+	return h.chain.CloseKeep(keepAddress)
+}
+
+// TerminateKeep terminates the keep.
+func (h *Harness) TerminateKeep(keepAddress common.Address) error {
+	return h.chain.TerminateKeep(keepAddress)
+}
+
+// CreateDeposit creates a new tBTC deposit backed by a keep with all nodes run
+// by the harness as members and returns the address of the deposit's keep.
+func (h *Harness) CreateDeposit(depositAddress string) (common.Address, error) {
+	h.tbtc.CreateDeposit(depositAddress, h.OperatorAddresses())
+
+	keep, err := h.tbtc.Keep(depositAddress)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return common.HexToAddress(keep.ID().String()), nil
+}
+
+// AwaitPublicKey waits until the public key of the keep is published on the
+// chain and returns it.
+func (h *Harness) AwaitPublicKey(
+	ctx context.Context,
+	keepAddress common.Address,
+) (*cecdsa.PublicKey, error) {
+	keep, err := h.keep(keepAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	var publicKey []byte
+	err = await(ctx, func() (bool, error) {
+		publicKey, err = keep.GetPublicKey()
+		if err != nil {
+			return false, err
+		}
+
+		return len(publicKey) > 0, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf(
+			"public key of keep [%s] not published: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
+
+	return unmarshalPublicKey(publicKey)
+}
+
+// AwaitSignature waits until a signature over the given digest is submitted to
+// the keep, verifies it against the keep's public key and returns it.
+func (h *Harness) AwaitSignature(
+	ctx context.Context,
+	keepAddress common.Address,
+	digest [32]byte,
+) (*chain.SignatureSubmittedEvent, error) {
+	keep, err := h.keep(keepAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	var signature *chain.SignatureSubmittedEvent
+	err = await(ctx, func() (bool, error) {
+		events, err := keep.PastSignatureSubmittedEvents(0)
+		if err != nil {
+			return false, err
+		}
+
+		for _, event := range events {
+			if event.Digest == digest {
+				signature = event
+				return true, nil
+			}
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf(
+			"signature over digest [%x] not submitted to keep [%s]: [%v]",
+			digest,
+			keepAddress.String(),
+			err,
+		)
+	}
+
+	publicKey, err := keep.GetPublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	unmarshalledPublicKey, err := unmarshalPublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cecdsa.Verify(
+		unmarshalledPublicKey,
+		digest[:],
+		new(big.Int).SetBytes(signature.R[:]),
+		new(big.Int).SetBytes(signature.S[:]),
+	) {
+		return nil, fmt.Errorf(
+			"signature over digest [%x] submitted to keep [%s] is invalid",
+			digest,
+			keepAddress.String(),
+		)
+	}
+
+	return signature, nil
+}
+
+// AwaitSigners waits until all nodes being members of the keep store their
+// signers of the keep.
+func (h *Harness) AwaitSigners(
+	ctx context.Context,
+	keepAddress common.Address,
+) error {
+	return h.awaitMembers(ctx, keepAddress, (*Node).HasSigner)
+}
+
+// AwaitKeepArchived waits until all nodes being members of the keep archive
+// their signers of the keep.
+func (h *Harness) AwaitKeepArchived(
+	ctx context.Context,
+	keepAddress common.Address,
+) error {
+	return h.awaitMembers(ctx, keepAddress, (*Node).IsKeepArchived)
+}
+
+// AwaitDepositState waits until the tBTC deposit gets into the given state.
+func (h *Harness) AwaitDepositState(
+	ctx context.Context,
+	depositAddress string,
+	state chain.DepositState,
+) error {
+	return await(ctx, func() (bool, error) {
+		currentState, err := h.tbtc.CurrentState(depositAddress)
+		if err != nil {
+			return false, err
+		}
+
+		return currentState == state, nil
+	})
+}
+
+func (h *Harness) awaitMembers(
+	ctx context.Context,
+	keepAddress common.Address,
+	check func(n *Node, keepAddress common.Address) bool,
+) error {
+	keep, err := h.keep(keepAddress)
+	if err != nil {
+		return err
+	}
+
+	members, err := keep.GetMembers()
+	if err != nil {
+		return err
+	}
+
+	return await(ctx, func() (bool, error) {
+		for _, n := range h.nodes {
+			if !isMember(members, n.operatorAddress) {
+				continue
+			}
+
+			if !check(n, keepAddress) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	})
+}
+
+func (h *Harness) keep(
+	keepAddress common.Address,
+) (chain.BondedECDSAKeepHandle, error) {
+	keepID, err := h.chain.UnmarshalID(keepAddress.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return h.chain.GetKeepWithID(keepID)
+}
+
+// OperatorAddress returns the operator address of the node.
+func (n *Node) OperatorAddress() common.Address {
+	return n.operatorAddress
+}
+
+// Chain returns the view of the local chain for the node's operator.
+func (n *Node) Chain() local.Chain {
+	return n.chain
+}
+
+// Drop cuts the node off the network. Messages sent by the node and messages
+// sent to the node are lost until the node is restored.
+func (n *Node) Drop() {
+	n.harness.updateFaults(func() {
+		n.dropped = true
+	})
+}
+
+// DelayMessages delays delivery of all messages sent by the node by the given
+// duration.
+func (n *Node) DelayMessages(delay time.Duration) {
+	n.harness.updateFaults(func() {
+		n.delay = delay
+	})
+}
+
+// Restore reconnects the node to the network and removes the delay of its
+// messages.
+func (n *Node) Restore() {
+	n.harness.updateFaults(func() {
+		n.dropped = false
+		n.delay = 0
+	})
+}
+
+// updateFaults changes network faults of nodes with the given function and
+// updates fault policies of all nodes accordingly. A dropped node does not
+// receive any messages and messages it sends are not received by other nodes.
+// Messages sent by a delayed node are received by other nodes once the delay
+// elapses.
+func (h *Harness) updateFaults(update func()) {
+	h.faultsMutex.Lock()
+	defer h.faultsMutex.Unlock()
+
+	update()
+
+	for _, receiver := range h.nodes {
+		var rules []netfault.Rule
+
+		if receiver.dropped {
+			rules = append(rules, netfault.Rule{DropRate: 1})
+		}
+
+		for _, sender := range h.nodes {
+			if sender == receiver {
+				continue
+			}
+
+			senders := []*key.NetworkPublic{sender.networkPublicKey}
+			if sender.dropped {
+				rules = append(rules, netfault.Rule{
+					Senders:  senders,
+					DropRate: 1,
+				})
+			} else if sender.delay > 0 {
+				rules = append(rules, netfault.Rule{
+					Senders: senders,
+					Delay:   sender.delay,
+				})
+			}
+		}
+
+		receiver.networkProvider.SetPolicy(&netfault.Policy{Rules: rules})
+	}
+}
+
+// HasSigner checks if the node stores a signer of the keep.
+func (n *Node) HasSigner(keepAddress common.Address) bool {
+	return directoryExists(filepath.Join(n.dataDir, "current", keepAddress.Hex()))
+}
+
+// IsKeepArchived checks if the node archived its signer of the keep.
+func (n *Node) IsKeepArchived(keepAddress common.Address) bool {
+	return directoryExists(filepath.Join(n.dataDir, "archive", keepAddress.Hex()))
+}
+
+// TSSPreParamsPoolSize returns the current size of the node's TSS
+// pre-parameters pool.
+func (n *Node) TSSPreParamsPoolSize() int {
+	return n.client.TSSPreParamsPoolSize()
+}
+
+// await executes the check until it passes or the context is done.
+func await(ctx context.Context, check func() (bool, error)) error {
+	ticker := time.NewTicker(awaitCheckTick)
+	defer ticker.Stop()
+
+	for {
+		passed, err := check()
+		if err != nil {
+			return err
+		}
+
+		if passed {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func unmarshalPublicKey(publicKey []byte) (*cecdsa.PublicKey, error) {
+	// The chain stores the public key without the uncompressed point prefix.
+	// Operator key functions are used instead of `go-ethereum/crypto` so that
+	// the harness can be built with the `celo` build tag.
+	operatorPublicKey, err := operator.Unmarshal(
+		append([]byte{0x04}, publicKey...),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return (*cecdsa.PublicKey)(operatorPublicKey), nil
+}
+
+func isMember(members []chain.ID, operatorAddress common.Address) bool {
+	for _, member := range members {
+		if common.HexToAddress(member.String()) == operatorAddress {
+			return true
+		}
+	}
+
+	return false
+}
+
+func directoryExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+func randomAddress() common.Address {
+	var address common.Address
+	// #nosec G404 (insecure random number source (rand))
+	// Harness addresses don't require secure randomness.
+	rand.Read(address[:])
+	return address
+}
@@ -0,0 +1,207 @@
+package harness
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+)
+
+const (
+	nodesCount = 3
+
+	testTimeout = 3 * time.Minute
+)
+
+func TestKeepLifecycle(t *testing.T) {
+	ctx, harness := newTestHarness(t)
+
+	keepAddress := harness.OpenKeep()
+
+	if _, err := harness.AwaitPublicKey(ctx, keepAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := harness.AwaitSigners(ctx, keepAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	digest := [32]byte{1, 2, 3}
+
+	if err := harness.RequestSignature(keepAddress, digest); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := harness.AwaitSignature(ctx, keepAddress, digest); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := harness.CloseKeep(keepAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := harness.AwaitKeepArchived(ctx, keepAddress); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDepositRedemption(t *testing.T) {
+	ctx, harness := newTestHarness(t)
+
+	depositAddress := randomAddress().Hex()
+
+	keepAddress, err := harness.CreateDeposit(depositAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := harness.AwaitPublicKey(ctx, keepAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := harness.AwaitSigners(ctx, keepAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	tbtcChain := harness.TBTC()
+
+	if err := tbtcChain.RetrieveSignerPubkey(depositAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	tbtcChain.FundDeposit(depositAddress)
+
+	if err := tbtcChain.RedeemDeposit(depositAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	redemptionRequests, err := tbtcChain.PastDepositRedemptionRequestedEvents(
+		0,
+		depositAddress,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	redemptionDigest := redemptionRequests[len(redemptionRequests)-1].Digest
+
+	signature, err := harness.AwaitSignature(ctx, keepAddress, redemptionDigest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = tbtcChain.ProvideRedemptionSignature(
+		depositAddress,
+		signature.RecoveryID+27,
+		signature.R,
+		signature.S,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = harness.AwaitDepositState(
+		ctx,
+		depositAddress,
+		chain.AwaitingWithdrawalProof,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDroppedNode(t *testing.T) {
+	ctx, harness := newTestHarness(t)
+
+	keepAddress := harness.OpenKeep()
+
+	if err := harness.AwaitSigners(ctx, keepAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	harness.Node(nodesCount - 1).Drop()
+
+	digest := [32]byte{4, 5, 6}
+
+	if err := harness.RequestSignature(keepAddress, digest); err != nil {
+		t.Fatal(err)
+	}
+
+	// All members have to take part in signing so the signature must not be
+	// calculated without the dropped node.
+	signingCtx, cancelSigningCtx := context.WithTimeout(ctx, 20*time.Second)
+	defer cancelSigningCtx()
+
+	if _, err := harness.AwaitSignature(signingCtx, keepAddress, digest); err == nil {
+		t.Fatal("signature calculated without the dropped node")
+	}
+
+	if err := harness.TerminateKeep(keepAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, node := range harness.Nodes() {
+		keep, err := node.Chain().GetKeepWithID(
+			keepID(t, harness, keepAddress.Hex()),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		isActive, err := keep.IsActive()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if isActive {
+			t.Errorf("keep should not be active for node [%v]", i)
+		}
+	}
+}
+
+func TestDelayedMessages(t *testing.T) {
+	ctx, harness := newTestHarness(t)
+
+	harness.Node(0).DelayMessages(500 * time.Millisecond)
+
+	keepAddress := harness.OpenKeep()
+
+	if err := harness.AwaitSigners(ctx, keepAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	digest := [32]byte{7, 8, 9}
+
+	if err := harness.RequestSignature(keepAddress, digest); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := harness.AwaitSignature(ctx, keepAddress, digest); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newTestHarness(t *testing.T) (context.Context, *Harness) {
+	if testing.Short() {
+		t.Skip("skipping harness test in short mode")
+	}
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), testTimeout)
+	t.Cleanup(cancelCtx)
+
+	harness, err := New(ctx, nodesCount, t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return ctx, harness
+}
+
+func keepID(t *testing.T, harness *Harness, keepAddress string) chain.ID {
+	keepID, err := harness.Chain().UnmarshalID(keepAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return keepID
+}
@@ -0,0 +1,203 @@
+package netfault
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-core/pkg/net/key"
+)
+
+// Policy determines faults injected into messages delivered to a node.
+//
+// Each delivered message is matched against the rules in the order they are
+// defined and the first matching rule decides about faults injected into the
+// delivery. Messages not matching any rule are delivered untouched.
+//
+// All random decisions are taken from a source initialized with the seed so
+// the same policy injects the same sequence of faults for the same sequence
+// of deliveries.
+type Policy struct {
+	Seed  int64
+	Rules []Rule
+}
+
+// Rule describes faults injected into deliveries matching the rule.
+type Rule struct {
+	// MessageTypes are types of messages the rule applies to, as returned
+	// by their `Type()` function. The rule applies to all messages if empty.
+	MessageTypes []string
+	// Senders are network public keys of peers whose messages the rule
+	// applies to. The rule applies to messages of all peers if empty.
+	Senders []*key.NetworkPublic
+
+	// DropRate is the probability of the message not being delivered.
+	DropRate float64
+	// CorruptRate is the probability of the message content being altered
+	// before the delivery. If the altered content could not be unmarshaled,
+	// the message is not delivered.
+	CorruptRate float64
+	// DuplicateRate is the probability of the message being delivered twice.
+	DuplicateRate float64
+	// ReorderRate is the probability of the message being held back for
+	// a random period of up to MaxReorderDelay, so that messages received
+	// later can be delivered before it.
+	ReorderRate     float64
+	MaxReorderDelay time.Duration
+	// Delay is the time each message is delivered after.
+	Delay time.Duration
+}
+
+// decision holds faults selected for a single delivery.
+type decision struct {
+	drop      bool
+	corrupt   bool
+	duplicate bool
+	reorder   bool
+	delay     time.Duration
+}
+
+// sender identifies the peer a delivered message comes from. Broadcast
+// messages are identified by the public key of the sender. Unicast messages
+// are identified by the transport identifier of the peer the unicast channel
+// is established with, as not all unicast channels, like the local one, set
+// the sender public key of received messages.
+type sender struct {
+	publicKey   []byte
+	transportID string
+}
+
+type compiledRule struct {
+	Rule
+
+	messageTypes       map[string]bool
+	senderPublicKeys   map[string]bool
+	senderTransportIDs map[string]bool
+}
+
+func (cr *compiledRule) matches(messageType string, sender *sender) bool {
+	if len(cr.messageTypes) > 0 && !cr.messageTypes[messageType] {
+		return false
+	}
+
+	if len(cr.Senders) == 0 {
+		return true
+	}
+
+	if sender.publicKey != nil {
+		return cr.senderPublicKeys[hex.EncodeToString(sender.publicKey)]
+	}
+
+	return cr.senderTransportIDs[sender.transportID]
+}
+
+// decider takes decisions about faults injected into deliveries according
+// to the policy. It is safe for concurrent use.
+type decider struct {
+	rules []*compiledRule
+
+	randomMutex sync.Mutex
+	random      *rand.Rand
+}
+
+func newDecider(
+	policy *Policy,
+	createTransportIdentifier func(ecdsa.PublicKey) (net.TransportIdentifier, error),
+) *decider {
+	rules := make([]*compiledRule, len(policy.Rules))
+	for i, rule := range policy.Rules {
+		compiled := &compiledRule{
+			Rule:               rule,
+			messageTypes:       make(map[string]bool, len(rule.MessageTypes)),
+			senderPublicKeys:   make(map[string]bool, len(rule.Senders)),
+			senderTransportIDs: make(map[string]bool, len(rule.Senders)),
+		}
+
+		for _, messageType := range rule.MessageTypes {
+			compiled.messageTypes[messageType] = true
+		}
+
+		for _, sender := range rule.Senders {
+			compiled.senderPublicKeys[hex.EncodeToString(key.Marshal(sender))] = true
+
+			transportID, err := createTransportIdentifier(ecdsa.PublicKey(*sender))
+			if err != nil {
+				logger.Errorf(
+					"rule will not match unicast messages of sender [%x]: [%v]",
+					key.Marshal(sender),
+					err,
+				)
+				continue
+			}
+
+			compiled.senderTransportIDs[transportID.String()] = true
+		}
+
+		rules[i] = compiled
+	}
+
+	return &decider{
+		rules: rules,
+		// #nosec G404 (insecure random number source (rand))
+		// Fault injection is deterministic on purpose and does not
+		// require secure randomness.
+		random: rand.New(rand.NewSource(policy.Seed)),
+	}
+}
+
+// decide selects faults for the delivery of a message of the given type sent
+// by the given peer. It returns nil if the message should be delivered
+// untouched.
+func (d *decider) decide(messageType string, sender *sender) *decision {
+	var rule *compiledRule
+	for _, candidate := range d.rules {
+		if candidate.matches(messageType, sender) {
+			rule = candidate
+			break
+		}
+	}
+
+	if rule == nil {
+		return nil
+	}
+
+	d.randomMutex.Lock()
+	defer d.randomMutex.Unlock()
+
+	result := &decision{
+		drop:      d.roll(rule.DropRate),
+		corrupt:   d.roll(rule.CorruptRate),
+		duplicate: d.roll(rule.DuplicateRate),
+		reorder:   d.roll(rule.ReorderRate) && rule.MaxReorderDelay > 0,
+		delay:     rule.Delay,
+	}
+
+	if result.reorder {
+		result.delay += time.Duration(d.random.Int63n(int64(rule.MaxReorderDelay)))
+	}
+
+	return result
+}
+
+// roll returns true with the given probability. Random number is drawn even
+// if the probability is zero so that the sequence of decisions taken for
+// the given seed does not depend on which faults are enabled.
+func (d *decider) roll(probability float64) bool {
+	return d.random.Float64() < probability
+}
+
+// corruptBytes flips a single random bit of the given bytes in place.
+func (d *decider) corruptBytes(bytes []byte) {
+	if len(bytes) == 0 {
+		return
+	}
+
+	d.randomMutex.Lock()
+	defer d.randomMutex.Unlock()
+
+	index := d.random.Intn(len(bytes))
+	bytes[index] ^= 1 << uint(d.random.Intn(8))
+}
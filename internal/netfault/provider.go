@@ -0,0 +1,290 @@
+// Package netfault provides a network provider wrapper injecting faults into
+// messages delivered to a node. It allows to drop, delay, duplicate, reorder
+// and corrupt deliveries from the chosen peers according to a seeded policy.
+// It is meant to be used in tests executed on the local network.
+package netfault
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-core/pkg/net"
+)
+
+var logger = log.Logger("keep-netfault")
+
+// Stats holds the number of faults injected by the provider.
+type Stats struct {
+	Dropped    uint64
+	Corrupted  uint64
+	Duplicated uint64
+	Reordered  uint64
+	Delayed    uint64
+}
+
+// Provider is a network provider injecting faults into messages received
+// by the node from broadcast and unicast channels. Faults are injected on
+// the receiving side, after broadcast retransmissions are filtered out, so
+// a dropped broadcast message is never delivered to the node.
+type Provider struct {
+	net.Provider
+
+	deciderMutex sync.RWMutex
+	decider      *decider
+
+	stats Stats
+}
+
+// Connect wraps the given network provider with a provider injecting faults
+// according to the given policy.
+func Connect(provider net.Provider, policy *Policy) *Provider {
+	return &Provider{
+		Provider: provider,
+		decider:  newDecider(policy, provider.CreateTransportIdentifier),
+	}
+}
+
+// SetPolicy replaces the policy of the provider. Messages received after the
+// call are subject to faults of the new policy; deliveries already delayed
+// are not affected.
+func (p *Provider) SetPolicy(policy *Policy) {
+	decider := newDecider(policy, p.Provider.CreateTransportIdentifier)
+
+	p.deciderMutex.Lock()
+	defer p.deciderMutex.Unlock()
+
+	p.decider = decider
+}
+
+func (p *Provider) currentDecider() *decider {
+	p.deciderMutex.RLock()
+	defer p.deciderMutex.RUnlock()
+
+	return p.decider
+}
+
+// Stats returns the number of faults injected so far.
+func (p *Provider) Stats() Stats {
+	return Stats{
+		Dropped:    atomic.LoadUint64(&p.stats.Dropped),
+		Corrupted:  atomic.LoadUint64(&p.stats.Corrupted),
+		Duplicated: atomic.LoadUint64(&p.stats.Duplicated),
+		Reordered:  atomic.LoadUint64(&p.stats.Reordered),
+		Delayed:    atomic.LoadUint64(&p.stats.Delayed),
+	}
+}
+
+// BroadcastChannelFor provides a broadcast channel injecting faults into
+// received messages.
+func (p *Provider) BroadcastChannelFor(name string) (net.BroadcastChannel, error) {
+	channel, err := p.Provider.BroadcastChannelFor(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &broadcastChannel{BroadcastChannel: channel, provider: p}, nil
+}
+
+// UnicastChannelWith provides a unicast channel with the given peer injecting
+// faults into received messages.
+func (p *Provider) UnicastChannelWith(
+	peerID net.TransportIdentifier,
+) (net.UnicastChannel, error) {
+	channel, err := p.Provider.UnicastChannelWith(peerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unicastChannel{
+		UnicastChannel: channel,
+		provider:       p,
+		peerID:         peerID,
+	}, nil
+}
+
+// OnUnicastChannelOpened registers a handler invoked with unicast channels
+// injecting faults into received messages.
+func (p *Provider) OnUnicastChannelOpened(
+	handler func(channel net.UnicastChannel),
+) {
+	p.Provider.OnUnicastChannelOpened(func(channel net.UnicastChannel) {
+		handler(&unicastChannel{UnicastChannel: channel, provider: p})
+	})
+}
+
+// receive wraps the handler so that faults are injected into messages before
+// they are passed to it. The handler is never called once the context is
+// done, even if the message delivery has been delayed.
+func (p *Provider) receive(
+	ctx context.Context,
+	handler func(message net.Message),
+	senderOf func(message net.Message) *sender,
+) func(message net.Message) {
+	return func(message net.Message) {
+		decision := p.currentDecider().decide(messageType(message), senderOf(message))
+		if decision == nil {
+			handler(message)
+			return
+		}
+
+		if decision.drop {
+			atomic.AddUint64(&p.stats.Dropped, 1)
+			return
+		}
+
+		if decision.corrupt {
+			corrupted, err := p.corrupt(message)
+			if err != nil {
+				logger.Debugf(
+					"dropping corrupted message of type [%v]: [%v]",
+					messageType(message),
+					err,
+				)
+				atomic.AddUint64(&p.stats.Dropped, 1)
+				return
+			}
+
+			atomic.AddUint64(&p.stats.Corrupted, 1)
+			message = corrupted
+		}
+
+		copies := 1
+		if decision.duplicate {
+			atomic.AddUint64(&p.stats.Duplicated, 1)
+			copies++
+		}
+
+		if decision.reorder {
+			atomic.AddUint64(&p.stats.Reordered, 1)
+		}
+
+		if decision.delay == 0 {
+			for i := 0; i < copies; i++ {
+				handler(message)
+			}
+			return
+		}
+
+		atomic.AddUint64(&p.stats.Delayed, 1)
+
+		go func() {
+			select {
+			case <-time.After(decision.delay):
+			case <-ctx.Done():
+				return
+			}
+
+			for i := 0; i < copies; i++ {
+				if ctx.Err() != nil {
+					return
+				}
+
+				handler(message)
+			}
+		}()
+	}
+}
+
+// messageType returns the type of the message payload. The type of the message
+// itself is not used as some channels, like the local broadcast channel, do not
+// set it to the payload type.
+func messageType(message net.Message) string {
+	if payload, ok := message.Payload().(net.TaggedMarshaler); ok {
+		return payload.Type()
+	}
+
+	return message.Type()
+}
+
+// corrupt returns a copy of the message with a single bit of its marshaled
+// payload flipped. The payload of the original message is not modified as it
+// may be shared with other receivers.
+func (p *Provider) corrupt(message net.Message) (net.Message, error) {
+	marshaler, ok := message.Payload().(net.TaggedMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("payload is not marshalable")
+	}
+
+	bytes, err := marshaler.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: [%v]", err)
+	}
+
+	p.currentDecider().corruptBytes(bytes)
+
+	payloadType := reflect.TypeOf(marshaler)
+	if payloadType.Kind() != reflect.Ptr {
+		return nil, fmt.Errorf("unsupported payload type [%v]", payloadType)
+	}
+
+	unmarshaler, ok := reflect.New(payloadType.Elem()).Interface().(net.TaggedUnmarshaler)
+	if !ok {
+		return nil, fmt.Errorf("payload is not unmarshalable")
+	}
+
+	if err := unmarshaler.Unmarshal(bytes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal payload: [%v]", err)
+	}
+
+	return &corruptedMessage{Message: message, payload: unmarshaler}, nil
+}
+
+type corruptedMessage struct {
+	net.Message
+
+	payload interface{}
+}
+
+func (cm *corruptedMessage) Payload() interface{} {
+	return cm.payload
+}
+
+type broadcastChannel struct {
+	net.BroadcastChannel
+
+	provider *Provider
+}
+
+func (bc *broadcastChannel) Recv(
+	ctx context.Context,
+	handler func(message net.Message),
+) {
+	bc.BroadcastChannel.Recv(ctx, bc.provider.receive(
+		ctx,
+		handler,
+		func(message net.Message) *sender {
+			return &sender{publicKey: message.SenderPublicKey()}
+		},
+	))
+}
+
+type unicastChannel struct {
+	net.UnicastChannel
+
+	provider *Provider
+	// peerID is the transport identifier of the peer the channel is
+	// established with. It is nil for channels opened by peers.
+	peerID net.TransportIdentifier
+}
+
+func (uc *unicastChannel) Recv(
+	ctx context.Context,
+	handler func(message net.Message),
+) {
+	uc.UnicastChannel.Recv(ctx, uc.provider.receive(
+		ctx,
+		handler,
+		func(message net.Message) *sender {
+			if uc.peerID != nil {
+				return &sender{transportID: uc.peerID.String()}
+			}
+
+			return &sender{transportID: message.TransportSenderID().String()}
+		},
+	))
+}
@@ -0,0 +1,407 @@
+package netfault
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-core/pkg/net/key"
+	"github.com/keep-network/keep-core/pkg/net/local"
+)
+
+const testMessageType = "netfault/test_message"
+
+type testMessage struct {
+	content []byte
+}
+
+func (tm *testMessage) Type() string {
+	return testMessageType
+}
+
+func (tm *testMessage) Marshal() ([]byte, error) {
+	return append([]byte{}, tm.content...), nil
+}
+
+func (tm *testMessage) Unmarshal(bytes []byte) error {
+	tm.content = append([]byte{}, bytes...)
+	return nil
+}
+
+func TestDropMessages(t *testing.T) {
+	sender, senderKey := newTestPeer(t)
+	otherSender, _ := newTestPeer(t)
+
+	receiver := Connect(newTestProvider(t), &Policy{
+		Rules: []Rule{
+			{Senders: []*key.NetworkPublic{senderKey}, DropRate: 1},
+		},
+	})
+
+	received := sendAndReceive(t, receiver, sender, otherSender)
+
+	expected := []string{"other-0", "other-1"}
+	if !reflect.DeepEqual(expected, received) {
+		t.Errorf(
+			"unexpected received messages\nexpected: [%v]\nactual:   [%v]",
+			expected,
+			received,
+		)
+	}
+
+	if stats := receiver.Stats(); stats.Dropped != 2 {
+		t.Errorf(
+			"unexpected number of dropped messages\nexpected: [%v]\nactual:   [%v]",
+			2,
+			stats.Dropped,
+		)
+	}
+}
+
+func TestSetPolicy(t *testing.T) {
+	sender, senderKey := newTestPeer(t)
+
+	receiver := Connect(newTestProvider(t), &Policy{
+		Rules: []Rule{
+			{Senders: []*key.NetworkPublic{senderKey}, DropRate: 1},
+		},
+	})
+
+	if received := sendAndReceive(t, receiver, sender); len(received) != 0 {
+		t.Errorf("unexpected received messages: [%v]", received)
+	}
+
+	receiver.SetPolicy(&Policy{})
+
+	received := sendAndReceive(t, receiver, sender)
+
+	expected := []string{"sender-0", "sender-1"}
+	if !reflect.DeepEqual(expected, received) {
+		t.Errorf(
+			"unexpected received messages\nexpected: [%v]\nactual:   [%v]",
+			expected,
+			received,
+		)
+	}
+}
+
+func TestDropUnicastMessages(t *testing.T) {
+	ctx, cancelCtx := context.WithTimeout(context.Background(), time.Second)
+	defer cancelCtx()
+
+	sender, senderKey := newTestPeer(t)
+	otherSender, otherSenderKey := newTestPeer(t)
+	_, receiverKey := newTestPeer(t)
+
+	receiver := Connect(local.ConnectWithKey(receiverKey), &Policy{
+		Rules: []Rule{
+			{Senders: []*key.NetworkPublic{senderKey}, DropRate: 1},
+		},
+	})
+
+	receivedMutex := sync.Mutex{}
+	received := []string{}
+
+	for i, peer := range []*testPeer{sender, otherSender} {
+		peerKey := []*key.NetworkPublic{senderKey, otherSenderKey}[i]
+
+		peerID, err := receiver.CreateTransportIdentifier(ecdsa.PublicKey(*peerKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		receiverChannel, err := receiver.UnicastChannelWith(peerID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		receiverChannel.SetUnmarshaler(func() net.TaggedUnmarshaler {
+			return &testMessage{}
+		})
+
+		receiverChannel.Recv(ctx, func(message net.Message) {
+			receivedMutex.Lock()
+			defer receivedMutex.Unlock()
+
+			received = append(
+				received,
+				string(message.Payload().(*testMessage).content),
+			)
+		})
+
+		receiverID, err := peer.provider.CreateTransportIdentifier(
+			ecdsa.PublicKey(*receiverKey),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		peerChannel, err := peer.provider.UnicastChannelWith(receiverID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = peerChannel.Send(&testMessage{
+			content: []byte(fmt.Sprintf("peer-%d", i)),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	<-ctx.Done()
+
+	receivedMutex.Lock()
+	defer receivedMutex.Unlock()
+
+	expected := []string{"peer-1"}
+	if !reflect.DeepEqual(expected, received) {
+		t.Errorf(
+			"unexpected received messages\nexpected: [%v]\nactual:   [%v]",
+			expected,
+			received,
+		)
+	}
+}
+
+func TestDuplicateMessages(t *testing.T) {
+	sender, _ := newTestPeer(t)
+
+	receiver := Connect(newTestProvider(t), &Policy{
+		Rules: []Rule{{DuplicateRate: 1}},
+	})
+
+	received := sendAndReceive(t, receiver, sender)
+
+	expected := []string{"sender-0", "sender-0", "sender-1", "sender-1"}
+	if !reflect.DeepEqual(expected, received) {
+		t.Errorf(
+			"unexpected received messages\nexpected: [%v]\nactual:   [%v]",
+			expected,
+			received,
+		)
+	}
+}
+
+func TestCorruptMessages(t *testing.T) {
+	sender, _ := newTestPeer(t)
+
+	receiver := Connect(newTestProvider(t), &Policy{
+		Rules: []Rule{{CorruptRate: 1}},
+	})
+
+	received := sendAndReceive(t, receiver, sender)
+
+	if len(received) != 2 {
+		t.Fatalf(
+			"unexpected number of received messages\nexpected: [%v]\nactual:   [%v]",
+			2,
+			len(received),
+		)
+	}
+
+	for i, content := range received {
+		original := fmt.Sprintf("sender-%d", i)
+
+		differentBits := 0
+		for j := range content {
+			for diff := content[j] ^ original[j]; diff != 0; diff &= diff - 1 {
+				differentBits++
+			}
+		}
+
+		if differentBits != 1 {
+			t.Errorf(
+				"expected exactly one bit flipped\noriginal:  [%v]\ncorrupted: [%v]",
+				original,
+				content,
+			)
+		}
+	}
+}
+
+func TestDelayMessages(t *testing.T) {
+	sender, _ := newTestPeer(t)
+
+	delay := 300 * time.Millisecond
+
+	receiver := Connect(newTestProvider(t), &Policy{
+		Rules: []Rule{{Delay: delay}},
+	})
+
+	start := time.Now()
+	received := sendAndReceive(t, receiver, sender)
+
+	if elapsed := time.Since(start); elapsed < delay {
+		t.Errorf("messages delivered before the delay: [%v]", elapsed)
+	}
+
+	expected := []string{"sender-0", "sender-1"}
+	if !reflect.DeepEqual(expected, received) {
+		t.Errorf(
+			"unexpected received messages\nexpected: [%v]\nactual:   [%v]",
+			expected,
+			received,
+		)
+	}
+}
+
+func TestRuleNotMatchingMessageType(t *testing.T) {
+	sender, _ := newTestPeer(t)
+
+	receiver := Connect(newTestProvider(t), &Policy{
+		Rules: []Rule{{MessageTypes: []string{"other"}, DropRate: 1}},
+	})
+
+	received := sendAndReceive(t, receiver, sender)
+
+	expected := []string{"sender-0", "sender-1"}
+	if !reflect.DeepEqual(expected, received) {
+		t.Errorf(
+			"unexpected received messages\nexpected: [%v]\nactual:   [%v]",
+			expected,
+			received,
+		)
+	}
+}
+
+func TestRuleMatchingMessageType(t *testing.T) {
+	sender, _ := newTestPeer(t)
+
+	receiver := Connect(newTestProvider(t), &Policy{
+		Rules: []Rule{{MessageTypes: []string{testMessageType}, DropRate: 1}},
+	})
+
+	received := sendAndReceive(t, receiver, sender)
+
+	if len(received) != 0 {
+		t.Errorf("unexpected received messages: [%v]", received)
+	}
+}
+
+func TestSeededDecisions(t *testing.T) {
+	policy := &Policy{
+		Seed: 1234,
+		Rules: []Rule{{
+			DropRate:        0.2,
+			CorruptRate:     0.2,
+			DuplicateRate:   0.2,
+			ReorderRate:     0.2,
+			MaxReorderDelay: time.Second,
+		}},
+	}
+
+	decide := func() []decision {
+		decider := newDecider(policy, newTestProvider(t).CreateTransportIdentifier)
+
+		decisions := make([]decision, 100)
+		for i := range decisions {
+			decisions[i] = *decider.decide(testMessageType, &sender{})
+		}
+
+		return decisions
+	}
+
+	if !reflect.DeepEqual(decide(), decide()) {
+		t.Errorf("decisions differ for the same seed")
+	}
+}
+
+// testPeer sends test messages on a broadcast channel.
+type testPeer struct {
+	provider net.Provider
+}
+
+func newTestPeer(t *testing.T) (*testPeer, *key.NetworkPublic) {
+	_, networkPublicKey, err := key.GenerateStaticNetworkKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testPeer{
+		provider: local.ConnectWithKey(networkPublicKey),
+	}, networkPublicKey
+}
+
+func newTestProvider(t *testing.T) net.Provider {
+	_, networkPublicKey, err := key.GenerateStaticNetworkKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return local.ConnectWithKey(networkPublicKey)
+}
+
+// sendAndReceive sends two messages from each of the senders over a broadcast
+// channel and returns contents of messages received by the receiver in the
+// order of delivery.
+func sendAndReceive(
+	t *testing.T,
+	receiver *Provider,
+	senders ...*testPeer,
+) []string {
+	ctx, cancelCtx := context.WithTimeout(context.Background(), time.Second)
+	defer cancelCtx()
+
+	channelName := fmt.Sprintf("netfault-test-%d", rand.Int())
+
+	receiverChannel, err := receiver.BroadcastChannelFor(channelName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	receivedMutex := sync.Mutex{}
+	received := []string{}
+
+	receiverChannel.Recv(ctx, func(message net.Message) {
+		receivedMutex.Lock()
+		defer receivedMutex.Unlock()
+
+		received = append(
+			received,
+			string(message.Payload().(*testMessage).content),
+		)
+	})
+
+	for i, sender := range senders {
+		name := "sender"
+		if i > 0 {
+			name = "other"
+		}
+
+		senderChannel, err := sender.provider.BroadcastChannelFor(channelName)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		senderChannel.SetUnmarshaler(func() net.TaggedUnmarshaler {
+			return &testMessage{}
+		})
+
+		for j := 0; j < 2; j++ {
+			err := senderChannel.Send(ctx, &testMessage{
+				content: []byte(fmt.Sprintf("%s-%d", name, j)),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Give the receiver time to handle the message so that messages
+			// are received in the order they were sent.
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	<-ctx.Done()
+
+	receivedMutex.Lock()
+	defer receivedMutex.Unlock()
+
+	return received
+}
@@ -12,13 +12,15 @@ import (
 )
 
 const (
-	testFixtureDirFormat  = "%s/tss"
-	testFixtureFileFormat = "keygen_data_%d.json"
+	testFixtureDirFormat   = "%s/tss"
+	testFixtureFileFormat  = "keygen_data_%d.json"
+	signerGoldenFileFormat = "signer_v%d.bin"
 )
 
 // LoadKeygenTestFixtures loads key generation test data.
 // Code copied from:
 //   https://github.com/binance-chain/tss-lib/blob/master/ecdsa/keygen/test_utils.go
+//
 // Test data JSON files copied from:
 //   https://github.com/binance-chain/tss-lib/tree/master/test/_ecdsa_fixtures
 func LoadKeygenTestFixtures(count int) ([]keygen.LocalPartySaveData, error) {
@@ -52,3 +54,27 @@ func makeTestFixtureFilePath(partyIndex int) string {
 	fixtureDirName := fmt.Sprintf(testFixtureDirFormat, srcDirName)
 	return fmt.Sprintf("%s/"+testFixtureFileFormat, fixtureDirName, partyIndex)
 }
+
+// LoadSignerGoldenFile loads a threshold signer marshalled with the given
+// format version. All golden files hold the same signer of member `member-0`
+// of group `golden-group` with members `member-0`, `member-1`, `member-2`,
+// dishonest threshold 2 and the threshold key of the first key generation
+// test fixture. Since format version 2, the signer records `ethereum` chain
+// and the creation time 2021-10-01T00:00:00Z.
+//
+// Golden files of older format versions must never be regenerated as they
+// hold encodings written by previous versions of the client.
+func LoadSignerGoldenFile(formatVersion uint32) ([]byte, error) {
+	_, callerFileName, _, _ := runtime.Caller(0)
+	srcDirName := filepath.Dir(callerFileName)
+	fixtureDirName := fmt.Sprintf(testFixtureDirFormat, srcDirName)
+	goldenFilePath := fmt.Sprintf(
+		"%s/"+signerGoldenFileFormat,
+		fixtureDirName,
+		formatVersion,
+	)
+
+	// #nosec G304 (file path provided as taint input)
+	// This line is used to read a golden file. There is no user input.
+	return ioutil.ReadFile(goldenFilePath)
+}
@@ -67,6 +67,11 @@ func (phm *PersistenceHandleMock) MockSigner(membershipIndex int, keepID string,
 	return nil
 }
 
+// MockFile registers a mock of a file with the given content for keep.
+func (phm *PersistenceHandleMock) MockFile(name string, keepID string, data []byte) {
+	phm.outputDataChan <- &testDataDescriptor{name, keepID, data}
+}
+
 // ReadAll reads all data stored in persistence handle.
 func (phm *PersistenceHandleMock) ReadAll() (<-chan persistence.DataDescriptor, <-chan error) {
 	close(phm.outputDataChan)
@@ -64,6 +64,13 @@ func main() {
 		cmd.ChainCLICommand,
 		cmd.SigningCommand,
 		cmd.ResolveBitcoinBeneficiaryAddressCommand,
+		cmd.LedgerCommand,
+		cmd.AttributionCommand,
+		cmd.RegistryCommand,
+		cmd.StorageCommand,
+		cmd.AuditCommand,
+		cmd.BondingCommand,
+		cmd.RewardsCommand,
 	}
 
 	err = app.Run(os.Args)
@@ -0,0 +1,183 @@
+// Package attribution implements a local record of failed TSS protocol
+// executions of keeps, attributed to the keep members who caused them.
+// Failures are stored per keep so repeated failures can be traced back to
+// specific operators.
+package attribution
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/keep-network/keep-ecdsa/pkg/utils/fileutil"
+)
+
+const (
+	directoryName = "attribution"
+	fileExtension = ".jsonl"
+)
+
+// Failure describes a single failed protocol execution of a keep. Culprits
+// are operators identified by the protocol as misbehaving. Missing operators
+// are those whose messages have not been received before the protocol timed
+// out.
+type Failure struct {
+	Timestamp        time.Time `json:"timestamp"`
+	Chain            string    `json:"chain"`
+	KeepID           string    `json:"keepId"`
+	Protocol         string    `json:"protocol"`
+	Attempt          int       `json:"attempt"`
+	Stage            string    `json:"stage"`
+	Round            int       `json:"round,omitempty"`
+	Culprits         []string  `json:"culprits,omitempty"`
+	MissingOperators []string  `json:"missingOperators,omitempty"`
+	Error            string    `json:"error"`
+}
+
+// Store keeps failures of each keep in a separate file under the data
+// directory. Each failure is stored as a separate JSON line.
+type Store struct {
+	directory string
+	mutex     sync.Mutex
+
+	recordedCount uint64
+}
+
+// Open opens the store located in the given data directory, creating the
+// store directory if it does not exist yet.
+func Open(dataDir string) (*Store, error) {
+	directory, err := fileutil.EnsureStoreDirectory(dataDir, directoryName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		directory: directory,
+	}, nil
+}
+
+// Record appends the failure to the failures of its keep.
+func (s *Store) Record(failure *Failure) error {
+	line, err := json.Marshal(failure)
+	if err != nil {
+		return fmt.Errorf("could not marshal failure: [%v]", err)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	file, err := os.OpenFile(
+		s.keepFilePath(failure.Chain, failure.KeepID),
+		os.O_APPEND|os.O_CREATE|os.O_WRONLY,
+		0600,
+	)
+	if err != nil {
+		return fmt.Errorf("could not open failures file: [%v]", err)
+	}
+	defer fileutil.CloseFile(file)
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("could not write failure: [%v]", err)
+	}
+
+	atomic.AddUint64(&s.recordedCount, 1)
+
+	return file.Sync()
+}
+
+// RecordedCount returns the number of failures recorded since the store has
+// been opened.
+func (s *Store) RecordedCount() uint64 {
+	return atomic.LoadUint64(&s.recordedCount)
+}
+
+// Failures returns failures recorded for the keep on the given chain, in
+// the order they were recorded.
+func (s *Store) Failures(chain string, keepID string) ([]*Failure, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return readFailures(s.keepFilePath(chain, keepID))
+}
+
+// AllFailures returns failures recorded for all keeps. Failures of each keep
+// are returned in the order they were recorded.
+func (s *Store) AllFailures() ([]*Failure, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	files, err := ioutil.ReadDir(s.directory)
+	if err != nil {
+		return nil, fmt.Errorf("could not read failures directory: [%v]", err)
+	}
+
+	failures := make([]*Failure, 0)
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), fileExtension) {
+			continue
+		}
+
+		keepFailures, err := readFailures(filepath.Join(s.directory, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		failures = append(failures, keepFailures...)
+	}
+
+	return failures, nil
+}
+
+func (s *Store) keepFilePath(chain string, keepID string) string {
+	fileName := strings.ToLower(keepID) + fileExtension
+	if len(chain) > 0 {
+		fileName = strings.ToLower(chain) + "_" + fileName
+	}
+
+	return filepath.Join(s.directory, fileName)
+}
+
+func readFailures(path string) ([]*Failure, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return []*Failure{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not open failures file: [%v]", err)
+	}
+	defer fileutil.CloseFile(file)
+
+	failures := make([]*Failure, 0)
+
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		failure := &Failure{}
+		if err := json.Unmarshal(scanner.Bytes(), failure); err != nil {
+			return nil, fmt.Errorf(
+				"could not unmarshal failure at line [%v] of [%v]: [%v]",
+				lineNumber,
+				path,
+				err,
+			)
+		}
+
+		failures = append(failures, failure)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read failures file: [%v]", err)
+	}
+
+	return failures, nil
+}
@@ -0,0 +1,117 @@
+package attribution
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestStore_RecordAndRead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "attribution")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	failures, err := store.AllFailures()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(failures) != 0 {
+		t.Fatalf("expected empty store, has [%v] failures", len(failures))
+	}
+
+	keepAFailures := []*Failure{
+		{
+			Timestamp: time.Unix(1600000000, 0).UTC(),
+			Chain:     "ethereum",
+			KeepID:    "0xA",
+			Protocol:  "key generation",
+			Attempt:   1,
+			Stage:     "key generation",
+			Round:     2,
+			Culprits:  []string{"0x01"},
+			Error:     "de-commitment verify failed",
+		},
+		{
+			Timestamp:        time.Unix(1600000100, 0).UTC(),
+			Chain:            "ethereum",
+			KeepID:           "0xA",
+			Protocol:         "key generation",
+			Attempt:          2,
+			Stage:            "readiness signaling",
+			MissingOperators: []string{"0x01", "0x02"},
+			Error:            "timeout exceeded",
+		},
+	}
+	keepBFailure := &Failure{
+		Timestamp:        time.Unix(1600000200, 0).UTC(),
+		Chain:            "ethereum",
+		KeepID:           "0xB",
+		Protocol:         "signing",
+		Attempt:          1,
+		Stage:            "signing",
+		Round:            5,
+		MissingOperators: []string{"0x02"},
+		Error:            "timeout exceeded",
+	}
+
+	for _, failure := range append(keepAFailures, keepBFailure) {
+		if err := store.Record(failure); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if store.RecordedCount() != 3 {
+		t.Errorf(
+			"unexpected recorded count\nexpected: [%v]\nactual:   [%v]",
+			3,
+			store.RecordedCount(),
+		)
+	}
+
+	// Open the store again to make sure failures are persisted.
+	store, err = Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	failures, err = store.Failures("ethereum", "0xA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(keepAFailures, failures) {
+		t.Errorf(
+			"unexpected keep failures\nexpected: [%+v]\nactual:   [%+v]",
+			keepAFailures,
+			failures,
+		)
+	}
+
+	failures, err = store.Failures("ethereum", "0xC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(failures) != 0 {
+		t.Errorf("expected no failures of unknown keep, has [%v]", len(failures))
+	}
+
+	failures, err = store.AllFailures()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(failures) != 3 {
+		t.Errorf(
+			"unexpected number of all failures\nexpected: [%v]\nactual:   [%v]",
+			3,
+			len(failures),
+		)
+	}
+}
@@ -0,0 +1,110 @@
+package attribution
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// OperatorSummary aggregates failures attributed to a single operator.
+type OperatorSummary struct {
+	Operator string   `json:"operator"`
+	Culprit  int      `json:"culprit"`
+	Missing  int      `json:"missing"`
+	Keeps    []string `json:"keeps"`
+}
+
+// Total returns the number of all failures attributed to the operator.
+func (os *OperatorSummary) Total() int {
+	return os.Culprit + os.Missing
+}
+
+// SummarizeByOperator aggregates failures per operator they are attributed
+// to. Returned summaries are sorted by the number of failures, descending.
+func SummarizeByOperator(failures []*Failure) []*OperatorSummary {
+	summaries := make(map[string]*OperatorSummary)
+	keeps := make(map[string]map[string]bool)
+
+	summaryOf := func(operator string, keepID string) *OperatorSummary {
+		operator = strings.ToLower(operator)
+
+		summary, ok := summaries[operator]
+		if !ok {
+			summary = &OperatorSummary{Operator: operator}
+			summaries[operator] = summary
+			keeps[operator] = make(map[string]bool)
+		}
+
+		if !keeps[operator][keepID] {
+			keeps[operator][keepID] = true
+			summary.Keeps = append(summary.Keeps, keepID)
+		}
+
+		return summary
+	}
+
+	for _, failure := range failures {
+		for _, culprit := range failure.Culprits {
+			summaryOf(culprit, failure.KeepID).Culprit++
+		}
+		for _, missing := range failure.MissingOperators {
+			summaryOf(missing, failure.KeepID).Missing++
+		}
+	}
+
+	result := make([]*OperatorSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		sort.Strings(summary.Keeps)
+		result = append(result, summary)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Total() != result[j].Total() {
+			return result[i].Total() > result[j].Total()
+		}
+		return result[i].Operator < result[j].Operator
+	})
+
+	return result
+}
+
+// WriteCSV writes operator summaries in the CSV format, with a header row.
+// Keeps of each operator are separated with semicolons.
+func WriteCSV(writer io.Writer, summaries []*OperatorSummary) error {
+	csvWriter := csv.NewWriter(writer)
+
+	err := csvWriter.Write([]string{
+		"operator",
+		"culprit",
+		"missing",
+		"keeps",
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, summary := range summaries {
+		err := csvWriter.Write([]string{
+			summary.Operator,
+			strconv.Itoa(summary.Culprit),
+			strconv.Itoa(summary.Missing),
+			strings.Join(summary.Keeps, ";"),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// WriteJSON writes operator summaries as an indented JSON array.
+func WriteJSON(writer io.Writer, summaries []*OperatorSummary) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(summaries)
+}
@@ -0,0 +1,54 @@
+package attribution
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestSummarizeByOperator(t *testing.T) {
+	failures := []*Failure{
+		{KeepID: "0xA", Culprits: []string{"0x01"}},
+		{KeepID: "0xA", MissingOperators: []string{"0x01", "0x02"}},
+		{KeepID: "0xB", MissingOperators: []string{"0X02"}},
+		{KeepID: "0xC", MissingOperators: []string{"0x02"}},
+	}
+
+	expectedSummaries := []*OperatorSummary{
+		{Operator: "0x02", Missing: 3, Keeps: []string{"0xA", "0xB", "0xC"}},
+		{Operator: "0x01", Culprit: 1, Missing: 1, Keeps: []string{"0xA"}},
+	}
+
+	summaries := SummarizeByOperator(failures)
+
+	if !reflect.DeepEqual(expectedSummaries, summaries) {
+		t.Errorf(
+			"unexpected summaries\nexpected: [%+v]\nactual:   [%+v]",
+			expectedSummaries,
+			summaries,
+		)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	summaries := []*OperatorSummary{
+		{Operator: "0x02", Missing: 3, Keeps: []string{"0xA", "0xB"}},
+		{Operator: "0x01", Culprit: 1, Keeps: []string{"0xA"}},
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := WriteCSV(buffer, summaries); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "operator,culprit,missing,keeps\n" +
+		"0x02,0,3,0xA;0xB\n" +
+		"0x01,1,0,0xA\n"
+	if expected != buffer.String() {
+		t.Errorf(
+			"unexpected report\nexpected: [%v]\nactual:   [%v]",
+			expected,
+			buffer.String(),
+		)
+	}
+}
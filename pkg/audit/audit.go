@@ -0,0 +1,130 @@
+// Package audit implements a tamper-evident log of signatures produced with
+// keep key shares. Each entry holds the hash of the previous entry, so
+// removing or modifying any entry breaks the chain of hashes of all entries
+// following it.
+package audit
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
+	"github.com/keep-network/keep-ecdsa/pkg/utils/fileutil"
+	"github.com/keep-network/keep-ecdsa/pkg/utils/hashchain"
+)
+
+const (
+	directoryName = "audit"
+	fileName      = "signatures.jsonl"
+)
+
+// Source determines how the signature has been requested.
+type Source string
+
+const (
+	// SourceSignatureRequested is the source of signatures requested on-chain
+	// from a keep.
+	SourceSignatureRequested Source = "signature-requested"
+	// SourceLiquidationRecovery is the source of signatures of liquidation
+	// recovery transactions of terminated keeps.
+	SourceLiquidationRecovery Source = "liquidation-recovery"
+	// SourceSignDigest is the source of signatures calculated out-of-band
+	// with the `signing sign-digest` command.
+	SourceSignDigest Source = "sign-digest"
+)
+
+// Signature is a signature recorded in the log.
+type Signature struct {
+	R          string `json:"r"`
+	S          string `json:"s"`
+	RecoveryID int    `json:"recoveryId"`
+}
+
+// NewSignature converts the signature to its hex-encoded form recorded in
+// the log.
+func NewSignature(signature *ecdsa.Signature) *Signature {
+	return &Signature{
+		R:          fmt.Sprintf("%064x", signature.R),
+		S:          fmt.Sprintf("%064x", signature.S),
+		RecoveryID: signature.RecoveryID,
+	}
+}
+
+// Entry describes a single produced signature. Request block and transaction
+// hash identify the on-chain event requesting the signature; they are empty
+// for signatures not requested on-chain and the transaction hash is empty if
+// the request has been found without its event. Co-signers are operators of
+// other keep members who took part in the signing.
+type Entry struct {
+	Timestamp              time.Time  `json:"timestamp"`
+	Source                 Source     `json:"source"`
+	Chain                  string     `json:"chain"`
+	KeepID                 string     `json:"keepId"`
+	Digest                 string     `json:"digest"`
+	RequestBlock           uint64     `json:"requestBlock,omitempty"`
+	RequestTransactionHash string     `json:"requestTransactionHash,omitempty"`
+	Signature              *Signature `json:"signature"`
+	CoSigners              []string   `json:"coSigners"`
+	hashchain.Link
+}
+
+// Log is an append-only log of produced signatures, kept as a file with one
+// JSON-encoded entry per line under the data directory.
+type Log struct {
+	file *hashchain.File
+}
+
+// Open opens the log located in the given data directory, creating the log
+// directory if it does not exist yet. Entries already in the log are not
+// verified, see Verify.
+func Open(dataDir string) (*Log, error) {
+	directory, err := fileutil.EnsureStoreDirectory(dataDir, directoryName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Log{
+		file: hashchain.NewFile(filepath.Join(directory, fileName)),
+	}, nil
+}
+
+// Append chains the entry to the last entry of the log and writes it to the
+// log file. The log file is locked while the entry is appended and the last
+// entry is read again if the file has been changed, so entries appended by
+// other processes sharing the data directory, like out-of-band signing
+// commands, are chained as well.
+func (l *Log) Append(entry *Entry) error {
+	if err := l.file.Append(entry); err != nil {
+		return fmt.Errorf("could not append entry to audit log: [%v]", err)
+	}
+
+	return nil
+}
+
+// Entries reads and verifies all entries of the log, in the order they were
+// appended. It fails if the chain of hashes of the entries is broken.
+func (l *Log) Entries() ([]*Entry, error) {
+	records, err := l.file.Records(func() hashchain.Record { return &Entry{} })
+	if err != nil {
+		return nil, fmt.Errorf("could not read audit log: [%v]", err)
+	}
+
+	entries := make([]*Entry, len(records))
+	for i, record := range records {
+		entries[i] = record.(*Entry)
+	}
+
+	return entries, nil
+}
+
+// Verify checks the chain of hashes of all entries of the log and returns
+// the number of verified entries.
+func (l *Log) Verify() (int, error) {
+	entries, err := l.Entries()
+	if err != nil {
+		return 0, err
+	}
+
+	return len(entries), nil
+}
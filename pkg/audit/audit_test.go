@@ -0,0 +1,191 @@
+package audit
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
+)
+
+func newTestEntry(keepID string, timestamp int64) *Entry {
+	return &Entry{
+		Timestamp:              time.Unix(timestamp, 0).UTC(),
+		Source:                 SourceSignatureRequested,
+		Chain:                  "ethereum",
+		KeepID:                 keepID,
+		Digest:                 strings.Repeat("ab", 32),
+		RequestBlock:           100,
+		RequestTransactionHash: "0x01",
+		Signature: NewSignature(&ecdsa.Signature{
+			R:          big.NewInt(10),
+			S:          big.NewInt(11),
+			RecoveryID: 1,
+		}),
+		CoSigners: []string{"0x02", "0x03"},
+	}
+}
+
+func TestLog_AppendAndVerify(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	auditLog, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := auditLog.Verify()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Fatalf("expected empty log, has [%v] entries", count)
+	}
+
+	appended := []*Entry{
+		newTestEntry("0xA", 1600000000),
+		newTestEntry("0xB", 1600000100),
+		newTestEntry("0xC", 1600000200),
+	}
+	if err := auditLog.Append(appended[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	// Entries appended through another handle, like the one of an out-of-band
+	// signing command, are chained as well.
+	otherLog, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := otherLog.Append(appended[1]); err != nil {
+		t.Fatal(err)
+	}
+
+	// The entry appended through the other handle is chained when the first
+	// handle appends again.
+	if err := auditLog.Append(appended[2]); err != nil {
+		t.Fatal(err)
+	}
+
+	if appended[0].PreviousHash != "" {
+		t.Errorf("unexpected previous hash of the first entry")
+	}
+	if appended[1].PreviousHash != appended[0].Hash {
+		t.Errorf(
+			"unexpected previous hash\nexpected: [%v]\nactual:   [%v]",
+			appended[0].Hash,
+			appended[1].PreviousHash,
+		)
+	}
+
+	entries, err := auditLog.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(appended, entries) {
+		t.Errorf(
+			"unexpected entries\nexpected: [%+v]\nactual:   [%+v]",
+			appended,
+			entries,
+		)
+	}
+
+	if entries[0].Signature.R != strings.Repeat("0", 63)+"a" {
+		t.Errorf("unexpected signature R: [%v]", entries[0].Signature.R)
+	}
+}
+
+func TestLog_Tampered(t *testing.T) {
+	var tests = map[string]struct {
+		tamper       func(lines []string) []string
+		expectedLine int
+	}{
+		"removed entry": {
+			tamper: func(lines []string) []string {
+				return append(lines[:1], lines[2:]...)
+			},
+			expectedLine: 2,
+		},
+		"modified entry": {
+			tamper: func(lines []string) []string {
+				lines[1] = strings.Replace(lines[1], "0xB", "0xC", 1)
+				return lines
+			},
+			expectedLine: 2,
+		},
+		"reordered entries": {
+			tamper: func(lines []string) []string {
+				lines[0], lines[1] = lines[1], lines[0]
+				return lines
+			},
+			expectedLine: 1,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "audit")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+
+			auditLog, err := Open(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for i, keepID := range []string{"0xA", "0xB", "0xC"} {
+				err := auditLog.Append(newTestEntry(keepID, int64(1600000000+i)))
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			path := filepath.Join(dir, directoryName, fileName)
+			content, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			lines := test.tamper(
+				strings.Split(strings.TrimSpace(string(content)), "\n"),
+			)
+			err = ioutil.WriteFile(
+				path,
+				[]byte(strings.Join(lines, "\n")+"\n"),
+				0600,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = auditLog.Verify()
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			expectedError := fmt.Sprintf(
+				"chain of hashes is broken at line [%d]",
+				test.expectedLine,
+			)
+			if !strings.Contains(err.Error(), expectedError) {
+				t.Errorf(
+					"unexpected error\nexpected: [%v]\nactual:   [%v]",
+					expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package audit
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WriteCSV writes entries in the CSV format, with a header row. Co-signers of
+// each entry are separated with semicolons.
+func WriteCSV(writer io.Writer, entries []*Entry) error {
+	csvWriter := csv.NewWriter(writer)
+
+	err := csvWriter.Write([]string{
+		"timestamp",
+		"source",
+		"chain",
+		"keep",
+		"digest",
+		"request_block",
+		"request_transaction_hash",
+		"signature_r",
+		"signature_s",
+		"signature_recovery_id",
+		"co_signers",
+		"previous_hash",
+		"hash",
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		requestBlock := ""
+		if entry.RequestBlock > 0 {
+			requestBlock = strconv.FormatUint(entry.RequestBlock, 10)
+		}
+
+		signature := entry.Signature
+		if signature == nil {
+			signature = &Signature{}
+		}
+
+		err := csvWriter.Write([]string{
+			entry.Timestamp.Format(time.RFC3339),
+			string(entry.Source),
+			entry.Chain,
+			entry.KeepID,
+			entry.Digest,
+			requestBlock,
+			entry.RequestTransactionHash,
+			signature.R,
+			signature.S,
+			strconv.Itoa(signature.RecoveryID),
+			strings.Join(entry.CoSigners, ";"),
+			entry.PreviousHash,
+			entry.Hash,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// WriteJSON writes entries as an indented JSON array.
+func WriteJSON(writer io.Writer, entries []*Entry) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(entries)
+}
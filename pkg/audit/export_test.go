@@ -0,0 +1,56 @@
+package audit
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-ecdsa/pkg/utils/hashchain"
+)
+
+func TestWriteCSV(t *testing.T) {
+	entries := []*Entry{
+		{
+			Timestamp:              time.Unix(1600000000, 0).UTC(),
+			Source:                 SourceSignatureRequested,
+			Chain:                  "ethereum",
+			KeepID:                 "0xA",
+			Digest:                 "ab",
+			RequestBlock:           100,
+			RequestTransactionHash: "0x01",
+			Signature:              &Signature{R: "0a", S: "0b", RecoveryID: 1},
+			CoSigners:              []string{"0x02", "0x03"},
+			Link:                   hashchain.Link{Hash: "h1"},
+		},
+		{
+			Timestamp: time.Unix(1600000100, 0).UTC(),
+			Source:    SourceSignDigest,
+			Chain:     "ethereum",
+			KeepID:    "0xA",
+			Digest:    "cd",
+			Signature: &Signature{R: "0c", S: "0d"},
+			CoSigners: []string{"0x01", "0x02", "0x03"},
+			Link:      hashchain.Link{PreviousHash: "h1", Hash: "h2"},
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := WriteCSV(buffer, entries); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "timestamp,source,chain,keep,digest,request_block," +
+		"request_transaction_hash,signature_r,signature_s," +
+		"signature_recovery_id,co_signers,previous_hash,hash\n" +
+		"2020-09-13T12:26:40Z,signature-requested,ethereum,0xA,ab,100," +
+		"0x01,0a,0b,1,0x02;0x03,,h1\n" +
+		"2020-09-13T12:28:20Z,sign-digest,ethereum,0xA,cd,,," +
+		"0c,0d,0,0x01;0x02;0x03,h1,h2\n"
+	if expected != buffer.String() {
+		t.Errorf(
+			"unexpected export\nexpected: [%v]\nactual:   [%v]",
+			expected,
+			buffer.String(),
+		)
+	}
+}
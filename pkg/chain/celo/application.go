@@ -0,0 +1,303 @@
+//+build celo
+
+package celo
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/celo-org/celo-blockchain/common"
+	"github.com/celo-org/celo-blockchain/core/types"
+	"github.com/keep-network/keep-common/pkg/chain/celo/celoutil"
+
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+)
+
+// candidatesPoolsContract is a keep factory contract maintaining signers'
+// pools of applications opening keeps with the factory.
+type candidatesPoolsContract interface {
+	RegisterMemberCandidate(
+		_application common.Address,
+		transactionOptions ...celoutil.TransactionOptions,
+	) (*types.Transaction, error)
+	RegisterMemberCandidateGasEstimate(
+		_application common.Address,
+	) (uint64, error)
+	UpdateOperatorStatus(
+		_operator common.Address,
+		_application common.Address,
+		transactionOptions ...celoutil.TransactionOptions,
+	) (*types.Transaction, error)
+	IsOperatorRegistered(
+		_operator common.Address,
+		_application common.Address,
+	) (bool, error)
+	IsOperatorEligible(
+		_operator common.Address,
+		_application common.Address,
+	) (bool, error)
+	IsOperatorUpToDate(
+		_operator common.Address,
+		_application common.Address,
+	) (bool, error)
+	GetSortitionPool(_application common.Address) (common.Address, error)
+}
+
+// bondingContract is a contract holding bonds of keeps opened by a keep
+// factory.
+type bondingContract interface {
+	AvailableUnbondedValue(
+		operator common.Address,
+		bondCreator common.Address,
+		authorizedSortitionPool common.Address,
+	) (*big.Int, error)
+}
+
+// keepFactoryContracts are contracts of a keep factory used to handle
+// applications opening keeps with the factory.
+type keepFactoryContracts struct {
+	// address is the address of the keep factory, the creator of bonds of
+	// its keeps.
+	address common.Address
+	// candidatesPools maintains signers' pools of the applications.
+	candidatesPools candidatesPoolsContract
+	// bonding holds bonds of keeps opened by the factory. It is nil if the
+	// bonding contract is not configured.
+	bonding bondingContract
+	// minimumBond returns the minimum bond the factory requires from
+	// operators joining signers' pools.
+	minimumBond func() (*big.Int, error)
+}
+
+// bondedECDSAKeepApplication represents an application opening keeps with
+// a keep factory, conforming to chain.BondedECDSAKeepApplicationHandle.
+type bondedECDSAKeepApplication struct {
+	chainHandle *celoChain
+
+	keepFactory *keepFactoryContracts
+
+	applicationAddress common.Address
+}
+
+func (cc *celoChain) newBondedECDSAKeepApplication(
+	keepFactory *keepFactoryContracts,
+	applicationAddress common.Address,
+) *bondedECDSAKeepApplication {
+	return &bondedECDSAKeepApplication{
+		chainHandle:        cc,
+		keepFactory:        keepFactory,
+		applicationAddress: applicationAddress,
+	}
+}
+
+// ApplicationHandle returns a handle for interacting with the factory on
+// behalf of the application with the given ID.
+func (cc *celoChain) ApplicationHandle(
+	applicationID chain.ID,
+) (chain.BondedECDSAKeepApplicationHandle, error) {
+	return cc.applicationHandle(cc.bondedECDSAKeepFactory, applicationID)
+}
+
+// applicationHandle returns a handle of the application with the given ID
+// opening keeps with the given keep factory. Returns an error if the factory
+// has no signers' pool for the application.
+func (cc *celoChain) applicationHandle(
+	keepFactory *keepFactoryContracts,
+	applicationID chain.ID,
+) (chain.BondedECDSAKeepApplicationHandle, error) {
+	applicationAddress, err := fromChainID(applicationID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"unable to interpret application ID [%v]: [%v]",
+			applicationID,
+			err,
+		)
+	}
+
+	// The factory reverts the call if there is no pool for the application.
+	if _, err := keepFactory.candidatesPools.GetSortitionPool(
+		applicationAddress,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"failed to get signers' pool of application [%v]: [%v]",
+			applicationAddress.String(),
+			err,
+		)
+	}
+
+	return cc.newBondedECDSAKeepApplication(
+		keepFactory,
+		applicationAddress,
+	), nil
+}
+
+// GetKeepApplication returns the ID of the application which opened the keep
+// with the given ID. The application is read from the event emitted by the
+// factory when the keep was created.
+func (cc *celoChain) GetKeepApplication(keepID chain.ID) (chain.ID, error) {
+	keepAddress, err := fromChainID(keepID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"unable to interpret keep ID [%v]: [%v]",
+			keepID,
+			err,
+		)
+	}
+
+	events, err := cc.bondedECDSAKeepFactoryContract.PastBondedECDSAKeepCreatedEvents(
+		0,
+		nil,
+		[]common.Address{keepAddress},
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get creation event of keep [%v]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
+
+	if len(events) == 0 {
+		return nil, fmt.Errorf(
+			"keep [%v] has not been created by the factory",
+			keepAddress.String(),
+		)
+	}
+
+	return celoChainID(events[0].Application), nil
+}
+
+func (bka *bondedECDSAKeepApplication) ID() chain.ID {
+	return celoChainID(bka.applicationAddress)
+}
+
+// RegisterAsMemberCandidate registers the operator as a candidate to be
+// selected to a keep. The transaction is of the low urgency class.
+func (bka *bondedECDSAKeepApplication) RegisterAsMemberCandidate() error {
+	gasEstimate, err :=
+		bka.keepFactory.candidatesPools.RegisterMemberCandidateGasEstimate(
+			bka.applicationAddress,
+		)
+	if err != nil {
+		return fmt.Errorf("failed to estimate gas [%v]", err)
+	}
+
+	// If we have multiple sortition pool join transactions queued - and that
+	// happens when multiple operators become eligible to join at the same time,
+	// e.g. after lowering the minimum bond requirement, transactions mined at
+	// the end may no longer have valid gas limits as they were estimated based
+	// on a different state of the pool. We add 20% safety margin to the original
+	// gas estimation to account for that.
+	gasEstimateWithMargin := float64(gasEstimate) * float64(1.2)
+	transaction, err := bka.keepFactory.candidatesPools.RegisterMemberCandidate(
+		bka.applicationAddress,
+		celoutil.TransactionOptions{
+			GasLimit: uint64(gasEstimateWithMargin),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	bka.chainHandle.recordTransaction(
+		"RegisterAsMemberCandidate",
+		"",
+		"",
+		transaction,
+	)
+
+	logger.Debugf(
+		"submitted RegisterMemberCandidate transaction with hash: [%s]",
+		transaction.Hash(),
+	)
+
+	return nil
+}
+
+// IsRegisteredForApplication checks if the operator is registered
+// as a signer candidate in the factory for the given application.
+func (bka *bondedECDSAKeepApplication) IsRegisteredForApplication() (bool, error) {
+	return bka.keepFactory.candidatesPools.IsOperatorRegistered(
+		bka.chainHandle.operatorAddress(),
+		bka.applicationAddress,
+	)
+}
+
+// IsEligibleForApplication checks if the operator is eligible to register
+// as a signer candidate for the given application.
+func (bka *bondedECDSAKeepApplication) IsEligibleForApplication() (bool, error) {
+	return bka.keepFactory.candidatesPools.IsOperatorEligible(
+		bka.chainHandle.operatorAddress(),
+		bka.applicationAddress,
+	)
+}
+
+// IsStatusUpToDateForApplication checks if the operator's status
+// is up to date in the signers' pool of the given application.
+func (bka *bondedECDSAKeepApplication) IsStatusUpToDateForApplication() (bool, error) {
+	return bka.keepFactory.candidatesPools.IsOperatorUpToDate(
+		bka.chainHandle.operatorAddress(),
+		bka.applicationAddress,
+	)
+}
+
+// UpdateStatusForApplication updates the operator's status in the signers'
+// pool for the given application. The transaction is of the low urgency class.
+func (bka *bondedECDSAKeepApplication) UpdateStatusForApplication() error {
+	transaction, err := bka.keepFactory.candidatesPools.UpdateOperatorStatus(
+		bka.chainHandle.operatorAddress(),
+		bka.applicationAddress,
+	)
+	if err != nil {
+		return err
+	}
+
+	bka.chainHandle.recordTransaction(
+		"UpdateStatusForApplication",
+		"",
+		"",
+		transaction,
+	)
+
+	logger.Debugf(
+		"submitted UpdateOperatorStatus transaction with hash: [%s]",
+		transaction.Hash(),
+	)
+
+	return nil
+}
+
+// MinimumBond returns the minimum bond the keep factory requires from the
+// operator to join the signers' pool of the given application.
+func (bka *bondedECDSAKeepApplication) MinimumBond() (*big.Int, error) {
+	return bka.keepFactory.minimumBond()
+}
+
+// AvailableUnbondedValue returns the operator's unbonded value available for
+// bonds in keeps of the given application.
+func (bka *bondedECDSAKeepApplication) AvailableUnbondedValue() (*big.Int, error) {
+	if bka.keepFactory.bonding == nil {
+		return nil, fmt.Errorf(
+			"bonding contract of the keep factory is not configured",
+		)
+	}
+
+	sortitionPoolAddress, err := bka.keepFactory.candidatesPools.GetSortitionPool(
+		bka.applicationAddress,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get signers' pool of application [%v]: [%v]",
+			bka.applicationAddress.String(),
+			err,
+		)
+	}
+
+	return bka.keepFactory.bonding.AvailableUnbondedValue(
+		bka.chainHandle.operatorAddress(),
+		bka.keepFactory.address,
+		sortitionPoolAddress,
+	)
+}
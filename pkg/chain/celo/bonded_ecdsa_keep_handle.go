@@ -3,6 +3,7 @@
 package celo
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sort"
@@ -14,15 +15,17 @@ import (
 	"github.com/keep-network/keep-common/pkg/chain/ethlike"
 	"github.com/keep-network/keep-common/pkg/subscription"
 	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/celo/abi"
 	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/celo/contract"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
 	"github.com/keep-network/keep-ecdsa/pkg/utils/byteutils"
 )
 
 type bondedEcdsaKeepHandle struct {
-	keepID     chain.ID
-	operatorID chain.ID
-	contract   *contract.BondedECDSAKeep
+	chainHandle *celoChain
+	keepID      chain.ID
+	operatorID  chain.ID
+	contract    *contract.BondedECDSAKeep
 }
 
 func (cc *celoChain) GetKeepWithID(
@@ -37,13 +40,15 @@ func (cc *celoChain) GetKeepWithID(
 		)
 	}
 
+	// Keep members submit only public keys and signatures to the keep
+	// contract, so all keep transactions are of the critical urgency class.
 	bondedECDSAKeepContract, err := contract.NewBondedECDSAKeep(
 		keepAddress,
 		cc.chainID,
 		cc.accountKey,
 		cc.client,
 		cc.nonceManager,
-		cc.miningWaiter,
+		cc.urgencyClasses[chain.UrgencyCritical].miningWaiter,
 		cc.blockCounter,
 		cc.transactionMutex,
 	)
@@ -52,9 +57,10 @@ func (cc *celoChain) GetKeepWithID(
 	}
 
 	return &bondedEcdsaKeepHandle{
-		keepID:     keepID,
-		operatorID: cc.OperatorID(),
-		contract:   bondedECDSAKeepContract,
+		chainHandle: cc,
+		keepID:      keepID,
+		operatorID:  cc.OperatorID(),
+		contract:    bondedECDSAKeepContract,
 	}, nil
 }
 
@@ -84,19 +90,35 @@ func (bekh *bondedEcdsaKeepHandle) ID() chain.ID {
 func (bekh *bondedEcdsaKeepHandle) OnSignatureRequested(
 	handler func(event *chain.SignatureRequestedEvent),
 ) (subscription.EventSubscription, error) {
-	onEvent := func(
-		Digest [32]uint8,
-		blockNumber uint64,
-	) {
-		handler(&chain.SignatureRequestedEvent{
-			Digest:      Digest,
-			BlockNumber: blockNumber,
-		})
-	}
-	return bekh.contract.SignatureRequested(
+	// The event is piped instead of being handled with OnEvent to preserve
+	// the hash of the requesting transaction.
+	eventChan := make(chan *abi.BondedECDSAKeepSignatureRequested)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(&chain.SignatureRequestedEvent{
+					Digest:          event.Digest,
+					BlockNumber:     event.Raw.BlockNumber,
+					TransactionHash: event.Raw.TxHash.Hex(),
+				})
+			}
+		}
+	}()
+
+	sub := bekh.contract.SignatureRequested(
 		nil,
 		nil,
-	).OnEvent(onEvent), nil
+	).Pipe(eventChan)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	}), nil
 }
 
 // OnConflictingPublicKeySubmitted installs a callback that is invoked when an
@@ -139,7 +161,7 @@ func (bekh *bondedEcdsaKeepHandle) OnPublicKeyPublished(
 }
 
 // SubmitKeepPublicKey submits a public key to a keep contract deployed under
-// a given address.
+// a given address. The transaction is of the critical urgency class.
 func (bekh *bondedEcdsaKeepHandle) SubmitKeepPublicKey(
 	publicKey [64]byte,
 ) error {
@@ -154,6 +176,13 @@ func (bekh *bondedEcdsaKeepHandle) SubmitKeepPublicKey(
 			return err
 		}
 
+		bekh.chainHandle.recordTransaction(
+			"SubmitKeepPublicKey",
+			bekh.ID().String(),
+			"",
+			transaction,
+		)
+
 		logger.Debugf(
 			"submitted SubmitPublicKey transaction with hash: [%s]",
 			transaction.Hash(),
@@ -174,7 +203,7 @@ func (bekh *bondedEcdsaKeepHandle) SubmitKeepPublicKey(
 }
 
 // SubmitSignature submits a signature to a keep contract deployed under a
-// given address.
+// given address. The transaction is of the critical urgency class.
 func (bekh *bondedEcdsaKeepHandle) SubmitSignature(
 	signature *ecdsa.Signature,
 ) error {
@@ -197,6 +226,13 @@ func (bekh *bondedEcdsaKeepHandle) SubmitSignature(
 		return err
 	}
 
+	bekh.chainHandle.recordTransaction(
+		"SubmitSignature",
+		bekh.ID().String(),
+		"",
+		transaction,
+	)
+
 	logger.Debugf(
 		"submitted SubmitSignature transaction with hash: [%s]",
 		transaction.Hash(),
@@ -385,3 +421,86 @@ func withRetry(fn func() error) error {
 		}
 	}
 }
+
+// GetMemberETHBalance returns the reward balance of the operator held by the
+// keep.
+func (bekh *bondedEcdsaKeepHandle) GetMemberETHBalance() (*big.Int, error) {
+	return bekh.contract.GetMemberETHBalance(
+		bekh.chainHandle.operatorAddress(),
+	)
+}
+
+// WithdrawETHRewardFeeEstimate returns the estimated fee of withdrawing the
+// operator's reward balance from the keep. The fee is the estimated gas of
+// the withdrawal multiplied by the gas price currently suggested by the
+// client. The estimation fails if the balance is zero.
+func (bekh *bondedEcdsaKeepHandle) WithdrawETHRewardFeeEstimate() (
+	*big.Int,
+	error,
+) {
+	gasEstimate, err := bekh.contract.WithdrawGasEstimate(
+		bekh.chainHandle.operatorAddress(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate withdrawal gas: [%v]", err)
+	}
+
+	gasPrice, err := bekh.chainHandle.client.SuggestGasPrice(
+		context.Background(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get suggested gas price: [%v]", err)
+	}
+
+	return new(big.Int).Mul(new(big.Int).SetUint64(gasEstimate), gasPrice), nil
+}
+
+// WithdrawETHReward withdraws the operator's reward balance held by the keep
+// to the operator's beneficiary. Rewards are withdrawn as a maintenance
+// operation, so unlike other keep transactions the transaction is of the low
+// urgency class.
+func (bekh *bondedEcdsaKeepHandle) WithdrawETHReward() error {
+	keepAddress, err := fromChainID(bekh.keepID)
+	if err != nil {
+		return fmt.Errorf(
+			"unable to interpret keep ID [%v]: [%v]",
+			bekh.keepID,
+			err,
+		)
+	}
+
+	lowUrgencyContract, err := contract.NewBondedECDSAKeep(
+		keepAddress,
+		bekh.chainHandle.chainID,
+		bekh.chainHandle.accountKey,
+		bekh.chainHandle.client,
+		bekh.chainHandle.nonceManager,
+		bekh.chainHandle.urgencyClasses[chain.UrgencyLow].miningWaiter,
+		bekh.chainHandle.blockCounter,
+		bekh.chainHandle.transactionMutex,
+	)
+	if err != nil {
+		return err
+	}
+
+	transaction, err := lowUrgencyContract.Withdraw(
+		bekh.chainHandle.operatorAddress(),
+	)
+	if err != nil {
+		return err
+	}
+
+	bekh.chainHandle.recordTransaction(
+		"WithdrawETHReward",
+		bekh.ID().String(),
+		"",
+		transaction,
+	)
+
+	logger.Debugf(
+		"submitted Withdraw transaction with hash: [%s]",
+		transaction.Hash(),
+	)
+
+	return nil
+}
@@ -0,0 +1,184 @@
+//+build celo
+
+package celo
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/celo-org/celo-blockchain/common"
+
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/celo/contract"
+)
+
+// keepBonding represents the bonding contract holding bonds of keeps opened
+// by the bonded ECDSA keep factory, conforming to chain.BondingHandle.
+type keepBonding struct {
+	chainHandle *celoChain
+
+	keepBondingContract *contract.KeepBonding
+}
+
+// Bonding returns a handle of the KeepBonding contract. Returns an error if
+// the KeepBonding contract address is not configured.
+func (cc *celoChain) Bonding() (chain.BondingHandle, error) {
+	if cc.keepBondingContract == nil {
+		return nil, fmt.Errorf("KeepBonding address unset")
+	}
+
+	return &keepBonding{
+		chainHandle:         cc,
+		keepBondingContract: cc.keepBondingContract,
+	}, nil
+}
+
+// UnbondedValue returns the operator's value deposited in the bonding
+// contract and not bonded in any keep.
+func (kb *keepBonding) UnbondedValue() (*big.Int, error) {
+	return kb.keepBondingContract.UnbondedValue(
+		kb.chainHandle.operatorAddress(),
+	)
+}
+
+// Authorizer returns the ID of the operator's authorizer.
+func (kb *keepBonding) Authorizer() (chain.ID, error) {
+	authorizer, err := kb.keepBondingContract.AuthorizerOf(
+		kb.chainHandle.operatorAddress(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return celoChainID(authorizer), nil
+}
+
+// Beneficiary returns the ID of the operator's beneficiary.
+func (kb *keepBonding) Beneficiary() (chain.ID, error) {
+	beneficiary, err := kb.keepBondingContract.BeneficiaryOf(
+		kb.chainHandle.operatorAddress(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return celoChainID(beneficiary), nil
+}
+
+// Deposit deposits the given value from the operator's account as the
+// operator's unbonded value. The transaction is of the normal urgency class.
+func (kb *keepBonding) Deposit(value *big.Int) error {
+	transaction, err := kb.keepBondingContract.Deposit(
+		kb.chainHandle.operatorAddress(),
+		value,
+	)
+	if err != nil {
+		return err
+	}
+
+	kb.chainHandle.recordTransaction("Deposit", "", "", transaction)
+
+	logger.Debugf(
+		"submitted Deposit transaction with hash: [%s]",
+		transaction.Hash(),
+	)
+
+	return nil
+}
+
+// Withdraw withdraws the given value of the operator's unbonded value to the
+// operator's beneficiary. The transaction is of the normal urgency class.
+func (kb *keepBonding) Withdraw(value *big.Int) error {
+	transaction, err := kb.keepBondingContract.Withdraw(
+		value,
+		kb.chainHandle.operatorAddress(),
+	)
+	if err != nil {
+		return err
+	}
+
+	kb.chainHandle.recordTransaction("Withdraw", "", "", transaction)
+
+	logger.Debugf(
+		"submitted Withdraw transaction with hash: [%s]",
+		transaction.Hash(),
+	)
+
+	return nil
+}
+
+// IsSortitionPoolAuthorized checks if the signers' pool of the application
+// with the given ID is authorized to use the operator's bonds.
+func (kb *keepBonding) IsSortitionPoolAuthorized(
+	applicationID chain.ID,
+) (bool, error) {
+	sortitionPoolAddress, err := kb.sortitionPoolAddress(applicationID)
+	if err != nil {
+		return false, err
+	}
+
+	return kb.keepBondingContract.HasSecondaryAuthorization(
+		kb.chainHandle.operatorAddress(),
+		sortitionPoolAddress,
+	)
+}
+
+// AuthorizeSortitionPool authorizes the signers' pool of the application with
+// the given ID to use the operator's bonds. The transaction is of the normal
+// urgency class.
+func (kb *keepBonding) AuthorizeSortitionPool(applicationID chain.ID) error {
+	sortitionPoolAddress, err := kb.sortitionPoolAddress(applicationID)
+	if err != nil {
+		return err
+	}
+
+	transaction, err := kb.keepBondingContract.AuthorizeSortitionPoolContract(
+		kb.chainHandle.operatorAddress(),
+		sortitionPoolAddress,
+	)
+	if err != nil {
+		return err
+	}
+
+	kb.chainHandle.recordTransaction(
+		"AuthorizeSortitionPool",
+		"",
+		"",
+		transaction,
+	)
+
+	logger.Debugf(
+		"submitted AuthorizeSortitionPoolContract transaction with hash: [%s]",
+		transaction.Hash(),
+	)
+
+	return nil
+}
+
+// sortitionPoolAddress returns the address of the signers' pool of the
+// application with the given ID in the bonded ECDSA keep factory.
+func (kb *keepBonding) sortitionPoolAddress(
+	applicationID chain.ID,
+) (common.Address, error) {
+	applicationAddress, err := fromChainID(applicationID)
+	if err != nil {
+		return common.Address{}, fmt.Errorf(
+			"unable to interpret application ID [%v]: [%v]",
+			applicationID,
+			err,
+		)
+	}
+
+	sortitionPoolAddress, err := kb.chainHandle.bondedECDSAKeepFactoryContract.GetSortitionPool(
+		applicationAddress,
+	)
+	if err != nil {
+		return common.Address{}, fmt.Errorf(
+			"failed to get signers' pool of application [%v]: [%v]",
+			applicationAddress.String(),
+			err,
+		)
+	}
+
+	return sortitionPoolAddress, nil
+}
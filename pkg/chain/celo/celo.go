@@ -66,7 +66,29 @@ func (cc *celoChain) BlockCounter() corechain.BlockCounter {
 func (cc *celoChain) OnBondedECDSAKeepCreated(
 	handler func(event *chain.BondedECDSAKeepCreatedEvent),
 ) subscription.EventSubscription {
-	onEvent := func(
+	return cc.bondedECDSAKeepFactoryContract.BondedECDSAKeepCreated(
+		nil,
+		nil,
+		nil,
+		nil,
+	).OnEvent(cc.keepCreatedEventHandler("BondedECDSAKeepCreated", handler))
+}
+
+// keepCreatedEventHandler converts keep creation events of the given name
+// emitted by keep factories to chain.BondedECDSAKeepCreatedEvent and passes
+// them to the handler.
+func (cc *celoChain) keepCreatedEventHandler(
+	eventName string,
+	handler func(event *chain.BondedECDSAKeepCreatedEvent),
+) func(
+	KeepAddress common.Address,
+	Members []common.Address,
+	Owner common.Address,
+	Application common.Address,
+	HonestThreshold *big.Int,
+	blockNumber uint64,
+) {
+	return func(
 		KeepAddress common.Address,
 		Members []common.Address,
 		Owner common.Address,
@@ -78,8 +100,9 @@ func (cc *celoChain) OnBondedECDSAKeepCreated(
 		if err != nil {
 			logger.Errorf(
 				"Failed to look up keep with address [%v] for "+
-					"BondedECDSAKeepCreated event at block [%v]: [%v].",
+					"%v event at block [%v]: [%v].",
 				KeepAddress,
+				eventName,
 				blockNumber,
 				err,
 			)
@@ -99,18 +122,12 @@ func (cc *celoChain) OnBondedECDSAKeepCreated(
 		handler(&chain.BondedECDSAKeepCreatedEvent{
 			Keep:                 keep,
 			MemberIDs:            memberIDs,
+			Application:          celoChainID(Application),
 			HonestThreshold:      HonestThreshold.Uint64(),
 			BlockNumber:          blockNumber,
 			ThisOperatorIsMember: thisOperatorIsMember,
 		})
 	}
-
-	return cc.bondedECDSAKeepFactoryContract.BondedECDSAKeepCreated(
-		nil,
-		nil,
-		nil,
-		nil,
-	).OnEvent(onEvent)
 }
 
 // HasMinimumStake returns true if the specified address is staked.  False will
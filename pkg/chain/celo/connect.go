@@ -20,12 +20,15 @@ import (
 	"github.com/keep-network/keep-common/pkg/chain/ethlike"
 	"github.com/keep-network/keep-ecdsa/pkg/chain"
 	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/celo/contract"
+	"github.com/keep-network/keep-ecdsa/pkg/ledger"
 )
 
 // Definitions of contract names.
 const (
-	BondedECDSAKeepFactoryContractName = "BondedECDSAKeepFactory"
-	TBTCSystemContractName             = "TBTCSystem"
+	BondedECDSAKeepFactoryContractName      = "BondedECDSAKeepFactory"
+	FullyBackedECDSAKeepFactoryContractName = "FullyBackedECDSAKeepFactory"
+	KeepBondingContractName                 = "KeepBonding"
+	TBTCSystemContractName                  = "TBTCSystem"
 )
 
 // celoChain is an implementation of Celo blockchain interface.
@@ -35,10 +38,15 @@ type celoChain struct {
 	client                         celoutil.CeloClient
 	chainID                        *big.Int
 	bondedECDSAKeepFactoryContract *contract.BondedECDSAKeepFactory
+	bondedECDSAKeepFactory         *keepFactoryContracts
+	fullyBackedKeepFactory         *fullyBackedKeepFactory
+	keepBondingContract            *contract.KeepBonding
 	tbtcSystemAddress              common.Address
 	blockCounter                   *ethlike.BlockCounter
-	miningWaiter                   *celoutil.MiningWaiter
+	urgencyClasses                 map[chain.Urgency]*urgencyClass
 	nonceManager                   *ethlike.NonceManager
+	ledger                         *ledger.Ledger
+	ledgerClient                   *ledgerClient
 
 	// transactionMutex allows interested parties to forcibly serialize
 	// transaction submission.
@@ -56,11 +64,16 @@ type celoChain struct {
 }
 
 // Connect performs initialization for communication with Celo blockchain
-// based on provided config.
+// based on provided config. Transactions are submitted with fee settings of
+// the urgency class of the given operation, as set in the urgency config.
+// If the transaction ledger is provided, gas and fees spent on all submitted
+// transactions are recorded in it.
 func Connect(
 	ctx context.Context,
 	accountKey *keystore.Key,
 	config *celo.Config,
+	urgencyConfig *chain.UrgencyConfig,
+	transactionLedger *ledger.Ledger,
 ) (chain.Handle, error) {
 	client, err := celoclient.Dial(config.URL)
 	if err != nil {
@@ -69,6 +82,12 @@ func Connect(
 
 	wrappedClient := addClientWrappers(config, client)
 
+	var transactionLedgerClient *ledgerClient
+	if transactionLedger != nil {
+		transactionLedgerClient = newLedgerClient(wrappedClient)
+		wrappedClient = transactionLedgerClient
+	}
+
 	transactionMutex := &sync.Mutex{}
 
 	chainID, err := client.ChainID(context.Background())
@@ -81,7 +100,7 @@ func Connect(
 
 	nonceManager := celoutil.NewNonceManager(wrappedClient, accountKey.Address)
 
-	miningWaiter := celoutil.NewMiningWaiter(wrappedClient, *config)
+	urgencyClasses := newUrgencyClasses(wrappedClient, config, urgencyConfig)
 
 	blockCounter, err := celoutil.NewBlockCounter(wrappedClient)
 	if err != nil {
@@ -109,13 +128,15 @@ func Connect(
 		return nil, err
 	}
 
+	// All transactions submitted to the factory are sortition pool
+	// maintenance operations, hence the low urgency class.
 	bondedECDSAKeepFactoryContract, err := contract.NewBondedECDSAKeepFactory(
 		bondedECDSAKeepFactoryContractAddress,
 		chainID,
 		accountKey,
 		wrappedClient,
 		nonceManager,
-		miningWaiter,
+		urgencyClasses[chain.UrgencyLow].miningWaiter,
 		blockCounter,
 		transactionMutex,
 	)
@@ -123,19 +144,85 @@ func Connect(
 		return nil, err
 	}
 
+	bondedECDSAKeepFactory := &keepFactoryContracts{
+		address:         bondedECDSAKeepFactoryContractAddress,
+		candidatesPools: bondedECDSAKeepFactoryContract,
+		minimumBond:     bondedECDSAKeepFactoryContract.MinimumBond,
+	}
+
+	// The bonding contract is optional; it is needed only to manage the
+	// operator's unbonded value. Bonding transactions are submitted on the
+	// operator's request, hence the normal urgency class.
+	var keepBondingContract *contract.KeepBonding
+	keepBondingContractAddress, err := config.ContractAddress(
+		KeepBondingContractName,
+	)
+	if err == nil {
+		keepBondingContract, err = contract.NewKeepBonding(
+			keepBondingContractAddress,
+			chainID,
+			accountKey,
+			wrappedClient,
+			nonceManager,
+			urgencyClasses[chain.UrgencyNormal].miningWaiter,
+			blockCounter,
+			transactionMutex,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		bondedECDSAKeepFactory.bonding = keepBondingContract
+	}
+
 	celo := &celoChain{
 		config:                         config,
 		accountKey:                     accountKey,
 		client:                         wrappedClient,
 		chainID:                        chainID,
 		bondedECDSAKeepFactoryContract: bondedECDSAKeepFactoryContract,
+		bondedECDSAKeepFactory:         bondedECDSAKeepFactory,
+		keepBondingContract:            keepBondingContract,
 		tbtcSystemAddress:              tbtcSystemAddress,
 		blockCounter:                   blockCounter,
 		nonceManager:                   nonceManager,
-		miningWaiter:                   miningWaiter,
+		urgencyClasses:                 urgencyClasses,
+		ledger:                         transactionLedger,
+		ledgerClient:                   transactionLedgerClient,
 		transactionMutex:               transactionMutex,
 	}
 
+	// The fully-backed keep factory is optional; the client watches it only
+	// if its address is configured.
+	fullyBackedECDSAKeepFactoryContractAddress, err := config.ContractAddress(
+		FullyBackedECDSAKeepFactoryContractName,
+	)
+	if err == nil {
+		fullyBackedECDSAKeepFactoryContract, err := contract.NewFullyBackedECDSAKeepFactory(
+			fullyBackedECDSAKeepFactoryContractAddress,
+			chainID,
+			accountKey,
+			wrappedClient,
+			nonceManager,
+			urgencyClasses[chain.UrgencyLow].miningWaiter,
+			blockCounter,
+			transactionMutex,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		celo.fullyBackedKeepFactory = &fullyBackedKeepFactory{
+			chainHandle:                         celo,
+			fullyBackedECDSAKeepFactoryContract: fullyBackedECDSAKeepFactoryContract,
+			keepFactory: &keepFactoryContracts{
+				address:         fullyBackedECDSAKeepFactoryContractAddress,
+				candidatesPools: fullyBackedECDSAKeepFactoryContract,
+				minimumBond:     fullyBackedECDSAKeepFactoryContract.DefaultMinimumBond,
+			},
+		}
+	}
+
 	celo.initializeBalanceMonitoring(ctx)
 
 	return celo, nil
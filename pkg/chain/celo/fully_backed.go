@@ -0,0 +1,159 @@
+//+build celo
+
+package celo
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/celo-org/celo-blockchain/common"
+
+	"github.com/keep-network/keep-common/pkg/subscription"
+
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/celo/contract"
+)
+
+// fullyBackedKeepFactory is an implementation of chain.BondedECDSAKeepFactory
+// for the fully-backed ECDSA keep factory. Members of fully-backed keeps are
+// secured only by their ETH bonds. Fully-backed keeps share the interface of
+// bonded ECDSA keeps, so they are handled by the same keep handle.
+type fullyBackedKeepFactory struct {
+	chainHandle *celoChain
+
+	fullyBackedECDSAKeepFactoryContract *contract.FullyBackedECDSAKeepFactory
+	keepFactory                         *keepFactoryContracts
+}
+
+// KeepFactories returns all keep factories the chain handle watches. The
+// bonded ECDSA keep factory is always the first one, followed by the
+// fully-backed ECDSA keep factory if it is configured.
+func (cc *celoChain) KeepFactories() []chain.BondedECDSAKeepFactory {
+	factories := []chain.BondedECDSAKeepFactory{cc}
+
+	if cc.fullyBackedKeepFactory != nil {
+		factories = append(factories, cc.fullyBackedKeepFactory)
+	}
+
+	return factories
+}
+
+// TBTCApplicationHandle returns an error as the tBTC application is handled
+// by the bonded ECDSA keep factory.
+func (fbkf *fullyBackedKeepFactory) TBTCApplicationHandle() (chain.TBTCHandle, error) {
+	return nil, fmt.Errorf(
+		"tBTC application is not handled by the fully-backed keep factory",
+	)
+}
+
+// ApplicationHandle returns a handle for interacting with the factory on
+// behalf of the application with the given ID.
+func (fbkf *fullyBackedKeepFactory) ApplicationHandle(
+	applicationID chain.ID,
+) (chain.BondedECDSAKeepApplicationHandle, error) {
+	return fbkf.chainHandle.applicationHandle(
+		fbkf.keepFactory,
+		applicationID,
+	)
+}
+
+// OnBondedECDSAKeepCreated installs a callback that is invoked when an on-chain
+// notification of a new fully-backed ECDSA keep creation is seen.
+func (fbkf *fullyBackedKeepFactory) OnBondedECDSAKeepCreated(
+	handler func(event *chain.BondedECDSAKeepCreatedEvent),
+) subscription.EventSubscription {
+	return fbkf.fullyBackedECDSAKeepFactoryContract.FullyBackedECDSAKeepCreated(
+		nil,
+		nil,
+		nil,
+		nil,
+	).OnEvent(fbkf.chainHandle.keepCreatedEventHandler(
+		"FullyBackedECDSAKeepCreated",
+		handler,
+	))
+}
+
+// IsOperatorAuthorized checks if the factory has the authorization to
+// operate on the bonded value of the provided operator.
+func (fbkf *fullyBackedKeepFactory) IsOperatorAuthorized(
+	operatorID chain.ID,
+) (bool, error) {
+	operatorAddress, err := fromChainID(operatorID)
+	if err != nil {
+		return false, err
+	}
+
+	return fbkf.fullyBackedECDSAKeepFactoryContract.IsOperatorAuthorized(
+		operatorAddress,
+	)
+}
+
+// GetKeepCount returns number of keeps opened by the factory.
+func (fbkf *fullyBackedKeepFactory) GetKeepCount() (*big.Int, error) {
+	return fbkf.fullyBackedECDSAKeepFactoryContract.GetKeepCount()
+}
+
+// GetKeepAtIndex returns a handle to the keep at the given index.
+func (fbkf *fullyBackedKeepFactory) GetKeepAtIndex(
+	keepIndex *big.Int,
+) (chain.BondedECDSAKeepHandle, error) {
+	keepAddress, err := fbkf.fullyBackedECDSAKeepFactoryContract.GetKeepAtIndex(
+		keepIndex,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to look up keep address for index [%v]: [%v]",
+			keepIndex,
+			err,
+		)
+	}
+
+	return fbkf.chainHandle.GetKeepWithID(celoChainID(keepAddress))
+}
+
+// GetKeepWithID returns a handle to the keep with the given ID.
+func (fbkf *fullyBackedKeepFactory) GetKeepWithID(
+	keepID chain.ID,
+) (chain.BondedECDSAKeepHandle, error) {
+	return fbkf.chainHandle.GetKeepWithID(keepID)
+}
+
+// GetKeepApplication returns the ID of the application which opened the keep
+// with the given ID. The application is read from the event emitted by the
+// factory when the keep was created.
+func (fbkf *fullyBackedKeepFactory) GetKeepApplication(
+	keepID chain.ID,
+) (chain.ID, error) {
+	keepAddress, err := fromChainID(keepID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"unable to interpret keep ID [%v]: [%v]",
+			keepID,
+			err,
+		)
+	}
+
+	events, err := fbkf.fullyBackedECDSAKeepFactoryContract.PastFullyBackedECDSAKeepCreatedEvents(
+		0,
+		nil,
+		[]common.Address{keepAddress},
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get creation event of keep [%v]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
+
+	if len(events) == 0 {
+		return nil, fmt.Errorf(
+			"keep [%v] has not been created by the factory",
+			keepAddress.String(),
+		)
+	}
+
+	return celoChainID(events[0].Application), nil
+}
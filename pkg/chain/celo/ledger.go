@@ -0,0 +1,154 @@
+//+build celo
+
+package celo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/celo-org/celo-blockchain/core/types"
+	"github.com/keep-network/keep-common/pkg/chain/celo/celoutil"
+	"github.com/keep-network/keep-ecdsa/pkg/ledger"
+)
+
+const (
+	// ledgerPollInterval is the interval in which receipts of transactions
+	// recorded in the ledger are checked.
+	ledgerPollInterval = 30 * time.Second
+	// ledgerTrackingTimeout is the maximum time the transaction is tracked
+	// before it is recorded in the ledger with an unknown outcome.
+	ledgerTrackingTimeout = 24 * time.Hour
+)
+
+// ledgerClient wraps the Celo client and remembers all transactions sent
+// for the given nonce. This allows to find the transaction that was actually
+// mined when the mining waiter resubmits the original transaction with a
+// higher fee.
+type ledgerClient struct {
+	celoutil.CeloClient
+
+	sentTransactionsMutex sync.Mutex
+	sentTransactions      map[uint64][]*types.Transaction
+}
+
+func newLedgerClient(client celoutil.CeloClient) *ledgerClient {
+	return &ledgerClient{
+		CeloClient:       client,
+		sentTransactions: make(map[uint64][]*types.Transaction),
+	}
+}
+
+func (lc *ledgerClient) SendTransaction(
+	ctx context.Context,
+	transaction *types.Transaction,
+) error {
+	if err := lc.CeloClient.SendTransaction(ctx, transaction); err != nil {
+		return err
+	}
+
+	lc.sentTransactionsMutex.Lock()
+	defer lc.sentTransactionsMutex.Unlock()
+
+	lc.sentTransactions[transaction.Nonce()] = append(
+		lc.sentTransactions[transaction.Nonce()],
+		transaction,
+	)
+
+	return nil
+}
+
+func (lc *ledgerClient) transactionsWithNonce(nonce uint64) []*types.Transaction {
+	lc.sentTransactionsMutex.Lock()
+	defer lc.sentTransactionsMutex.Unlock()
+
+	return append([]*types.Transaction{}, lc.sentTransactions[nonce]...)
+}
+
+func (lc *ledgerClient) forgetNonce(nonce uint64) {
+	lc.sentTransactionsMutex.Lock()
+	defer lc.sentTransactionsMutex.Unlock()
+
+	delete(lc.sentTransactions, nonce)
+}
+
+// recordTransaction tracks the submitted transaction in the background and,
+// once it is mined, appends its gas usage, effective gas price and outcome to
+// the ledger. If ledger is not configured, this function does nothing.
+func (cc *celoChain) recordTransaction(
+	operation string,
+	keepID string,
+	depositAddress string,
+	transaction *types.Transaction,
+) {
+	if cc.ledger == nil || cc.ledgerClient == nil {
+		return
+	}
+
+	go func() {
+		entry := &ledger.Entry{
+			Timestamp:       time.Now(),
+			Chain:           cc.Name(),
+			Operation:       operation,
+			KeepID:          keepID,
+			DepositAddress:  depositAddress,
+			TransactionHash: transaction.Hash().Hex(),
+			Outcome:         ledger.OutcomeUnknown,
+		}
+
+		cc.trackTransaction(entry, transaction.Nonce())
+
+		if err := cc.ledger.Append(entry); err != nil {
+			logger.Errorf(
+				"could not record transaction [%v] in the ledger: [%v]",
+				entry.TransactionHash,
+				err,
+			)
+		}
+	}()
+}
+
+// trackTransaction waits until any of the transactions sent with the given
+// nonce is mined and fills the entry with the mined transaction's details.
+func (cc *celoChain) trackTransaction(entry *ledger.Entry, nonce uint64) {
+	defer cc.ledgerClient.forgetNonce(nonce)
+
+	ctx, cancelCtx := context.WithTimeout(
+		context.Background(),
+		ledgerTrackingTimeout,
+	)
+	defer cancelCtx()
+
+	ticker := time.NewTicker(ledgerPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Warnf(
+				"could not determine outcome of transaction [%v]",
+				entry.TransactionHash,
+			)
+			return
+		case <-ticker.C:
+			for _, transaction := range cc.ledgerClient.transactionsWithNonce(nonce) {
+				receipt, err := cc.client.TransactionReceipt(ctx, transaction.Hash())
+				if err != nil || receipt == nil {
+					continue
+				}
+
+				entry.TransactionHash = transaction.Hash().Hex()
+				entry.GasUsed = receipt.GasUsed
+				entry.EffectiveGasPrice = transaction.GasPrice()
+
+				if receipt.Status == types.ReceiptStatusSuccessful {
+					entry.Outcome = ledger.OutcomeSucceeded
+				} else {
+					entry.Outcome = ledger.OutcomeReverted
+				}
+
+				return
+			}
+		}
+	}
+}
@@ -8,21 +8,16 @@ import (
 	"sort"
 
 	"github.com/celo-org/celo-blockchain/common"
-	"github.com/keep-network/keep-common/pkg/chain/celo/celoutil"
 	"github.com/keep-network/keep-common/pkg/subscription"
 	"github.com/keep-network/keep-ecdsa/pkg/chain"
-	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/celo/contract"
 	tbtcchain "github.com/keep-network/tbtc/pkg/chain/celo/gen/contract"
 )
 
 // tbtcApplication represents a tBTC application handle conforming to
 // chain.TBTCHandle.
 type tbtcApplication struct {
-	chainHandle *celoChain
+	*bondedECDSAKeepApplication
 
-	bondedECDSAKeepFactoryContract *contract.BondedECDSAKeepFactory
-
-	tbtcSystemAddress  common.Address
 	tbtcSystemContract *tbtcchain.TBTCSystem
 }
 
@@ -38,7 +33,7 @@ func (cc *celoChain) TBTCApplicationHandle() (chain.TBTCHandle, error) {
 		cc.accountKey,
 		cc.client,
 		cc.nonceManager,
-		cc.miningWaiter,
+		cc.urgencyClasses[chain.UrgencyNormal].miningWaiter,
 		cc.blockCounter,
 		cc.transactionMutex,
 	)
@@ -47,97 +42,14 @@ func (cc *celoChain) TBTCApplicationHandle() (chain.TBTCHandle, error) {
 	}
 
 	return &tbtcApplication{
-		chainHandle:                    cc,
-		bondedECDSAKeepFactoryContract: cc.bondedECDSAKeepFactoryContract,
-		tbtcSystemAddress:              cc.tbtcSystemAddress,
-		tbtcSystemContract:             tbtcSystemContract,
+		bondedECDSAKeepApplication: cc.newBondedECDSAKeepApplication(
+			cc.bondedECDSAKeepFactory,
+			cc.tbtcSystemAddress,
+		),
+		tbtcSystemContract: tbtcSystemContract,
 	}, nil
 }
 
-func (ta *tbtcApplication) ID() chain.ID {
-	return celoChainID(ta.tbtcSystemAddress)
-}
-
-func (ta *tbtcApplication) RegisterAsMemberCandidate() error {
-	gasEstimate, err :=
-		ta.bondedECDSAKeepFactoryContract.RegisterMemberCandidateGasEstimate(
-			ta.tbtcSystemAddress,
-		)
-	if err != nil {
-		return fmt.Errorf("failed to estimate gas [%v]", err)
-	}
-
-	// If we have multiple sortition pool join transactions queued - and that
-	// happens when multiple operators become eligible to join at the same time,
-	// e.g. after lowering the minimum bond requirement, transactions mined at
-	// the end may no longer have valid gas limits as they were estimated based
-	// on a different state of the pool. We add 20% safety margin to the original
-	// gas estimation to account for that.
-	gasEstimateWithMargin := float64(gasEstimate) * float64(1.2)
-	transaction, err := ta.bondedECDSAKeepFactoryContract.RegisterMemberCandidate(
-		ta.tbtcSystemAddress,
-		celoutil.TransactionOptions{
-			GasLimit: uint64(gasEstimateWithMargin),
-		},
-	)
-	if err != nil {
-		return err
-	}
-
-	logger.Debugf(
-		"submitted RegisterMemberCandidate transaction with hash: [%s]",
-		transaction.Hash(),
-	)
-
-	return nil
-}
-
-// IsRegisteredForApplication checks if the operator is registered
-// as a signer candidate in the factory for the given application.
-func (ta *tbtcApplication) IsRegisteredForApplication() (bool, error) {
-	return ta.bondedECDSAKeepFactoryContract.IsOperatorRegistered(
-		ta.chainHandle.operatorAddress(),
-		ta.tbtcSystemAddress,
-	)
-}
-
-// IsEligibleForApplication checks if the operator is eligible to register
-// as a signer candidate for the given application.
-func (ta *tbtcApplication) IsEligibleForApplication() (bool, error) {
-	return ta.bondedECDSAKeepFactoryContract.IsOperatorEligible(
-		ta.chainHandle.operatorAddress(),
-		ta.tbtcSystemAddress,
-	)
-}
-
-// IsStatusUpToDateForApplication checks if the operator's status
-// is up to date in the signers' pool of the given application.
-func (ta *tbtcApplication) IsStatusUpToDateForApplication() (bool, error) {
-	return ta.bondedECDSAKeepFactoryContract.IsOperatorUpToDate(
-		ta.chainHandle.operatorAddress(),
-		ta.tbtcSystemAddress,
-	)
-}
-
-// UpdateStatusForApplication updates the operator's status in the signers'
-// pool for the given application.
-func (ta *tbtcApplication) UpdateStatusForApplication() error {
-	transaction, err := ta.bondedECDSAKeepFactoryContract.UpdateOperatorStatus(
-		ta.chainHandle.operatorAddress(),
-		ta.tbtcSystemAddress,
-	)
-	if err != nil {
-		return err
-	}
-
-	logger.Debugf(
-		"submitted UpdateOperatorStatus transaction with hash: [%s]",
-		transaction.Hash(),
-	)
-
-	return nil
-}
-
 // OnDepositCreated installs a callback that is invoked when an
 // on-chain notification of a new deposit creation is seen.
 func (ta *tbtcApplication) OnDepositCreated(
@@ -296,7 +208,7 @@ func (ta *tbtcApplication) PastDepositRedemptionRequestedEvents(
 func (ta *tbtcApplication) Keep(
 	depositAddress string,
 ) (chain.BondedECDSAKeepHandle, error) {
-	deposit, err := ta.getDepositContract(depositAddress)
+	deposit, err := ta.getDepositContract(depositAddress, chain.UrgencyNormal)
 	if err != nil {
 		return nil, err
 	}
@@ -310,11 +222,11 @@ func (ta *tbtcApplication) Keep(
 }
 
 // RetrieveSignerPubkey retrieves the signer public key for the
-// provided deposit.
+// provided deposit. The transaction is of the normal urgency class.
 func (ta *tbtcApplication) RetrieveSignerPubkey(
 	depositAddress string,
 ) error {
-	deposit, err := ta.getDepositContract(depositAddress)
+	deposit, err := ta.getDepositContract(depositAddress, chain.UrgencyNormal)
 	if err != nil {
 		return err
 	}
@@ -324,6 +236,13 @@ func (ta *tbtcApplication) RetrieveSignerPubkey(
 		return err
 	}
 
+	ta.chainHandle.recordTransaction(
+		"RetrieveSignerPubkey",
+		"",
+		depositAddress,
+		transaction,
+	)
+
 	logger.Debugf(
 		"submitted RetrieveSignerPubkey transaction with hash: [%s]",
 		transaction.Hash(),
@@ -333,14 +252,14 @@ func (ta *tbtcApplication) RetrieveSignerPubkey(
 }
 
 // ProvideRedemptionSignature provides the redemption signature for the
-// provided deposit.
+// provided deposit. The transaction is of the critical urgency class.
 func (ta *tbtcApplication) ProvideRedemptionSignature(
 	depositAddress string,
 	v uint8,
 	r [32]uint8,
 	s [32]uint8,
 ) error {
-	deposit, err := ta.getDepositContract(depositAddress)
+	deposit, err := ta.getDepositContract(depositAddress, chain.UrgencyCritical)
 	if err != nil {
 		return err
 	}
@@ -350,6 +269,13 @@ func (ta *tbtcApplication) ProvideRedemptionSignature(
 		return err
 	}
 
+	ta.chainHandle.recordTransaction(
+		"ProvideRedemptionSignature",
+		"",
+		depositAddress,
+		transaction,
+	)
+
 	logger.Debugf(
 		"submitted ProvideRedemptionSignature transaction with hash: [%s]",
 		transaction.Hash(),
@@ -359,12 +285,13 @@ func (ta *tbtcApplication) ProvideRedemptionSignature(
 }
 
 // IncreaseRedemptionFee increases the redemption fee for the provided deposit.
+// The transaction is of the normal urgency class.
 func (ta *tbtcApplication) IncreaseRedemptionFee(
 	depositAddress string,
 	previousOutputValueBytes [8]uint8,
 	newOutputValueBytes [8]uint8,
 ) error {
-	deposit, err := ta.getDepositContract(depositAddress)
+	deposit, err := ta.getDepositContract(depositAddress, chain.UrgencyNormal)
 	if err != nil {
 		return err
 	}
@@ -377,6 +304,13 @@ func (ta *tbtcApplication) IncreaseRedemptionFee(
 		return err
 	}
 
+	ta.chainHandle.recordTransaction(
+		"IncreaseRedemptionFee",
+		"",
+		depositAddress,
+		transaction,
+	)
+
 	logger.Debugf(
 		"submitted IncreaseRedemptionFee transaction with hash: [%s]",
 		transaction.Hash(),
@@ -386,6 +320,7 @@ func (ta *tbtcApplication) IncreaseRedemptionFee(
 }
 
 // ProvideRedemptionProof provides the redemption proof for the provided deposit.
+// The transaction is of the critical urgency class.
 func (ta *tbtcApplication) ProvideRedemptionProof(
 	depositAddress string,
 	txVersion [4]uint8,
@@ -396,7 +331,7 @@ func (ta *tbtcApplication) ProvideRedemptionProof(
 	txIndexInBlock *big.Int,
 	bitcoinHeaders []uint8,
 ) error {
-	deposit, err := ta.getDepositContract(depositAddress)
+	deposit, err := ta.getDepositContract(depositAddress, chain.UrgencyCritical)
 	if err != nil {
 		return err
 	}
@@ -414,6 +349,13 @@ func (ta *tbtcApplication) ProvideRedemptionProof(
 		return err
 	}
 
+	ta.chainHandle.recordTransaction(
+		"ProvideRedemptionProof",
+		"",
+		depositAddress,
+		transaction,
+	)
+
 	logger.Debugf(
 		"submitted ProvideRedemptionProof transaction with hash: [%s]",
 		transaction.Hash(),
@@ -426,7 +368,7 @@ func (ta *tbtcApplication) ProvideRedemptionProof(
 func (ta *tbtcApplication) CurrentState(
 	depositAddress string,
 ) (chain.DepositState, error) {
-	deposit, err := ta.getDepositContract(depositAddress)
+	deposit, err := ta.getDepositContract(depositAddress, chain.UrgencyNormal)
 	if err != nil {
 		return 0, err
 	}
@@ -443,7 +385,7 @@ func (ta *tbtcApplication) CurrentState(
 func (ta *tbtcApplication) FundingInfo(
 	depositAddress string,
 ) (*chain.FundingInfo, error) {
-	deposit, err := ta.getDepositContract(depositAddress)
+	deposit, err := ta.getDepositContract(depositAddress, chain.UrgencyNormal)
 	if err != nil {
 		return nil, err
 	}
@@ -479,8 +421,11 @@ func (ta *tbtcApplication) FundingInfo(
 	}, nil
 }
 
+// getDepositContract returns a binding of the deposit contract submitting
+// transactions with the mining waiter of the given urgency class.
 func (ta *tbtcApplication) getDepositContract(
 	address string,
+	urgency chain.Urgency,
 ) (*tbtcchain.Deposit, error) {
 	if !common.IsHexAddress(address) {
 		return nil, fmt.Errorf("incorrect deposit contract address")
@@ -492,7 +437,7 @@ func (ta *tbtcApplication) getDepositContract(
 		ta.chainHandle.accountKey,
 		ta.chainHandle.client,
 		ta.chainHandle.nonceManager,
-		ta.chainHandle.miningWaiter,
+		ta.chainHandle.urgencyClasses[urgency].miningWaiter,
 		ta.chainHandle.blockCounter,
 		ta.chainHandle.transactionMutex,
 	)
@@ -0,0 +1,51 @@
+//+build celo
+
+package celo
+
+import (
+	"github.com/keep-network/keep-common/pkg/chain/celo"
+	"github.com/keep-network/keep-common/pkg/chain/celo/celoutil"
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+)
+
+// urgencyClass holds transaction submission components configured for
+// a single urgency class.
+type urgencyClass struct {
+	miningWaiter *celoutil.MiningWaiter
+}
+
+// newUrgencyClasses creates transaction submission components for all
+// urgency classes. Class-specific fee cap and mining check interval override
+// the global values from the Celo config. Celo does not support EIP-1559 so
+// the class fee cap works as the maximum gas price and the class priority fee
+// is ignored.
+func newUrgencyClasses(
+	client celoutil.CeloClient,
+	config *celo.Config,
+	urgencyConfig *chain.UrgencyConfig,
+) map[chain.Urgency]*urgencyClass {
+	classes := make(map[chain.Urgency]*urgencyClass)
+
+	for _, urgency := range chain.Urgencies {
+		classConfig := urgencyConfig.Class(urgency)
+
+		miningWaiterConfig := *config
+		if classConfig.MaxGasFeeCap != nil {
+			miningWaiterConfig.MaxGasPrice = celo.WrapWei(
+				classConfig.MaxGasFeeCap.Int,
+			)
+		}
+		if classConfig.MiningCheckInterval != 0 {
+			miningWaiterConfig.MiningCheckInterval =
+				classConfig.MiningCheckInterval
+		}
+
+		logger.Infof("configuring [%v] urgency transactions", urgency)
+
+		classes[urgency] = &urgencyClass{
+			miningWaiter: celoutil.NewMiningWaiter(client, miningWaiterConfig),
+		}
+	}
+
+	return classes
+}
@@ -82,18 +82,66 @@ type Handle interface {
 	// BlockTimestamp returns given block's timestamp.
 	// In case the block is not yet mined, an error should be returned.
 	BlockTimestamp(blockNumber *big.Int) (uint64, error)
+	// KeepFactories returns all keep factories of the chain the client
+	// operates on. The first factory is the one embedded in the handle.
+	KeepFactories() []BondedECDSAKeepFactory
+	// Bonding returns a handle of the bonding contract holding the operator's
+	// value available for bonds in keeps. Returns an error if the bonding
+	// contract is not configured for the chain.
+	Bonding() (BondingHandle, error)
 
 	BondedECDSAKeepFactory
 }
 
+// BondingHandle is an interface that provides ability to manage the operator's
+// value available for bonds in keeps opened by the bonded ECDSA keep factory.
+type BondingHandle interface {
+	// UnbondedValue returns the operator's value deposited in the bonding
+	// contract and not bonded in any keep.
+	UnbondedValue() (*big.Int, error)
+
+	// Authorizer returns the ID of the operator's authorizer. Only the
+	// authorizer can authorize sortition pools to use the operator's bonds.
+	Authorizer() (ID, error)
+
+	// Beneficiary returns the ID of the operator's beneficiary receiving the
+	// withdrawn unbonded value.
+	Beneficiary() (ID, error)
+
+	// Deposit deposits the given value from the operator's account as the
+	// operator's unbonded value. Submitted with UrgencyNormal.
+	Deposit(value *big.Int) error
+
+	// Withdraw withdraws the given value of the operator's unbonded value to
+	// the operator's beneficiary. Submitted with UrgencyNormal.
+	Withdraw(value *big.Int) error
+
+	// IsSortitionPoolAuthorized checks if the signers' pool of the
+	// application with the given ID is authorized to use the operator's
+	// bonds.
+	IsSortitionPoolAuthorized(applicationID ID) (bool, error)
+
+	// AuthorizeSortitionPool authorizes the signers' pool of the application
+	// with the given ID to use the operator's bonds. The transaction reverts
+	// if the operator is not its own authorizer. Submitted with UrgencyNormal.
+	AuthorizeSortitionPool(applicationID ID) error
+}
+
 // BondedECDSAKeepFactory is an interface that provides ability to interact with
-// BondedECDSAKeepFactory ethereum contracts.
+// keep factory contracts, such as BondedECDSAKeepFactory or
+// FullyBackedECDSAKeepFactory.
 type BondedECDSAKeepFactory interface {
 	// TBTCApplicationHandle returns a handle for interacting with the tBTC
 	// application associated with this BondedECDSAKeepManager. Returns nil with
 	// an error if no tBTC application exists for this manager.
 	TBTCApplicationHandle() (TBTCHandle, error)
 
+	// ApplicationHandle returns a handle for interacting with the factory on
+	// behalf of the application with the given ID, e.g. to register the
+	// operator in the application's signers' pool. Returns an error if the
+	// factory has no signers' pool for the application.
+	ApplicationHandle(applicationID ID) (BondedECDSAKeepApplicationHandle, error)
+
 	// OnBondedECDSAKeepCreated installs a callback that is invoked when an
 	// on-chain notification of a new bonded ECDSA keep creation is seen.
 	OnBondedECDSAKeepCreated(
@@ -111,6 +159,9 @@ type BondedECDSAKeepFactory interface {
 	GetKeepAtIndex(keepIndex *big.Int) (BondedECDSAKeepHandle, error)
 	// GetKeepWithID returns a handle to the keep with the given ID.
 	GetKeepWithID(keepID ID) (BondedECDSAKeepHandle, error)
+	// GetKeepApplication returns the ID of the application which opened the
+	// keep with the given ID.
+	GetKeepApplication(keepID ID) (ID, error)
 }
 
 // BondedECDSAKeepHandle is an interface that provides ability to interact with
@@ -142,11 +193,11 @@ type BondedECDSAKeepHandle interface {
 	) (subscription.EventSubscription, error)
 
 	// SubmitKeepPublicKey submits a 64-byte serialized public key to a keep
-	// contract deployed under a given address.
+	// contract deployed under a given address. Submitted with UrgencyCritical.
 	SubmitKeepPublicKey(publicKey [64]byte) error
 
 	// SubmitSignature submits a signature to a keep contract deployed under a
-	// given address.
+	// given address. Submitted with UrgencyCritical.
 	SubmitSignature(signature *ecdsa.Signature) error
 
 	// OnKeepClosed installs a callback that will be called on closing the
@@ -211,6 +262,22 @@ type BondedECDSAKeepHandle interface {
 	PastSignatureSubmittedEvents(
 		startBlock uint64,
 	) ([]*SignatureSubmittedEvent, error)
+
+	// GetMemberETHBalance returns the ETH reward balance of this instance's
+	// operator held by the keep.
+	GetMemberETHBalance() (*big.Int, error)
+
+	// WithdrawETHRewardFeeEstimate returns the estimated fee of withdrawing
+	// this instance's operator's ETH reward balance from the keep, in the
+	// smallest unit of the chain's native token. The fee is estimated with
+	// the fee caps the withdrawal is submitted with. The estimation fails if
+	// the balance is zero.
+	WithdrawETHRewardFeeEstimate() (*big.Int, error)
+
+	// WithdrawETHReward withdraws this instance's operator's ETH reward
+	// balance held by the keep to the operator's beneficiary. Submitted with
+	// UrgencyLow.
+	WithdrawETHReward() error
 }
 
 // BondedECDSAKeepApplicationHandle is a handle to a specific application that
@@ -225,7 +292,7 @@ type BondedECDSAKeepApplicationHandle interface {
 	ID() ID
 
 	// RegisterAsMemberCandidate registers this instance's operator as a
-	// candidate to be selected to a keep.
+	// candidate to be selected to a keep. Submitted with UrgencyLow.
 	RegisterAsMemberCandidate() error
 
 	// IsRegisteredForApplication checks if this instance's operator is
@@ -242,6 +309,18 @@ type BondedECDSAKeepApplicationHandle interface {
 	IsStatusUpToDateForApplication() (bool, error)
 
 	// UpdateStatusForApplication updates this instance's operator's status in
-	// the signers' pool for the given application.
+	// the signers' pool for the given application. Submitted with UrgencyLow.
 	UpdateStatusForApplication() error
+
+	// MinimumBond returns the minimum bond the signers' pool of the
+	// application requires from this instance's operator to join it. The
+	// minimum is set by the application and may differ from the default
+	// minimum bond of the keep factory.
+	MinimumBond() (*big.Int, error)
+
+	// AvailableUnbondedValue returns this instance's operator's unbonded
+	// value available for bonds in keeps of the application. The value is
+	// zero if the signers' pool of the application is not authorized to use
+	// the operator's bonds.
+	AvailableUnbondedValue() (*big.Int, error)
 }
@@ -0,0 +1,395 @@
+//+build !celo
+
+package ethereum
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/ethereum/contract"
+)
+
+// candidatesPoolsContract is a keep factory contract maintaining signers'
+// pools of applications opening keeps with the factory.
+type candidatesPoolsContract interface {
+	RegisterMemberCandidate(
+		_application common.Address,
+		transactionOptions ...ethutil.TransactionOptions,
+	) (*types.Transaction, error)
+	RegisterMemberCandidateGasEstimate(
+		_application common.Address,
+	) (uint64, error)
+	UpdateOperatorStatus(
+		_operator common.Address,
+		_application common.Address,
+		transactionOptions ...ethutil.TransactionOptions,
+	) (*types.Transaction, error)
+	IsOperatorRegistered(
+		_operator common.Address,
+		_application common.Address,
+	) (bool, error)
+	IsOperatorEligible(
+		_operator common.Address,
+		_application common.Address,
+	) (bool, error)
+	IsOperatorUpToDate(
+		_operator common.Address,
+		_application common.Address,
+	) (bool, error)
+	GetSortitionPool(_application common.Address) (common.Address, error)
+}
+
+// bondingContract is a contract holding bonds of keeps opened by a keep
+// factory.
+type bondingContract interface {
+	AvailableUnbondedValue(
+		operator common.Address,
+		bondCreator common.Address,
+		authorizedSortitionPool common.Address,
+	) (*big.Int, error)
+}
+
+// keepFactoryContracts are contracts of a keep factory used to handle
+// applications opening keeps with the factory.
+type keepFactoryContracts struct {
+	// address is the address of the keep factory, the creator of bonds of
+	// its keeps.
+	address common.Address
+	// candidatesPools maintains signers' pools of the applications.
+	candidatesPools candidatesPoolsContract
+	// bonding holds bonds of keeps opened by the factory. It is nil if the
+	// bonding contract is not configured.
+	bonding bondingContract
+	// pastKeepApplications reads applications from past creation events of
+	// the keep with the given address emitted by the factory.
+	pastKeepApplications func(keepAddress common.Address) ([]common.Address, error)
+
+	// keepApplications caches applications which opened keeps of the
+	// factory, mapped by keep addresses. The application of a keep never
+	// changes, so past creation events of a keep are looked up only if the
+	// creation event has not been seen by the client.
+	keepApplicationsMutex sync.Mutex
+	keepApplications      map[common.Address]common.Address
+}
+
+// keepApplication returns the application which opened the keep with the
+// given address. The application is looked up in past keep creation events of
+// the factory only if it is not cached yet.
+func (kfc *keepFactoryContracts) keepApplication(
+	keepAddress common.Address,
+) (common.Address, error) {
+	kfc.keepApplicationsMutex.Lock()
+	application, ok := kfc.keepApplications[keepAddress]
+	kfc.keepApplicationsMutex.Unlock()
+
+	if ok {
+		return application, nil
+	}
+
+	applications, err := kfc.pastKeepApplications(keepAddress)
+	if err != nil {
+		return common.Address{}, fmt.Errorf(
+			"failed to get creation event of keep [%v]: [%v]",
+			keepAddress.String(),
+			err,
+		)
+	}
+
+	if len(applications) == 0 {
+		return common.Address{}, fmt.Errorf(
+			"keep [%v] has not been created by the factory",
+			keepAddress.String(),
+		)
+	}
+
+	kfc.cacheKeepApplication(keepAddress, applications[0])
+
+	return applications[0], nil
+}
+
+// cacheKeepApplication caches the application which opened the keep with the
+// given address.
+func (kfc *keepFactoryContracts) cacheKeepApplication(
+	keepAddress common.Address,
+	application common.Address,
+) {
+	kfc.keepApplicationsMutex.Lock()
+	defer kfc.keepApplicationsMutex.Unlock()
+
+	if kfc.keepApplications == nil {
+		kfc.keepApplications = make(map[common.Address]common.Address)
+	}
+	kfc.keepApplications[keepAddress] = application
+}
+
+// bondedECDSAKeepApplication represents an application opening keeps with
+// a keep factory, conforming to chain.BondedECDSAKeepApplicationHandle.
+type bondedECDSAKeepApplication struct {
+	chainHandle *ethereumChain
+
+	keepFactory *keepFactoryContracts
+
+	applicationAddress common.Address
+}
+
+func (ec *ethereumChain) newBondedECDSAKeepApplication(
+	keepFactory *keepFactoryContracts,
+	applicationAddress common.Address,
+) *bondedECDSAKeepApplication {
+	return &bondedECDSAKeepApplication{
+		chainHandle:        ec,
+		keepFactory:        keepFactory,
+		applicationAddress: applicationAddress,
+	}
+}
+
+// ApplicationHandle returns a handle for interacting with the factory on
+// behalf of the application with the given ID.
+func (ec *ethereumChain) ApplicationHandle(
+	applicationID chain.ID,
+) (chain.BondedECDSAKeepApplicationHandle, error) {
+	return ec.applicationHandle(ec.bondedECDSAKeepFactory, applicationID)
+}
+
+// applicationHandle returns a handle of the application with the given ID
+// opening keeps with the given keep factory. Returns an error if the factory
+// has no signers' pool for the application.
+func (ec *ethereumChain) applicationHandle(
+	keepFactory *keepFactoryContracts,
+	applicationID chain.ID,
+) (chain.BondedECDSAKeepApplicationHandle, error) {
+	applicationAddress, err := fromChainID(applicationID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"unable to interpret application ID [%v]: [%v]",
+			applicationID,
+			err,
+		)
+	}
+
+	// The factory reverts the call if there is no pool for the application.
+	if _, err := keepFactory.candidatesPools.GetSortitionPool(
+		applicationAddress,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"failed to get signers' pool of application [%v]: [%v]",
+			applicationAddress.String(),
+			err,
+		)
+	}
+
+	return ec.newBondedECDSAKeepApplication(
+		keepFactory,
+		applicationAddress,
+	), nil
+}
+
+// GetKeepApplication returns the ID of the application which opened the keep
+// with the given ID. The application is read from the event emitted by the
+// factory when the keep was created and cached.
+func (ec *ethereumChain) GetKeepApplication(keepID chain.ID) (chain.ID, error) {
+	return ec.getKeepApplication(ec.bondedECDSAKeepFactory, keepID)
+}
+
+// getKeepApplication returns the ID of the application which opened the keep
+// with the given ID with the given keep factory.
+func (ec *ethereumChain) getKeepApplication(
+	keepFactory *keepFactoryContracts,
+	keepID chain.ID,
+) (chain.ID, error) {
+	keepAddress, err := fromChainID(keepID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"unable to interpret keep ID [%v]: [%v]",
+			keepID,
+			err,
+		)
+	}
+
+	application, err := keepFactory.keepApplication(keepAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return ec.toChainID(application), nil
+}
+
+// bondedECDSAKeepApplications returns a function reading applications from
+// past creation events of the keep with the given address emitted by the
+// bonded ECDSA keep factory.
+func bondedECDSAKeepApplications(
+	factoryContract *contract.BondedECDSAKeepFactory,
+) func(keepAddress common.Address) ([]common.Address, error) {
+	return func(keepAddress common.Address) ([]common.Address, error) {
+		events, err := factoryContract.PastBondedECDSAKeepCreatedEvents(
+			0,
+			nil,
+			[]common.Address{keepAddress},
+			nil,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		applications := make([]common.Address, len(events))
+		for i, event := range events {
+			applications[i] = event.Application
+		}
+
+		return applications, nil
+	}
+}
+
+func (bka *bondedECDSAKeepApplication) ID() chain.ID {
+	return bka.chainHandle.toChainID(bka.applicationAddress)
+}
+
+// RegisterAsMemberCandidate registers the operator as a candidate to be
+// selected to a keep. The transaction is of the low urgency class.
+func (bka *bondedECDSAKeepApplication) RegisterAsMemberCandidate() error {
+	gasEstimate, err :=
+		bka.keepFactory.candidatesPools.RegisterMemberCandidateGasEstimate(
+			bka.applicationAddress,
+		)
+	if err != nil {
+		return fmt.Errorf("failed to estimate gas [%v]", err)
+	}
+
+	// If we have multiple sortition pool join transactions queued - and that
+	// happens when multiple operators become eligible to join at the same time,
+	// e.g. after lowering the minimum bond requirement, transactions mined at
+	// the end may no longer have valid gas limits as they were estimated based
+	// on a different state of the pool. We add 20% safety margin to the original
+	// gas estimation to account for that.
+	gasEstimateWithMargin := float64(gasEstimate) * float64(1.2)
+	transaction, err := bka.keepFactory.candidatesPools.RegisterMemberCandidate(
+		bka.applicationAddress,
+		bka.chainHandle.transactionOptions(
+			chain.UrgencyLow,
+			uint64(gasEstimateWithMargin),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	bka.chainHandle.recordTransaction(
+		"RegisterAsMemberCandidate",
+		"",
+		"",
+		transaction,
+	)
+
+	logger.Debugf(
+		"submitted RegisterMemberCandidate transaction with hash: [%s]",
+		transaction.Hash(),
+	)
+
+	return nil
+}
+
+// IsRegisteredForApplication checks if the operator is registered
+// as a signer candidate in the factory for the given application.
+func (bka *bondedECDSAKeepApplication) IsRegisteredForApplication() (bool, error) {
+	return bka.keepFactory.candidatesPools.IsOperatorRegistered(
+		bka.chainHandle.operatorAddress(),
+		bka.applicationAddress,
+	)
+}
+
+// IsEligibleForApplication checks if the operator is eligible to register
+// as a signer candidate for the given application.
+func (bka *bondedECDSAKeepApplication) IsEligibleForApplication() (bool, error) {
+	return bka.keepFactory.candidatesPools.IsOperatorEligible(
+		bka.chainHandle.operatorAddress(),
+		bka.applicationAddress,
+	)
+}
+
+// IsStatusUpToDateForApplication checks if the operator's status
+// is up to date in the signers' pool of the given application.
+func (bka *bondedECDSAKeepApplication) IsStatusUpToDateForApplication() (bool, error) {
+	return bka.keepFactory.candidatesPools.IsOperatorUpToDate(
+		bka.chainHandle.operatorAddress(),
+		bka.applicationAddress,
+	)
+}
+
+// UpdateStatusForApplication updates the operator's status in the signers'
+// pool for the given application. The transaction is of the low urgency class.
+func (bka *bondedECDSAKeepApplication) UpdateStatusForApplication() error {
+	transaction, err := bka.keepFactory.candidatesPools.UpdateOperatorStatus(
+		bka.chainHandle.operatorAddress(),
+		bka.applicationAddress,
+		bka.chainHandle.transactionOptions(chain.UrgencyLow, 0),
+	)
+	if err != nil {
+		return err
+	}
+
+	bka.chainHandle.recordTransaction(
+		"UpdateStatusForApplication",
+		"",
+		"",
+		transaction,
+	)
+
+	logger.Debugf(
+		"submitted UpdateOperatorStatus transaction with hash: [%s]",
+		transaction.Hash(),
+	)
+
+	return nil
+}
+
+// MinimumBond returns the minimum bond the signers' pool of the application
+// requires from the operator to join it.
+func (bka *bondedECDSAKeepApplication) MinimumBond() (*big.Int, error) {
+	poolAddress, err := bka.keepFactory.candidatesPools.GetSortitionPool(
+		bka.applicationAddress,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get signers' pool of application [%v]: [%v]",
+			bka.applicationAddress.String(),
+			err,
+		)
+	}
+
+	return bka.chainHandle.sortitionPoolMinimumBond(poolAddress)
+}
+
+// AvailableUnbondedValue returns the operator's unbonded value available for
+// bonds in keeps of the given application.
+func (bka *bondedECDSAKeepApplication) AvailableUnbondedValue() (*big.Int, error) {
+	if bka.keepFactory.bonding == nil {
+		return nil, fmt.Errorf(
+			"bonding contract of the keep factory is not configured",
+		)
+	}
+
+	sortitionPoolAddress, err := bka.keepFactory.candidatesPools.GetSortitionPool(
+		bka.applicationAddress,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get signers' pool of application [%v]: [%v]",
+			bka.applicationAddress.String(),
+			err,
+		)
+	}
+
+	return bka.keepFactory.bonding.AvailableUnbondedValue(
+		bka.chainHandle.operatorAddress(),
+		bka.keepFactory.address,
+		sortitionPoolAddress,
+	)
+}
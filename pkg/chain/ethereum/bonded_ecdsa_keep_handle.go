@@ -3,22 +3,24 @@
 package ethereum
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sort"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
 	"github.com/keep-network/keep-common/pkg/chain/ethlike"
 	"github.com/keep-network/keep-common/pkg/subscription"
 	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/ethereum/abi"
 	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/ethereum/contract"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
 	"github.com/keep-network/keep-ecdsa/pkg/utils/byteutils"
 )
 
 type bondedEcdsaKeepHandle struct {
+	chainHandle     *ethereumChain
 	keepAddress     common.Address
 	operatorAddress common.Address
 	contract        *contract.BondedECDSAKeep
@@ -36,13 +38,15 @@ func (ec *ethereumChain) GetKeepWithID(
 		)
 	}
 
+	// Keep members submit only public keys and signatures to the keep
+	// contract, so all keep transactions are of the critical urgency class.
 	bondedECDSAKeepContract, err := contract.NewBondedECDSAKeep(
 		keepAddress,
 		ec.chainID,
 		ec.accountKey,
 		ec.client,
 		ec.nonceManager,
-		ec.miningWaiter,
+		ec.urgencyClasses[chain.UrgencyCritical].miningWaiter,
 		ec.blockCounter,
 		ec.transactionMutex,
 	)
@@ -55,6 +59,7 @@ func (ec *ethereumChain) GetKeepWithID(
 	}
 
 	return &bondedEcdsaKeepHandle{
+		chainHandle:     ec,
 		keepAddress:     keepAddress,
 		operatorAddress: ec.operatorAddress(),
 		contract:        bondedECDSAKeepContract,
@@ -76,11 +81,11 @@ func (ec *ethereumChain) GetKeepAtIndex(
 		)
 	}
 
-	return ec.GetKeepWithID(ethereumChainID(keepAddress))
+	return ec.GetKeepWithID(ec.toChainID(keepAddress))
 }
 
 func (bekh *bondedEcdsaKeepHandle) ID() chain.ID {
-	return ethereumChainID(bekh.keepAddress)
+	return bekh.chainHandle.toChainID(bekh.keepAddress)
 }
 
 // OnSignatureRequested installs a callback that is invoked on-chain
@@ -88,19 +93,35 @@ func (bekh *bondedEcdsaKeepHandle) ID() chain.ID {
 func (bekh *bondedEcdsaKeepHandle) OnSignatureRequested(
 	handler func(event *chain.SignatureRequestedEvent),
 ) (subscription.EventSubscription, error) {
-	onEvent := func(
-		Digest [32]uint8,
-		blockNumber uint64,
-	) {
-		handler(&chain.SignatureRequestedEvent{
-			Digest:      Digest,
-			BlockNumber: blockNumber,
-		})
-	}
-	return bekh.contract.SignatureRequested(
+	// The event is piped instead of being handled with OnEvent to preserve
+	// the hash of the requesting transaction.
+	eventChan := make(chan *abi.BondedECDSAKeepSignatureRequested)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(&chain.SignatureRequestedEvent{
+					Digest:          event.Digest,
+					BlockNumber:     event.Raw.BlockNumber,
+					TransactionHash: event.Raw.TxHash.Hex(),
+				})
+			}
+		}
+	}()
+
+	sub := bekh.contract.SignatureRequested(
 		nil,
 		nil,
-	).OnEvent(onEvent), nil
+	).Pipe(eventChan)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	}), nil
 }
 
 // OnConflictingPublicKeySubmitted installs a callback that is invoked when an
@@ -114,7 +135,7 @@ func (bekh *bondedEcdsaKeepHandle) OnConflictingPublicKeySubmitted(
 		blockNumber uint64,
 	) {
 		handler(&chain.ConflictingPublicKeySubmittedEvent{
-			SubmittingMember:     ethereumChainID(SubmittingMember),
+			SubmittingMember:     bekh.chainHandle.toChainID(SubmittingMember),
 			ConflictingPublicKey: ConflictingPublicKey,
 			BlockNumber:          blockNumber,
 		})
@@ -143,21 +164,29 @@ func (bekh *bondedEcdsaKeepHandle) OnPublicKeyPublished(
 }
 
 // SubmitKeepPublicKey submits a public key to a keep contract deployed under
-// a given address.
+// a given address. The transaction is of the critical urgency class.
 func (bekh *bondedEcdsaKeepHandle) SubmitKeepPublicKey(
 	publicKey [64]byte,
 ) error {
 	submitPubKey := func() error {
 		transaction, err := bekh.contract.SubmitPublicKey(
 			publicKey[:],
-			ethutil.TransactionOptions{
-				GasLimit: 350000, // enough for a group size of 16
-			},
+			bekh.chainHandle.transactionOptions(
+				chain.UrgencyCritical,
+				350000, // enough for a group size of 16
+			),
 		)
 		if err != nil {
 			return err
 		}
 
+		bekh.chainHandle.recordTransaction(
+			"SubmitKeepPublicKey",
+			bekh.ID().String(),
+			"",
+			transaction,
+		)
+
 		logger.Debugf(
 			"submitted SubmitPublicKey transaction with hash: [%s]",
 			transaction.Hash(),
@@ -178,7 +207,7 @@ func (bekh *bondedEcdsaKeepHandle) SubmitKeepPublicKey(
 }
 
 // SubmitSignature submits a signature to a keep contract deployed under a
-// given address.
+// given address. The transaction is of the critical urgency class.
 func (bekh *bondedEcdsaKeepHandle) SubmitSignature(
 	signature *ecdsa.Signature,
 ) error {
@@ -196,11 +225,19 @@ func (bekh *bondedEcdsaKeepHandle) SubmitSignature(
 		signatureR,
 		signatureS,
 		uint8(signature.RecoveryID),
+		bekh.chainHandle.transactionOptions(chain.UrgencyCritical, 0),
 	)
 	if err != nil {
 		return err
 	}
 
+	bekh.chainHandle.recordTransaction(
+		"SubmitSignature",
+		bekh.ID().String(),
+		"",
+		transaction,
+	)
+
 	logger.Debugf(
 		"submitted SubmitSignature transaction with hash: [%s]",
 		transaction.Hash(),
@@ -279,7 +316,7 @@ func (bekh *bondedEcdsaKeepHandle) GetMembers() ([]chain.ID, error) {
 		return nil, err
 	}
 
-	return toIDSlice(memberAddresses), nil
+	return bekh.chainHandle.toIDSlice(memberAddresses), nil
 }
 
 // GetOwner returns keep's owner.
@@ -288,7 +325,7 @@ func (bekh *bondedEcdsaKeepHandle) GetOwner() (chain.ID, error) {
 	if err != nil {
 		return nil, err
 	}
-	return ethereumChainID(owner), nil
+	return bekh.chainHandle.toChainID(owner), nil
 }
 
 // IsThisOperatorMember returns whether or not the operator is a member
@@ -395,3 +432,79 @@ func withRetry(fn func() error) error {
 		}
 	}
 }
+
+// GetMemberETHBalance returns the ETH reward balance of the operator held by
+// the keep.
+func (bekh *bondedEcdsaKeepHandle) GetMemberETHBalance() (*big.Int, error) {
+	return bekh.contract.GetMemberETHBalance(bekh.operatorAddress)
+}
+
+// WithdrawETHRewardFeeEstimate returns the estimated fee in wei of withdrawing
+// the operator's ETH reward balance from the keep. The fee is the estimated
+// gas of the withdrawal multiplied by the gas fee cap of the low urgency
+// class the withdrawal is submitted with. The estimation fails if the balance
+// is zero.
+func (bekh *bondedEcdsaKeepHandle) WithdrawETHRewardFeeEstimate() (
+	*big.Int,
+	error,
+) {
+	gasEstimate, err := bekh.contract.WithdrawGasEstimate(
+		bekh.operatorAddress,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate withdrawal gas: [%v]", err)
+	}
+
+	gasFeeCap, err := bekh.chainHandle.gasFeeCap(chain.UrgencyLow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gas fee cap: [%v]", err)
+	}
+
+	return new(big.Int).Mul(new(big.Int).SetUint64(gasEstimate), gasFeeCap), nil
+}
+
+// WithdrawETHReward withdraws the operator's ETH reward balance held by the
+// keep to the operator's beneficiary. Rewards are withdrawn as a maintenance
+// operation, so unlike other keep transactions the transaction is of the low
+// urgency class.
+func (bekh *bondedEcdsaKeepHandle) WithdrawETHReward() error {
+	lowUrgencyContract, err := contract.NewBondedECDSAKeep(
+		bekh.keepAddress,
+		bekh.chainHandle.chainID,
+		bekh.chainHandle.accountKey,
+		bekh.chainHandle.client,
+		bekh.chainHandle.nonceManager,
+		bekh.chainHandle.urgencyClasses[chain.UrgencyLow].miningWaiter,
+		bekh.chainHandle.blockCounter,
+		bekh.chainHandle.transactionMutex,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to resolve contract for keep with id [%v]: [%v]",
+			bekh.ID(),
+			err,
+		)
+	}
+
+	transaction, err := lowUrgencyContract.Withdraw(
+		bekh.operatorAddress,
+		bekh.chainHandle.transactionOptions(chain.UrgencyLow, 0),
+	)
+	if err != nil {
+		return err
+	}
+
+	bekh.chainHandle.recordTransaction(
+		"WithdrawETHReward",
+		bekh.ID().String(),
+		"",
+		transaction,
+	)
+
+	logger.Debugf(
+		"submitted Withdraw transaction with hash: [%s]",
+		transaction.Hash(),
+	)
+
+	return nil
+}
@@ -20,25 +20,35 @@ import (
 	"github.com/keep-network/keep-common/pkg/chain/ethereum"
 	"github.com/keep-network/keep-ecdsa/pkg/chain"
 	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/ethereum/contract"
+	"github.com/keep-network/keep-ecdsa/pkg/ledger"
 )
 
 // Definitions of contract names.
 const (
-	BondedECDSAKeepFactoryContractName = "BondedECDSAKeepFactory"
-	TBTCSystemContractName             = "TBTCSystem"
+	BondedECDSAKeepFactoryContractName      = "BondedECDSAKeepFactory"
+	FullyBackedECDSAKeepFactoryContractName = "FullyBackedECDSAKeepFactory"
+	FullyBackedBondingContractName          = "FullyBackedBonding"
+	KeepBondingContractName                 = "KeepBonding"
+	TBTCSystemContractName                  = "TBTCSystem"
 )
 
 // ethereumChain is an implementation of ethereum blockchain interface.
 type ethereumChain struct {
 	config                         *ethereum.Config
+	network                        *chain.NetworkConfig
 	accountKey                     *keystore.Key
 	client                         ethutil.EthereumClient
 	chainID                        *big.Int
 	bondedECDSAKeepFactoryContract *contract.BondedECDSAKeepFactory
+	bondedECDSAKeepFactory         *keepFactoryContracts
+	fullyBackedKeepFactory         *fullyBackedKeepFactory
+	keepBondingContract            *contract.KeepBonding
 	tbtcSystemAddress              common.Address
 	blockCounter                   *ethlike.BlockCounter
-	miningWaiter                   *ethutil.MiningWaiter
+	urgencyClasses                 map[chain.Urgency]*urgencyClass
 	nonceManager                   *ethlike.NonceManager
+	ledger                         *ledger.Ledger
+	ledgerClient                   *ledgerClient
 
 	// transactionMutex allows interested parties to forcibly serialize
 	// transaction submission.
@@ -55,22 +65,26 @@ type ethereumChain struct {
 	transactionMutex *sync.Mutex
 }
 
-// Connect performs initialization for communication with Ethereum blockchain
-// based on provided config.
+// Connect performs initialization for communication with Ethereum or another
+// EVM network based on provided config. The node has to report the chain ID of
+// the configured network; fees are set according to the network's fee model.
+// Transactions are submitted with fee settings of
+// the urgency class of the given operation, as set in the urgency config.
+// If the transaction ledger is provided, gas and fees spent on all submitted
+// transactions are recorded in it.
 func Connect(
 	ctx context.Context,
 	accountKey *keystore.Key,
 	config *ethereum.Config,
+	network *chain.NetworkConfig,
+	urgencyConfig *chain.UrgencyConfig,
+	transactionLedger *ledger.Ledger,
 ) (chain.Handle, error) {
 	client, err := ethclient.Dial(config.URL)
 	if err != nil {
 		return nil, err
 	}
 
-	wrappedClient := addClientWrappers(config, client)
-
-	transactionMutex := &sync.Mutex{}
-
 	chainID, err := client.ChainID(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -79,9 +93,38 @@ func Connect(
 		)
 	}
 
-	nonceManager := ethutil.NewNonceManager(wrappedClient, accountKey.Address)
+	if err := validateNetwork(network, chainID); err != nil {
+		return nil, err
+	}
 
-	miningWaiter := ethutil.NewMiningWaiter(wrappedClient, *config)
+	wrappedClient := addFeeModelWrapper(
+		network,
+		addClientWrappers(config, client),
+	)
+
+	var transactionLedgerClient *ledgerClient
+	if transactionLedger != nil {
+		transactionLedgerClient = newLedgerClient(
+			wrappedClient,
+			transactionLedger,
+			network.NetworkName(),
+		)
+		wrappedClient = transactionLedgerClient
+	}
+
+	transactionMutex := &sync.Mutex{}
+
+	nonceManager := ethutil.NewNonceManager(
+		wrappedClient,
+		accountKey.Address,
+	)
+
+	urgencyClasses := newUrgencyClasses(
+		wrappedClient,
+		config,
+		network,
+		urgencyConfig,
+	)
 
 	blockCounter, err := ethutil.NewBlockCounter(wrappedClient)
 	if err != nil {
@@ -108,13 +151,15 @@ func Connect(
 	if err != nil {
 		return nil, err
 	}
+	// All transactions submitted to the factory are sortition pool
+	// maintenance operations, hence the low urgency class.
 	bondedECDSAKeepFactoryContract, err := contract.NewBondedECDSAKeepFactory(
 		bondedECDSAKeepFactoryContractAddress,
 		chainID,
 		accountKey,
 		wrappedClient,
 		nonceManager,
-		miningWaiter,
+		urgencyClasses[chain.UrgencyLow].miningWaiter,
 		blockCounter,
 		transactionMutex,
 	)
@@ -122,20 +167,118 @@ func Connect(
 		return nil, err
 	}
 
+	bondedECDSAKeepFactory := &keepFactoryContracts{
+		address:         bondedECDSAKeepFactoryContractAddress,
+		candidatesPools: bondedECDSAKeepFactoryContract,
+		pastKeepApplications: bondedECDSAKeepApplications(
+			bondedECDSAKeepFactoryContract,
+		),
+	}
+
+	// The bonding contract is optional; it is needed only to manage the
+	// operator's unbonded value. Bonding transactions are submitted on the
+	// operator's request, hence the normal urgency class.
+	var keepBondingContract *contract.KeepBonding
+	keepBondingContractAddress, err := config.ContractAddress(
+		KeepBondingContractName,
+	)
+	if err == nil {
+		keepBondingContract, err = contract.NewKeepBonding(
+			keepBondingContractAddress,
+			chainID,
+			accountKey,
+			wrappedClient,
+			nonceManager,
+			urgencyClasses[chain.UrgencyNormal].miningWaiter,
+			blockCounter,
+			transactionMutex,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		bondedECDSAKeepFactory.bonding = keepBondingContract
+	}
+
 	ethereum := &ethereumChain{
 		config:                         config,
+		network:                        network,
 		accountKey:                     accountKey,
 		client:                         wrappedClient,
 		chainID:                        chainID,
 		bondedECDSAKeepFactoryContract: bondedECDSAKeepFactoryContract,
+		bondedECDSAKeepFactory:         bondedECDSAKeepFactory,
+		keepBondingContract:            keepBondingContract,
 		tbtcSystemAddress:              tbtcSystemAddress,
 		blockCounter:                   blockCounter,
 		nonceManager:                   nonceManager,
-		miningWaiter:                   miningWaiter,
+		urgencyClasses:                 urgencyClasses,
+		ledger:                         transactionLedger,
+		ledgerClient:                   transactionLedgerClient,
 		transactionMutex:               transactionMutex,
 	}
 
+	// The fully-backed keep factory is optional; the client watches it only
+	// if its address is configured.
+	fullyBackedECDSAKeepFactoryContractAddress, err := config.ContractAddress(
+		FullyBackedECDSAKeepFactoryContractName,
+	)
+	if err == nil {
+		fullyBackedECDSAKeepFactoryContract, err := contract.NewFullyBackedECDSAKeepFactory(
+			fullyBackedECDSAKeepFactoryContractAddress,
+			chainID,
+			accountKey,
+			wrappedClient,
+			nonceManager,
+			urgencyClasses[chain.UrgencyLow].miningWaiter,
+			blockCounter,
+			transactionMutex,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		fullyBackedKeepFactoryContracts := &keepFactoryContracts{
+			address:         fullyBackedECDSAKeepFactoryContractAddress,
+			candidatesPools: fullyBackedECDSAKeepFactoryContract,
+			pastKeepApplications: fullyBackedECDSAKeepApplications(
+				fullyBackedECDSAKeepFactoryContract,
+			),
+		}
+
+		// The bonding contract of the fully-backed keep factory is optional;
+		// it is needed only to report the operator's unbonded value missing
+		// to join signers' pools of the factory.
+		fullyBackedBondingContractAddress, err := config.ContractAddress(
+			FullyBackedBondingContractName,
+		)
+		if err == nil {
+			fullyBackedBondingContract, err := contract.NewFullyBackedBonding(
+				fullyBackedBondingContractAddress,
+				chainID,
+				accountKey,
+				wrappedClient,
+				nonceManager,
+				urgencyClasses[chain.UrgencyNormal].miningWaiter,
+				blockCounter,
+				transactionMutex,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			fullyBackedKeepFactoryContracts.bonding = fullyBackedBondingContract
+		}
+
+		ethereum.fullyBackedKeepFactory = &fullyBackedKeepFactory{
+			chainHandle:                         ethereum,
+			fullyBackedECDSAKeepFactoryContract: fullyBackedECDSAKeepFactoryContract,
+			keepFactory:                         fullyBackedKeepFactoryContracts,
+		}
+	}
+
 	ethereum.initializeBalanceMonitoring(ctx)
+	ethereum.resumeTransactionTracking()
 
 	return ethereum, nil
 }
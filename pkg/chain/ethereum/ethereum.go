@@ -31,9 +31,11 @@ var logger = log.Logger("keep-chain-eth-ethereum")
 func Offline(
 	accountKey *keystore.Key,
 	config *ethereum.Config,
+	network *chain.NetworkConfig,
 ) chain.OfflineHandle {
 	ethereum := &ethereumChain{
 		config:     config,
+		network:    network,
 		accountKey: accountKey,
 
 		transactionMutex: &sync.Mutex{},
@@ -43,7 +45,7 @@ func Offline(
 }
 
 func (ec *ethereumChain) Name() string {
-	return "ethereum"
+	return ec.network.NetworkName()
 }
 
 // operatorAddress returns client operator's Ethereum address.
@@ -52,11 +54,11 @@ func (ec *ethereumChain) operatorAddress() common.Address {
 }
 
 func (ec *ethereumChain) OperatorID() chain.ID {
-	return ethereumChainID(ec.accountKey.Address)
+	return ec.toChainID(ec.accountKey.Address)
 }
 
 func (ec *ethereumChain) PublicKeyToOperatorID(publicKey *cecdsa.PublicKey) chain.ID {
-	return ethereumChainID(crypto.PubkeyToAddress(*publicKey))
+	return ec.toChainID(crypto.PubkeyToAddress(*publicKey))
 }
 
 // Signing returns signing interface for creating and verifying signatures.
@@ -74,7 +76,34 @@ func (ec *ethereumChain) BlockCounter() corechain.BlockCounter {
 func (ec *ethereumChain) OnBondedECDSAKeepCreated(
 	handler func(event *chain.BondedECDSAKeepCreatedEvent),
 ) subscription.EventSubscription {
-	onEvent := func(
+	return ec.bondedECDSAKeepFactoryContract.BondedECDSAKeepCreated(
+		nil,
+		nil,
+		nil,
+		nil,
+	).OnEvent(ec.keepCreatedEventHandler(
+		ec.bondedECDSAKeepFactory,
+		"BondedECDSAKeepCreated",
+		handler,
+	))
+}
+
+// keepCreatedEventHandler converts keep creation events of the given name
+// emitted by the keep factory to chain.BondedECDSAKeepCreatedEvent and passes
+// them to the handler. Applications which opened the keeps are cached.
+func (ec *ethereumChain) keepCreatedEventHandler(
+	keepFactory *keepFactoryContracts,
+	eventName string,
+	handler func(event *chain.BondedECDSAKeepCreatedEvent),
+) func(
+	KeepAddress common.Address,
+	Members []common.Address,
+	Owner common.Address,
+	Application common.Address,
+	HonestThreshold *big.Int,
+	blockNumber uint64,
+) {
+	return func(
 		KeepAddress common.Address,
 		Members []common.Address,
 		Owner common.Address,
@@ -82,12 +111,15 @@ func (ec *ethereumChain) OnBondedECDSAKeepCreated(
 		HonestThreshold *big.Int,
 		blockNumber uint64,
 	) {
-		keep, err := ec.GetKeepWithID(ethereumChainID(KeepAddress))
+		keepFactory.cacheKeepApplication(KeepAddress, Application)
+
+		keep, err := ec.GetKeepWithID(ec.toChainID(KeepAddress))
 		if err != nil {
 			logger.Errorf(
 				"Failed to look up keep with address [%v] for "+
-					"BondedECDSAKeepCreated event at block [%v]: [%v].",
+					"%v event at block [%v]: [%v].",
 				KeepAddress,
+				eventName,
 				blockNumber,
 				err,
 			)
@@ -101,24 +133,18 @@ func (ec *ethereumChain) OnBondedECDSAKeepCreated(
 				thisOperatorIsMember = true
 			}
 
-			memberIDs = append(memberIDs, ethereumChainID(memberAddress))
+			memberIDs = append(memberIDs, ec.toChainID(memberAddress))
 		}
 
 		handler(&chain.BondedECDSAKeepCreatedEvent{
 			Keep:                 keep,
 			MemberIDs:            memberIDs,
+			Application:          ec.toChainID(Application),
 			HonestThreshold:      HonestThreshold.Uint64(),
 			BlockNumber:          blockNumber,
 			ThisOperatorIsMember: thisOperatorIsMember,
 		})
 	}
-
-	return ec.bondedECDSAKeepFactoryContract.BondedECDSAKeepCreated(
-		nil,
-		nil,
-		nil,
-		nil,
-	).OnEvent(onEvent)
 }
 
 // HasMinimumStake returns true if the specified address is staked.  False will
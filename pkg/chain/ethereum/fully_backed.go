@@ -0,0 +1,156 @@
+//+build !celo
+
+package ethereum
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/keep-network/keep-common/pkg/subscription"
+
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/ethereum/contract"
+)
+
+// fullyBackedKeepFactory is an implementation of chain.BondedECDSAKeepFactory
+// for the fully-backed ECDSA keep factory. Members of fully-backed keeps are
+// secured only by their ETH bonds. Fully-backed keeps share the interface of
+// bonded ECDSA keeps, so they are handled by the same keep handle.
+type fullyBackedKeepFactory struct {
+	chainHandle *ethereumChain
+
+	fullyBackedECDSAKeepFactoryContract *contract.FullyBackedECDSAKeepFactory
+	keepFactory                         *keepFactoryContracts
+}
+
+// KeepFactories returns all keep factories the chain handle watches. The
+// bonded ECDSA keep factory is always the first one, followed by the
+// fully-backed ECDSA keep factory if it is configured.
+func (ec *ethereumChain) KeepFactories() []chain.BondedECDSAKeepFactory {
+	factories := []chain.BondedECDSAKeepFactory{ec}
+
+	if ec.fullyBackedKeepFactory != nil {
+		factories = append(factories, ec.fullyBackedKeepFactory)
+	}
+
+	return factories
+}
+
+// TBTCApplicationHandle returns an error as the tBTC application is handled
+// by the bonded ECDSA keep factory.
+func (fbkf *fullyBackedKeepFactory) TBTCApplicationHandle() (chain.TBTCHandle, error) {
+	return nil, fmt.Errorf(
+		"tBTC application is not handled by the fully-backed keep factory",
+	)
+}
+
+// ApplicationHandle returns a handle for interacting with the factory on
+// behalf of the application with the given ID.
+func (fbkf *fullyBackedKeepFactory) ApplicationHandle(
+	applicationID chain.ID,
+) (chain.BondedECDSAKeepApplicationHandle, error) {
+	return fbkf.chainHandle.applicationHandle(
+		fbkf.keepFactory,
+		applicationID,
+	)
+}
+
+// OnBondedECDSAKeepCreated installs a callback that is invoked when an on-chain
+// notification of a new fully-backed ECDSA keep creation is seen.
+func (fbkf *fullyBackedKeepFactory) OnBondedECDSAKeepCreated(
+	handler func(event *chain.BondedECDSAKeepCreatedEvent),
+) subscription.EventSubscription {
+	return fbkf.fullyBackedECDSAKeepFactoryContract.FullyBackedECDSAKeepCreated(
+		nil,
+		nil,
+		nil,
+		nil,
+	).OnEvent(fbkf.chainHandle.keepCreatedEventHandler(
+		fbkf.keepFactory,
+		"FullyBackedECDSAKeepCreated",
+		handler,
+	))
+}
+
+// IsOperatorAuthorized checks if the factory has the authorization to
+// operate on the bonded value of the provided operator.
+func (fbkf *fullyBackedKeepFactory) IsOperatorAuthorized(
+	operatorID chain.ID,
+) (bool, error) {
+	operatorAddress, err := fromChainID(operatorID)
+	if err != nil {
+		return false, err
+	}
+
+	return fbkf.fullyBackedECDSAKeepFactoryContract.IsOperatorAuthorized(
+		operatorAddress,
+	)
+}
+
+// GetKeepCount returns number of keeps opened by the factory.
+func (fbkf *fullyBackedKeepFactory) GetKeepCount() (*big.Int, error) {
+	return fbkf.fullyBackedECDSAKeepFactoryContract.GetKeepCount()
+}
+
+// GetKeepAtIndex returns a handle to the keep at the given index.
+func (fbkf *fullyBackedKeepFactory) GetKeepAtIndex(
+	keepIndex *big.Int,
+) (chain.BondedECDSAKeepHandle, error) {
+	keepAddress, err := fbkf.fullyBackedECDSAKeepFactoryContract.GetKeepAtIndex(
+		keepIndex,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to look up keep address for index [%v]: [%v]",
+			keepIndex,
+			err,
+		)
+	}
+
+	return fbkf.chainHandle.GetKeepWithID(fbkf.chainHandle.toChainID(keepAddress))
+}
+
+// GetKeepWithID returns a handle to the keep with the given ID.
+func (fbkf *fullyBackedKeepFactory) GetKeepWithID(
+	keepID chain.ID,
+) (chain.BondedECDSAKeepHandle, error) {
+	return fbkf.chainHandle.GetKeepWithID(keepID)
+}
+
+// GetKeepApplication returns the ID of the application which opened the keep
+// with the given ID. The application is read from the event emitted by the
+// factory when the keep was created and cached.
+func (fbkf *fullyBackedKeepFactory) GetKeepApplication(
+	keepID chain.ID,
+) (chain.ID, error) {
+	return fbkf.chainHandle.getKeepApplication(fbkf.keepFactory, keepID)
+}
+
+// fullyBackedECDSAKeepApplications returns a function reading applications
+// from past creation events of the keep with the given address emitted by
+// the fully-backed ECDSA keep factory.
+func fullyBackedECDSAKeepApplications(
+	factoryContract *contract.FullyBackedECDSAKeepFactory,
+) func(keepAddress common.Address) ([]common.Address, error) {
+	return func(keepAddress common.Address) ([]common.Address, error) {
+		events, err := factoryContract.PastFullyBackedECDSAKeepCreatedEvents(
+			0,
+			nil,
+			[]common.Address{keepAddress},
+			nil,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		applications := make([]common.Address, len(events))
+		for i, event := range events {
+			applications[i] = event.Application
+		}
+
+		return applications, nil
+	}
+}
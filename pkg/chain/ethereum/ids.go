@@ -9,39 +9,53 @@ import (
 	"github.com/keep-network/keep-ecdsa/pkg/chain"
 )
 
-func (ec ethereumChain) UnmarshalID(idString string) (chain.ID, error) {
+func (ec *ethereumChain) UnmarshalID(idString string) (chain.ID, error) {
 	if !common.IsHexAddress(idString) {
 		return nil, fmt.Errorf(
-			"[%v] is not a valid ethereum ID",
+			"[%v] is not a valid %v ID",
 			idString,
+			ec.Name(),
 		)
 	}
 
-	return ethereumChainID(common.HexToAddress(idString)), nil
+	return ec.toChainID(common.HexToAddress(idString)), nil
 }
 
-// ethereumChainID is the Ethereum-speecific chain.ID type; it is an alias for
-// go-ethereum/common.Address.
-type ethereumChainID common.Address
+// ethereumChainID is the chain.ID type of EVM networks; it holds
+// a go-ethereum/common.Address along with the name of the network the
+// address belongs to, so that IDs of different networks served by the same
+// backend are not mistaken for each other.
+type ethereumChainID struct {
+	networkName string
+	address     common.Address
+}
+
+// toChainID returns the ID of the given address on the chain's network.
+func (ec *ethereumChain) toChainID(address common.Address) ethereumChainID {
+	return ethereumChainID{
+		networkName: ec.Name(),
+		address:     address,
+	}
+}
 
 func (eci ethereumChainID) ChainName() string {
-	return "ethereum"
+	return eci.networkName
 }
 
 func (eci ethereumChainID) String() string {
-	return common.Address(eci).Hex()
+	return eci.address.Hex()
 }
 
 func (eci ethereumChainID) IsForChain(handle chain.Handle) bool {
-	_, ok := handle.(*ethereumChain)
+	ec, ok := handle.(*ethereumChain)
 
-	return ok
+	return ok && ec.Name() == eci.networkName
 }
 
-func toIDSlice(addresses []common.Address) []chain.ID {
+func (ec *ethereumChain) toIDSlice(addresses []common.Address) []chain.ID {
 	memberIDs := make([]chain.ID, 0, len(addresses))
 	for _, address := range addresses {
-		memberIDs = append(memberIDs, ethereumChainID(address))
+		memberIDs = append(memberIDs, ec.toChainID(address))
 	}
 
 	return memberIDs
@@ -53,5 +67,5 @@ func fromChainID(id chain.ID) (common.Address, error) {
 		return common.Address{}, fmt.Errorf("failed to convert to ethereumChainID")
 	}
 
-	return common.Address(eci), nil
+	return eci.address, nil
 }
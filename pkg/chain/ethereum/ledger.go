@@ -0,0 +1,307 @@
+//+build !celo
+
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-ecdsa/pkg/ledger"
+)
+
+const (
+	// ledgerPollInterval is the interval in which receipts of transactions
+	// recorded in the ledger are checked.
+	ledgerPollInterval = 30 * time.Second
+	// ledgerTrackingTimeout is the maximum time the transaction is tracked
+	// before it is recorded in the ledger with an unknown outcome.
+	ledgerTrackingTimeout = 24 * time.Hour
+)
+
+// ledgerClient wraps the Ethereum client and remembers all transactions sent
+// for the given nonce. This allows to find the transaction that was actually
+// mined when the mining waiter resubmits the original transaction with a
+// higher fee. Hashes of resubmitted transactions are added to the pending
+// transaction stored in the ledger, so that the mined one can be found after
+// the client restarts.
+type ledgerClient struct {
+	ethutil.EthereumClient
+
+	ledger    *ledger.Ledger
+	chainName string
+
+	sentTransactionsMutex sync.Mutex
+	sentTransactions      map[uint64][]*types.Transaction
+}
+
+func newLedgerClient(
+	client ethutil.EthereumClient,
+	transactionLedger *ledger.Ledger,
+	chainName string,
+) *ledgerClient {
+	return &ledgerClient{
+		EthereumClient:   client,
+		ledger:           transactionLedger,
+		chainName:        chainName,
+		sentTransactions: make(map[uint64][]*types.Transaction),
+	}
+}
+
+func (lc *ledgerClient) SendTransaction(
+	ctx context.Context,
+	transaction *types.Transaction,
+) error {
+	if err := lc.EthereumClient.SendTransaction(ctx, transaction); err != nil {
+		return err
+	}
+
+	lc.sentTransactionsMutex.Lock()
+	defer lc.sentTransactionsMutex.Unlock()
+
+	lc.sentTransactions[transaction.Nonce()] = append(
+		lc.sentTransactions[transaction.Nonce()],
+		transaction,
+	)
+
+	err := lc.ledger.AddPendingTransactionHash(
+		lc.chainName,
+		transaction.Nonce(),
+		transaction.Hash().Hex(),
+	)
+	if err != nil {
+		logger.Errorf(
+			"could not store hash of transaction [%v] in the ledger: [%v]",
+			transaction.Hash().Hex(),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// addPending stores the entry as a pending transaction in the ledger along
+// with hashes of all transactions sent with the given nonce so far.
+func (lc *ledgerClient) addPending(
+	entry *ledger.Entry,
+	nonce uint64,
+) (*ledger.Pending, error) {
+	lc.sentTransactionsMutex.Lock()
+	defer lc.sentTransactionsMutex.Unlock()
+
+	pending := &ledger.Pending{
+		Entry:             entry,
+		Nonce:             nonce,
+		TransactionHashes: []string{entry.TransactionHash},
+	}
+	for _, transaction := range lc.sentTransactions[nonce] {
+		if hash := transaction.Hash().Hex(); hash != entry.TransactionHash {
+			pending.TransactionHashes = append(pending.TransactionHashes, hash)
+		}
+	}
+
+	return pending, lc.ledger.AddPending(pending)
+}
+
+func (lc *ledgerClient) transactionsWithNonce(nonce uint64) []*types.Transaction {
+	lc.sentTransactionsMutex.Lock()
+	defer lc.sentTransactionsMutex.Unlock()
+
+	return append([]*types.Transaction{}, lc.sentTransactions[nonce]...)
+}
+
+func (lc *ledgerClient) forgetNonce(nonce uint64) {
+	lc.sentTransactionsMutex.Lock()
+	defer lc.sentTransactionsMutex.Unlock()
+
+	delete(lc.sentTransactions, nonce)
+}
+
+// recordTransaction tracks the submitted transaction in the background and,
+// once it is mined, appends its gas usage, effective gas price and outcome to
+// the ledger. Until then, the transaction is stored in the ledger as pending,
+// so that it is tracked again if the client restarts. If ledger is not
+// configured, this function does nothing.
+func (ec *ethereumChain) recordTransaction(
+	operation string,
+	keepID string,
+	depositAddress string,
+	transaction *types.Transaction,
+) {
+	if ec.ledger == nil || ec.ledgerClient == nil {
+		return
+	}
+
+	entry := &ledger.Entry{
+		Timestamp:       time.Now(),
+		Chain:           ec.Name(),
+		Operation:       operation,
+		KeepID:          keepID,
+		DepositAddress:  depositAddress,
+		TransactionHash: transaction.Hash().Hex(),
+		Outcome:         ledger.OutcomeUnknown,
+	}
+
+	pending, err := ec.ledgerClient.addPending(entry, transaction.Nonce())
+	if err != nil {
+		logger.Errorf(
+			"could not store pending transaction [%v] in the ledger: [%v]",
+			entry.TransactionHash,
+			err,
+		)
+	}
+
+	go ec.completeTransaction(pending)
+}
+
+// resumeTransactionTracking tracks transactions stored in the ledger as
+// pending by the previous run of the client.
+func (ec *ethereumChain) resumeTransactionTracking() {
+	if ec.ledger == nil || ec.ledgerClient == nil {
+		return
+	}
+
+	pendings, err := ec.ledger.Pending(ec.Name())
+	if err != nil {
+		logger.Errorf(
+			"could not read pending transactions from the ledger: [%v]",
+			err,
+		)
+		return
+	}
+
+	for _, pending := range pendings {
+		logger.Infof(
+			"resuming tracking of pending transaction [%v]",
+			pending.Entry.TransactionHash,
+		)
+
+		go ec.completeTransaction(pending)
+	}
+}
+
+// completeTransaction tracks the pending transaction until it is mined or the
+// tracking period ends and records its outcome in the ledger.
+func (ec *ethereumChain) completeTransaction(pending *ledger.Pending) {
+	ec.trackTransaction(pending)
+
+	if err := ec.ledger.Complete(pending.Nonce, pending.Entry); err != nil {
+		logger.Errorf(
+			"could not record transaction [%v] in the ledger: [%v]",
+			pending.Entry.TransactionHash,
+			err,
+		)
+	}
+}
+
+// trackTransaction waits until any of the transactions sent with the nonce of
+// the pending transaction is mined and fills the entry with the mined
+// transaction's details. The transaction is tracked until the tracking period
+// counted from the original submission ends.
+func (ec *ethereumChain) trackTransaction(pending *ledger.Pending) {
+	entry := pending.Entry
+	defer ec.ledgerClient.forgetNonce(pending.Nonce)
+
+	ctx, cancelCtx := context.WithDeadline(
+		context.Background(),
+		entry.Timestamp.Add(ledgerTrackingTimeout),
+	)
+	defer cancelCtx()
+
+	ticker := time.NewTicker(ledgerPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Warnf(
+				"could not determine outcome of transaction [%v]",
+				entry.TransactionHash,
+			)
+			return
+		case <-ticker.C:
+			transactions := make(map[common.Hash]*types.Transaction)
+			for _, hash := range pending.TransactionHashes {
+				transactions[common.HexToHash(hash)] = nil
+			}
+			for _, transaction := range ec.ledgerClient.transactionsWithNonce(
+				pending.Nonce,
+			) {
+				transactions[transaction.Hash()] = transaction
+			}
+
+			for hash, transaction := range transactions {
+				receipt, err := ec.client.TransactionReceipt(ctx, hash)
+				if err != nil || receipt == nil {
+					continue
+				}
+
+				// Transactions sent before the client restarted are
+				// fetched from the chain.
+				if transaction == nil {
+					transaction, _, err = ec.client.TransactionByHash(ctx, hash)
+					if err != nil {
+						logger.Warnf(
+							"could not get mined transaction [%v]: [%v]",
+							hash.Hex(),
+							err,
+						)
+						continue
+					}
+				}
+
+				entry.TransactionHash = transaction.Hash().Hex()
+				entry.GasUsed = receipt.GasUsed
+				entry.EffectiveGasPrice = ec.effectiveGasPrice(
+					ctx,
+					transaction,
+					receipt,
+				)
+
+				if receipt.Status == types.ReceiptStatusSuccessful {
+					entry.Outcome = ledger.OutcomeSucceeded
+				} else {
+					entry.Outcome = ledger.OutcomeReverted
+				}
+
+				return
+			}
+		}
+	}
+}
+
+// effectiveGasPrice returns the price per gas actually paid for the mined
+// transaction. For EIP-1559 transactions, it is the base fee of the block the
+// transaction was mined in increased by the priority fee, capped at the
+// transaction's gas fee cap.
+func (ec *ethereumChain) effectiveGasPrice(
+	ctx context.Context,
+	transaction *types.Transaction,
+	receipt *types.Receipt,
+) *big.Int {
+	if transaction.Type() != types.DynamicFeeTxType {
+		return transaction.GasPrice()
+	}
+
+	header, err := ec.client.HeaderByNumber(ctx, receipt.BlockNumber)
+	if err != nil || header.BaseFee == nil {
+		logger.Warnf(
+			"could not determine base fee of block [%v]; "+
+				"using gas fee cap as effective gas price: [%v]",
+			receipt.BlockNumber,
+			err,
+		)
+		return transaction.GasFeeCap()
+	}
+
+	price := new(big.Int).Add(header.BaseFee, transaction.GasTipCap())
+	if price.Cmp(transaction.GasFeeCap()) > 0 {
+		return transaction.GasFeeCap()
+	}
+
+	return price
+}
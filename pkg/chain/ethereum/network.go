@@ -0,0 +1,75 @@
+//+build !celo
+
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+)
+
+// validateNetwork checks whether the chain ID reported by the node matches the
+// chain ID of the configured network.
+func validateNetwork(network *chain.NetworkConfig, chainID *big.Int) error {
+	if err := network.Validate(); err != nil {
+		return err
+	}
+
+	if network == nil || network.ChainID == 0 {
+		return nil
+	}
+
+	if !chainID.IsUint64() || chainID.Uint64() != network.ChainID {
+		return fmt.Errorf(
+			"node reports chain ID [%v] but network [%v] has chain ID [%v]",
+			chainID,
+			network.NetworkName(),
+			network.ChainID,
+		)
+	}
+
+	return nil
+}
+
+// legacyFeeClient wraps the client so that block headers are reported without
+// the base fee. Contract bindings and the mining waiter decide on the
+// transaction type based on the presence of the base fee, so all transactions
+// are submitted as legacy transactions with a single gas price.
+type legacyFeeClient struct {
+	ethutil.EthereumClient
+}
+
+func (lfc *legacyFeeClient) HeaderByNumber(
+	ctx context.Context,
+	number *big.Int,
+) (*types.Header, error) {
+	header, err := lfc.EthereumClient.HeaderByNumber(ctx, number)
+	if err != nil || header == nil || header.BaseFee == nil {
+		return header, err
+	}
+
+	legacyHeader := types.CopyHeader(header)
+	legacyHeader.BaseFee = nil
+
+	return legacyHeader, nil
+}
+
+func addFeeModelWrapper(
+	network *chain.NetworkConfig,
+	client ethutil.EthereumClient,
+) ethutil.EthereumClient {
+	if network == nil || network.FeeModel != chain.FeeModelLegacy {
+		return client
+	}
+
+	logger.Infof(
+		"using legacy transactions on network [%v]",
+		network.NetworkName(),
+	)
+
+	return &legacyFeeClient{client}
+}
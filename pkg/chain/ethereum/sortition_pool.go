@@ -0,0 +1,55 @@
+//+build !celo
+
+package ethereum
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	hostchainabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+)
+
+// sortitionPoolABI is the part of the ABI of bonded and fully-backed
+// sortition pools called by the client. Sortition pools are deployed by keep
+// factories from the sortition pools package, so there are no generated
+// bindings for them.
+const sortitionPoolABI = `[{"inputs":[],"name":"getMinimumBondableValue","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+
+// sortitionPoolMinimumBond returns the minimum bondable value the sortition
+// pool with the given address requires from operators joining it. The value
+// is set by the application of the pool and may differ from the default
+// minimum bond of the keep factory.
+func (ec *ethereumChain) sortitionPoolMinimumBond(
+	poolAddress common.Address,
+) (*big.Int, error) {
+	contractABI, err := hostchainabi.JSON(strings.NewReader(sortitionPoolABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate ABI: [%v]", err)
+	}
+
+	var result *big.Int
+	err = ethutil.CallAtBlock(
+		ec.operatorAddress(),
+		nil,
+		nil,
+		&contractABI,
+		ec.client,
+		ethutil.NewErrorResolver(ec.client, &contractABI, &poolAddress),
+		poolAddress,
+		"getMinimumBondableValue",
+		&result,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get minimum bondable value of sortition pool [%v]: [%v]",
+			poolAddress.String(),
+			err,
+		)
+	}
+
+	return result, nil
+}
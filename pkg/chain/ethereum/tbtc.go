@@ -9,11 +9,9 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
-	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
 	"github.com/keep-network/keep-common/pkg/subscription"
 
 	"github.com/keep-network/keep-ecdsa/pkg/chain"
-	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/ethereum/contract"
 
 	tbtccontract "github.com/keep-network/tbtc/pkg/chain/ethereum/gen/contract"
 )
@@ -21,11 +19,8 @@ import (
 // tbtcApplication represents a tBTC application handle conforming to
 // chain.TBTCHandle.
 type tbtcApplication struct {
-	chainHandle *ethereumChain
+	*bondedECDSAKeepApplication
 
-	bondedECDSAKeepFactoryContract *contract.BondedECDSAKeepFactory
-
-	tbtcSystemAddress  common.Address
 	tbtcSystemContract *tbtccontract.TBTCSystem
 }
 
@@ -41,7 +36,7 @@ func (ec *ethereumChain) TBTCApplicationHandle() (chain.TBTCHandle, error) {
 		ec.accountKey,
 		ec.client,
 		ec.nonceManager,
-		ec.miningWaiter,
+		ec.urgencyClasses[chain.UrgencyNormal].miningWaiter,
 		ec.blockCounter,
 		ec.transactionMutex,
 	)
@@ -50,97 +45,14 @@ func (ec *ethereumChain) TBTCApplicationHandle() (chain.TBTCHandle, error) {
 	}
 
 	return &tbtcApplication{
-		chainHandle:                    ec,
-		bondedECDSAKeepFactoryContract: ec.bondedECDSAKeepFactoryContract,
-		tbtcSystemAddress:              ec.tbtcSystemAddress,
-		tbtcSystemContract:             tbtcSystemContract,
+		bondedECDSAKeepApplication: ec.newBondedECDSAKeepApplication(
+			ec.bondedECDSAKeepFactory,
+			ec.tbtcSystemAddress,
+		),
+		tbtcSystemContract: tbtcSystemContract,
 	}, nil
 }
 
-func (ta *tbtcApplication) ID() chain.ID {
-	return ethereumChainID(ta.tbtcSystemAddress)
-}
-
-func (ta *tbtcApplication) RegisterAsMemberCandidate() error {
-	gasEstimate, err :=
-		ta.bondedECDSAKeepFactoryContract.RegisterMemberCandidateGasEstimate(
-			ta.tbtcSystemAddress,
-		)
-	if err != nil {
-		return fmt.Errorf("failed to estimate gas [%v]", err)
-	}
-
-	// If we have multiple sortition pool join transactions queued - and that
-	// happens when multiple operators become eligible to join at the same time,
-	// e.g. after lowering the minimum bond requirement, transactions mined at
-	// the end may no longer have valid gas limits as they were estimated based
-	// on a different state of the pool. We add 20% safety margin to the original
-	// gas estimation to account for that.
-	gasEstimateWithMargin := float64(gasEstimate) * float64(1.2)
-	transaction, err := ta.bondedECDSAKeepFactoryContract.RegisterMemberCandidate(
-		ta.tbtcSystemAddress,
-		ethutil.TransactionOptions{
-			GasLimit: uint64(gasEstimateWithMargin),
-		},
-	)
-	if err != nil {
-		return err
-	}
-
-	logger.Debugf(
-		"submitted RegisterMemberCandidate transaction with hash: [%s]",
-		transaction.Hash(),
-	)
-
-	return nil
-}
-
-// IsRegisteredForApplication checks if the operator is registered
-// as a signer candidate in the factory for the given application.
-func (ta *tbtcApplication) IsRegisteredForApplication() (bool, error) {
-	return ta.bondedECDSAKeepFactoryContract.IsOperatorRegistered(
-		ta.chainHandle.operatorAddress(),
-		ta.tbtcSystemAddress,
-	)
-}
-
-// IsEligibleForApplication checks if the operator is eligible to register
-// as a signer candidate for the given application.
-func (ta *tbtcApplication) IsEligibleForApplication() (bool, error) {
-	return ta.bondedECDSAKeepFactoryContract.IsOperatorEligible(
-		ta.chainHandle.operatorAddress(),
-		ta.tbtcSystemAddress,
-	)
-}
-
-// IsStatusUpToDateForApplication checks if the operator's status
-// is up to date in the signers' pool of the given application.
-func (ta *tbtcApplication) IsStatusUpToDateForApplication() (bool, error) {
-	return ta.bondedECDSAKeepFactoryContract.IsOperatorUpToDate(
-		ta.chainHandle.operatorAddress(),
-		ta.tbtcSystemAddress,
-	)
-}
-
-// UpdateStatusForApplication updates the operator's status in the signers'
-// pool for the given application.
-func (ta *tbtcApplication) UpdateStatusForApplication() error {
-	transaction, err := ta.bondedECDSAKeepFactoryContract.UpdateOperatorStatus(
-		ta.chainHandle.operatorAddress(),
-		ta.tbtcSystemAddress,
-	)
-	if err != nil {
-		return err
-	}
-
-	logger.Debugf(
-		"submitted UpdateOperatorStatus transaction with hash: [%s]",
-		transaction.Hash(),
-	)
-
-	return nil
-}
-
 // OnDepositCreated installs a callback that is invoked when an
 // on-chain notification of a new deposit creation is seen.
 func (ta *tbtcApplication) OnDepositCreated(
@@ -299,7 +211,7 @@ func (ta *tbtcApplication) PastDepositRedemptionRequestedEvents(
 func (ta *tbtcApplication) Keep(
 	depositAddress string,
 ) (chain.BondedECDSAKeepHandle, error) {
-	deposit, err := ta.getDepositContract(depositAddress)
+	deposit, err := ta.getDepositContract(depositAddress, chain.UrgencyNormal)
 	if err != nil {
 		return nil, err
 	}
@@ -309,24 +221,33 @@ func (ta *tbtcApplication) Keep(
 		return nil, err
 	}
 
-	return ta.chainHandle.GetKeepWithID(ethereumChainID(keepAddress))
+	return ta.chainHandle.GetKeepWithID(ta.chainHandle.toChainID(keepAddress))
 }
 
 // RetrieveSignerPubkey retrieves the signer public key for the
-// provided deposit.
+// provided deposit. The transaction is of the normal urgency class.
 func (ta *tbtcApplication) RetrieveSignerPubkey(
 	depositAddress string,
 ) error {
-	deposit, err := ta.getDepositContract(depositAddress)
+	deposit, err := ta.getDepositContract(depositAddress, chain.UrgencyNormal)
 	if err != nil {
 		return err
 	}
 
-	transaction, err := deposit.RetrieveSignerPubkey()
+	transaction, err := deposit.RetrieveSignerPubkey(
+		ta.chainHandle.transactionOptions(chain.UrgencyNormal, 0),
+	)
 	if err != nil {
 		return err
 	}
 
+	ta.chainHandle.recordTransaction(
+		"RetrieveSignerPubkey",
+		ta.depositKeepID(deposit, depositAddress),
+		depositAddress,
+		transaction,
+	)
+
 	logger.Debugf(
 		"submitted RetrieveSignerPubkey transaction with hash: [%s]",
 		transaction.Hash(),
@@ -336,23 +257,35 @@ func (ta *tbtcApplication) RetrieveSignerPubkey(
 }
 
 // ProvideRedemptionSignature provides the redemption signature for the
-// provided deposit.
+// provided deposit. The transaction is of the critical urgency class.
 func (ta *tbtcApplication) ProvideRedemptionSignature(
 	depositAddress string,
 	v uint8,
 	r [32]uint8,
 	s [32]uint8,
 ) error {
-	deposit, err := ta.getDepositContract(depositAddress)
+	deposit, err := ta.getDepositContract(depositAddress, chain.UrgencyCritical)
 	if err != nil {
 		return err
 	}
 
-	transaction, err := deposit.ProvideRedemptionSignature(v, r, s)
+	transaction, err := deposit.ProvideRedemptionSignature(
+		v,
+		r,
+		s,
+		ta.chainHandle.transactionOptions(chain.UrgencyCritical, 0),
+	)
 	if err != nil {
 		return err
 	}
 
+	ta.chainHandle.recordTransaction(
+		"ProvideRedemptionSignature",
+		ta.depositKeepID(deposit, depositAddress),
+		depositAddress,
+		transaction,
+	)
+
 	logger.Debugf(
 		"submitted ProvideRedemptionSignature transaction with hash: [%s]",
 		transaction.Hash(),
@@ -362,12 +295,13 @@ func (ta *tbtcApplication) ProvideRedemptionSignature(
 }
 
 // IncreaseRedemptionFee increases the redemption fee for the provided deposit.
+// The transaction is of the normal urgency class.
 func (ta *tbtcApplication) IncreaseRedemptionFee(
 	depositAddress string,
 	previousOutputValueBytes [8]uint8,
 	newOutputValueBytes [8]uint8,
 ) error {
-	deposit, err := ta.getDepositContract(depositAddress)
+	deposit, err := ta.getDepositContract(depositAddress, chain.UrgencyNormal)
 	if err != nil {
 		return err
 	}
@@ -375,11 +309,19 @@ func (ta *tbtcApplication) IncreaseRedemptionFee(
 	transaction, err := deposit.IncreaseRedemptionFee(
 		previousOutputValueBytes,
 		newOutputValueBytes,
+		ta.chainHandle.transactionOptions(chain.UrgencyNormal, 0),
 	)
 	if err != nil {
 		return err
 	}
 
+	ta.chainHandle.recordTransaction(
+		"IncreaseRedemptionFee",
+		ta.depositKeepID(deposit, depositAddress),
+		depositAddress,
+		transaction,
+	)
+
 	logger.Debugf(
 		"submitted IncreaseRedemptionFee transaction with hash: [%s]",
 		transaction.Hash(),
@@ -389,6 +331,7 @@ func (ta *tbtcApplication) IncreaseRedemptionFee(
 }
 
 // ProvideRedemptionProof provides the redemption proof for the provided deposit.
+// The transaction is of the critical urgency class.
 func (ta *tbtcApplication) ProvideRedemptionProof(
 	depositAddress string,
 	txVersion [4]uint8,
@@ -399,7 +342,7 @@ func (ta *tbtcApplication) ProvideRedemptionProof(
 	txIndexInBlock *big.Int,
 	bitcoinHeaders []uint8,
 ) error {
-	deposit, err := ta.getDepositContract(depositAddress)
+	deposit, err := ta.getDepositContract(depositAddress, chain.UrgencyCritical)
 	if err != nil {
 		return err
 	}
@@ -412,11 +355,19 @@ func (ta *tbtcApplication) ProvideRedemptionProof(
 		merkleProof,
 		txIndexInBlock,
 		bitcoinHeaders,
+		ta.chainHandle.transactionOptions(chain.UrgencyCritical, 0),
 	)
 	if err != nil {
 		return err
 	}
 
+	ta.chainHandle.recordTransaction(
+		"ProvideRedemptionProof",
+		ta.depositKeepID(deposit, depositAddress),
+		depositAddress,
+		transaction,
+	)
+
 	logger.Debugf(
 		"submitted ProvideRedemptionProof transaction with hash: [%s]",
 		transaction.Hash(),
@@ -429,7 +380,7 @@ func (ta *tbtcApplication) ProvideRedemptionProof(
 func (ta *tbtcApplication) CurrentState(
 	depositAddress string,
 ) (chain.DepositState, error) {
-	deposit, err := ta.getDepositContract(depositAddress)
+	deposit, err := ta.getDepositContract(depositAddress, chain.UrgencyNormal)
 	if err != nil {
 		return 0, err
 	}
@@ -442,8 +393,32 @@ func (ta *tbtcApplication) CurrentState(
 	return chain.DepositState(state.Uint64()), err
 }
 
+// getDepositContract returns a binding of the deposit contract submitting
+// transactions with the mining waiter of the given urgency class.
+// depositKeepID returns the address of the keep backing the deposit, so that
+// transactions submitted to the deposit are attributed to the keep in the
+// transaction ledger. If the address can not be read, an empty string is
+// returned and the transaction is recorded for the deposit only.
+func (ta *tbtcApplication) depositKeepID(
+	deposit *tbtccontract.Deposit,
+	depositAddress string,
+) string {
+	keepAddress, err := deposit.KeepAddress()
+	if err != nil {
+		logger.Warnf(
+			"could not get keep of deposit [%s] for the ledger: [%v]",
+			depositAddress,
+			err,
+		)
+		return ""
+	}
+
+	return keepAddress.Hex()
+}
+
 func (ta *tbtcApplication) getDepositContract(
 	address string,
+	urgency chain.Urgency,
 ) (*tbtccontract.Deposit, error) {
 	if !common.IsHexAddress(address) {
 		return nil, fmt.Errorf("incorrect deposit contract address")
@@ -455,7 +430,7 @@ func (ta *tbtcApplication) getDepositContract(
 		ta.chainHandle.accountKey,
 		ta.chainHandle.client,
 		ta.chainHandle.nonceManager,
-		ta.chainHandle.miningWaiter,
+		ta.chainHandle.urgencyClasses[urgency].miningWaiter,
 		ta.chainHandle.blockCounter,
 		ta.chainHandle.transactionMutex,
 	)
@@ -470,7 +445,7 @@ func (ta *tbtcApplication) getDepositContract(
 func (ta *tbtcApplication) FundingInfo(
 	depositAddress string,
 ) (*chain.FundingInfo, error) {
-	deposit, err := ta.getDepositContract(depositAddress)
+	deposit, err := ta.getDepositContract(depositAddress, chain.UrgencyNormal)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,119 @@
+//+build !celo
+
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+)
+
+// urgencyClass holds transaction submission components configured for
+// a single urgency class.
+type urgencyClass struct {
+	miningWaiter       *ethutil.MiningWaiter
+	transactionOptions ethutil.TransactionOptions
+}
+
+// newUrgencyClasses creates transaction submission components for all
+// urgency classes. Class-specific fee cap and mining check interval override
+// the global values from the Ethereum config. Priority fees are not set on
+// networks using legacy transactions.
+func newUrgencyClasses(
+	client ethutil.EthereumClient,
+	config *ethereum.Config,
+	network *chain.NetworkConfig,
+	urgencyConfig *chain.UrgencyConfig,
+) map[chain.Urgency]*urgencyClass {
+	classes := make(map[chain.Urgency]*urgencyClass)
+
+	for _, urgency := range chain.Urgencies {
+		classConfig := urgencyConfig.Class(urgency)
+
+		miningWaiterConfig := *config
+		if classConfig.MaxGasFeeCap != nil {
+			miningWaiterConfig.MaxGasFeeCap = classConfig.MaxGasFeeCap
+		}
+		if classConfig.MiningCheckInterval != 0 {
+			miningWaiterConfig.MiningCheckInterval =
+				classConfig.MiningCheckInterval
+		}
+
+		transactionOptions := ethutil.TransactionOptions{}
+		if classConfig.GasTipCap != nil &&
+			(network == nil || network.FeeModel != chain.FeeModelLegacy) {
+			transactionOptions.GasTipCap = classConfig.GasTipCap.Int
+		}
+
+		logger.Infof("configuring [%v] urgency transactions", urgency)
+
+		classes[urgency] = &urgencyClass{
+			miningWaiter:       ethutil.NewMiningWaiter(client, miningWaiterConfig),
+			transactionOptions: transactionOptions,
+		}
+	}
+
+	return classes
+}
+
+// transactionOptions returns transaction options for the given urgency class
+// with the provided gas limit applied. Gas limit of 0 means the limit is
+// estimated.
+func (ec *ethereumChain) transactionOptions(
+	urgency chain.Urgency,
+	gasLimit uint64,
+) ethutil.TransactionOptions {
+	options := ec.urgencyClasses[urgency].transactionOptions
+	options.GasLimit = gasLimit
+	return options
+}
+
+// gasFeeCap returns the fee per gas transactions of the given urgency class
+// are submitted with at most, the same way the contract bindings set it.
+// On networks using EIP-1559 transactions, it is the gas tip cap of the
+// class, or the tip suggested by the client if the class has none, increased
+// by twice the base fee of the latest block. On networks using legacy
+// transactions, it is the gas price suggested by the client. The cap is the
+// one of the first submission; the mining waiter of the class may raise it
+// for resubmissions up to the maximal gas fee cap of the class.
+func (ec *ethereumChain) gasFeeCap(urgency chain.Urgency) (*big.Int, error) {
+	ctx := context.Background()
+
+	legacy := ec.network != nil && ec.network.FeeModel == chain.FeeModelLegacy
+
+	var baseFee *big.Int
+	if !legacy {
+		header, err := ec.client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get latest block header: [%v]", err)
+		}
+		baseFee = header.BaseFee
+	}
+
+	// Blocks without base fee are produced before the London fork.
+	if baseFee == nil {
+		gasPrice, err := ec.client.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get suggested gas price: [%v]", err)
+		}
+		return gasPrice, nil
+	}
+
+	gasTipCap := ec.urgencyClasses[urgency].transactionOptions.GasTipCap
+	if gasTipCap == nil {
+		suggestedGasTipCap, err := ec.client.SuggestGasTipCap(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get suggested gas tip cap: [%v]", err)
+		}
+		gasTipCap = suggestedGasTipCap
+	}
+
+	return new(big.Int).Add(
+		gasTipCap,
+		new(big.Int).Mul(baseFee, big.NewInt(2)),
+	), nil
+}
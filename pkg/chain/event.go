@@ -4,6 +4,7 @@ package chain
 type BondedECDSAKeepCreatedEvent struct {
 	Keep                 BondedECDSAKeepHandle
 	MemberIDs            []ID // keep member ids
+	Application          ID   // application which opened the keep
 	HonestThreshold      uint64
 	BlockNumber          uint64
 	ThisOperatorIsMember bool
@@ -26,10 +27,13 @@ type PublicKeyPublishedEvent struct {
 }
 
 // SignatureRequestedEvent is an event emitted when a user requests
-// a digest to be signed.
+// a digest to be signed. The transaction hash is empty if the request has
+// not been observed as an event, e.g. when it is found by querying the keep
+// state.
 type SignatureRequestedEvent struct {
-	Digest      [32]byte
-	BlockNumber uint64
+	Digest          [32]byte
+	BlockNumber     uint64
+	TransactionHash string
 }
 
 // KeepClosedEvent is an event emitted when a keep has been closed.
@@ -0,0 +1,3864 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contract
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	hostchainabi "github.com/celo-org/celo-blockchain/accounts/abi"
+	"github.com/celo-org/celo-blockchain/accounts/abi/bind"
+	"github.com/celo-org/celo-blockchain/accounts/keystore"
+	"github.com/celo-org/celo-blockchain/common"
+	"github.com/celo-org/celo-blockchain/core/types"
+	"github.com/celo-org/celo-blockchain/event"
+
+	"github.com/ipfs/go-log"
+
+	chainutil "github.com/keep-network/keep-common/pkg/chain/celo/celoutil"
+	"github.com/keep-network/keep-common/pkg/chain/ethlike"
+	"github.com/keep-network/keep-common/pkg/subscription"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/celo/abi"
+)
+
+// Create a package-level logger for this contract. The logger exists at
+// package level so that the logger is registered at startup and can be
+// included or excluded from logging at startup by name.
+var fbecdsakLogger = log.Logger("keep-contract-FullyBackedECDSAKeep")
+
+type FullyBackedECDSAKeep struct {
+	contract          *abi.FullyBackedECDSAKeep
+	contractAddress   common.Address
+	contractABI       *hostchainabi.ABI
+	caller            bind.ContractCaller
+	transactor        bind.ContractTransactor
+	callerOptions     *bind.CallOpts
+	transactorOptions *bind.TransactOpts
+	errorResolver     *chainutil.ErrorResolver
+	nonceManager      *ethlike.NonceManager
+	miningWaiter      *chainutil.MiningWaiter
+	blockCounter      *ethlike.BlockCounter
+
+	transactionMutex *sync.Mutex
+}
+
+func NewFullyBackedECDSAKeep(
+	contractAddress common.Address,
+	chainId *big.Int,
+	accountKey *keystore.Key,
+	backend bind.ContractBackend,
+	nonceManager *ethlike.NonceManager,
+	miningWaiter *chainutil.MiningWaiter,
+	blockCounter *ethlike.BlockCounter,
+	transactionMutex *sync.Mutex,
+) (*FullyBackedECDSAKeep, error) {
+	callerOptions := &bind.CallOpts{
+		From: accountKey.Address,
+	}
+
+	// FIXME Switch to bind.NewKeyedTransactorWithChainID when
+	// FIXME celo-org/celo-blockchain merges in changes from upstream
+	// FIXME ethereum/go-ethereum beyond v1.9.25.
+	transactorOptions, err := chainutil.NewKeyedTransactorWithChainID(
+		accountKey.PrivateKey,
+		chainId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate transactor: [%v]", err)
+	}
+
+	contract, err := abi.NewFullyBackedECDSAKeep(
+		contractAddress,
+		backend,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to instantiate contract at address: %s [%v]",
+			contractAddress.String(),
+			err,
+		)
+	}
+
+	contractABI, err := hostchainabi.JSON(strings.NewReader(abi.FullyBackedECDSAKeepABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate ABI: [%v]", err)
+	}
+
+	return &FullyBackedECDSAKeep{
+		contract:          contract,
+		contractAddress:   contractAddress,
+		contractABI:       &contractABI,
+		caller:            backend,
+		transactor:        backend,
+		callerOptions:     callerOptions,
+		transactorOptions: transactorOptions,
+		errorResolver:     chainutil.NewErrorResolver(backend, &contractABI, &contractAddress),
+		nonceManager:      nonceManager,
+		miningWaiter:      miningWaiter,
+		blockCounter:      blockCounter,
+		transactionMutex:  transactionMutex,
+	}, nil
+}
+
+// ----- Non-const Methods ------
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CloseKeep(
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction closeKeep",
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.CloseKeep(
+		transactorOptions,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			nil,
+			"closeKeep",
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction closeKeep with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbecdsak.contract.CloseKeep(
+				newTransactorOptions,
+			)
+			if err != nil {
+				return nil, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					nil,
+					"closeKeep",
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction closeKeep with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallCloseKeep(
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"closeKeep",
+		&result,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) CloseKeepGasEstimate() (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"closeKeep",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) DistributeERC20Reward(
+	_tokenAddress common.Address,
+	_value *big.Int,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction distributeERC20Reward",
+		" params: ",
+		fmt.Sprint(
+			_tokenAddress,
+			_value,
+		),
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.DistributeERC20Reward(
+		transactorOptions,
+		_tokenAddress,
+		_value,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			nil,
+			"distributeERC20Reward",
+			_tokenAddress,
+			_value,
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction distributeERC20Reward with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbecdsak.contract.DistributeERC20Reward(
+				newTransactorOptions,
+				_tokenAddress,
+				_value,
+			)
+			if err != nil {
+				return nil, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					nil,
+					"distributeERC20Reward",
+					_tokenAddress,
+					_value,
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction distributeERC20Reward with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallDistributeERC20Reward(
+	_tokenAddress common.Address,
+	_value *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"distributeERC20Reward",
+		&result,
+		_tokenAddress,
+		_value,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) DistributeERC20RewardGasEstimate(
+	_tokenAddress common.Address,
+	_value *big.Int,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"distributeERC20Reward",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+		_tokenAddress,
+		_value,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) DistributeETHReward(
+	value *big.Int,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction distributeETHReward",
+		" value: ", value,
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	transactorOptions.Value = value
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.DistributeETHReward(
+		transactorOptions,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			value,
+			"distributeETHReward",
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction distributeETHReward with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbecdsak.contract.DistributeETHReward(
+				newTransactorOptions,
+			)
+			if err != nil {
+				return nil, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					value,
+					"distributeETHReward",
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction distributeETHReward with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallDistributeETHReward(
+	value *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, value,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"distributeETHReward",
+		&result,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) DistributeETHRewardGasEstimate() (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"distributeETHReward",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) Initialize(
+	_owner common.Address,
+	_members []common.Address,
+	_honestThreshold *big.Int,
+	_bonding common.Address,
+	_keepFactory common.Address,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction initialize",
+		" params: ",
+		fmt.Sprint(
+			_owner,
+			_members,
+			_honestThreshold,
+			_bonding,
+			_keepFactory,
+		),
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.Initialize(
+		transactorOptions,
+		_owner,
+		_members,
+		_honestThreshold,
+		_bonding,
+		_keepFactory,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			nil,
+			"initialize",
+			_owner,
+			_members,
+			_honestThreshold,
+			_bonding,
+			_keepFactory,
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction initialize with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbecdsak.contract.Initialize(
+				newTransactorOptions,
+				_owner,
+				_members,
+				_honestThreshold,
+				_bonding,
+				_keepFactory,
+			)
+			if err != nil {
+				return nil, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					nil,
+					"initialize",
+					_owner,
+					_members,
+					_honestThreshold,
+					_bonding,
+					_keepFactory,
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction initialize with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallInitialize(
+	_owner common.Address,
+	_members []common.Address,
+	_honestThreshold *big.Int,
+	_bonding common.Address,
+	_keepFactory common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"initialize",
+		&result,
+		_owner,
+		_members,
+		_honestThreshold,
+		_bonding,
+		_keepFactory,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) InitializeGasEstimate(
+	_owner common.Address,
+	_members []common.Address,
+	_honestThreshold *big.Int,
+	_bonding common.Address,
+	_keepFactory common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"initialize",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+		_owner,
+		_members,
+		_honestThreshold,
+		_bonding,
+		_keepFactory,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) ReturnPartialSignerBonds(
+	value *big.Int,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction returnPartialSignerBonds",
+		" value: ", value,
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	transactorOptions.Value = value
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.ReturnPartialSignerBonds(
+		transactorOptions,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			value,
+			"returnPartialSignerBonds",
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction returnPartialSignerBonds with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbecdsak.contract.ReturnPartialSignerBonds(
+				newTransactorOptions,
+			)
+			if err != nil {
+				return nil, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					value,
+					"returnPartialSignerBonds",
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction returnPartialSignerBonds with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallReturnPartialSignerBonds(
+	value *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, value,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"returnPartialSignerBonds",
+		&result,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) ReturnPartialSignerBondsGasEstimate() (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"returnPartialSignerBonds",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) SeizeSignerBonds(
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction seizeSignerBonds",
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.SeizeSignerBonds(
+		transactorOptions,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			nil,
+			"seizeSignerBonds",
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction seizeSignerBonds with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbecdsak.contract.SeizeSignerBonds(
+				newTransactorOptions,
+			)
+			if err != nil {
+				return nil, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					nil,
+					"seizeSignerBonds",
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction seizeSignerBonds with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallSeizeSignerBonds(
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"seizeSignerBonds",
+		&result,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) SeizeSignerBondsGasEstimate() (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"seizeSignerBonds",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) Sign(
+	_digest [32]uint8,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction sign",
+		" params: ",
+		fmt.Sprint(
+			_digest,
+		),
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.Sign(
+		transactorOptions,
+		_digest,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			nil,
+			"sign",
+			_digest,
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction sign with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbecdsak.contract.Sign(
+				newTransactorOptions,
+				_digest,
+			)
+			if err != nil {
+				return nil, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					nil,
+					"sign",
+					_digest,
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction sign with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallSign(
+	_digest [32]uint8,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"sign",
+		&result,
+		_digest,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) SignGasEstimate(
+	_digest [32]uint8,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"sign",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+		_digest,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) SubmitPublicKey(
+	_publicKey []uint8,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction submitPublicKey",
+		" params: ",
+		fmt.Sprint(
+			_publicKey,
+		),
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.SubmitPublicKey(
+		transactorOptions,
+		_publicKey,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			nil,
+			"submitPublicKey",
+			_publicKey,
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction submitPublicKey with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbecdsak.contract.SubmitPublicKey(
+				newTransactorOptions,
+				_publicKey,
+			)
+			if err != nil {
+				return nil, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					nil,
+					"submitPublicKey",
+					_publicKey,
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction submitPublicKey with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallSubmitPublicKey(
+	_publicKey []uint8,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"submitPublicKey",
+		&result,
+		_publicKey,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) SubmitPublicKeyGasEstimate(
+	_publicKey []uint8,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"submitPublicKey",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+		_publicKey,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) SubmitSignature(
+	_r [32]uint8,
+	_s [32]uint8,
+	_recoveryID uint8,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction submitSignature",
+		" params: ",
+		fmt.Sprint(
+			_r,
+			_s,
+			_recoveryID,
+		),
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.SubmitSignature(
+		transactorOptions,
+		_r,
+		_s,
+		_recoveryID,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			nil,
+			"submitSignature",
+			_r,
+			_s,
+			_recoveryID,
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction submitSignature with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbecdsak.contract.SubmitSignature(
+				newTransactorOptions,
+				_r,
+				_s,
+				_recoveryID,
+			)
+			if err != nil {
+				return nil, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					nil,
+					"submitSignature",
+					_r,
+					_s,
+					_recoveryID,
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction submitSignature with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallSubmitSignature(
+	_r [32]uint8,
+	_s [32]uint8,
+	_recoveryID uint8,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"submitSignature",
+		&result,
+		_r,
+		_s,
+		_recoveryID,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) SubmitSignatureGasEstimate(
+	_r [32]uint8,
+	_s [32]uint8,
+	_recoveryID uint8,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"submitSignature",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+		_r,
+		_s,
+		_recoveryID,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) SubmitSignatureFraud(
+	_v uint8,
+	_r [32]uint8,
+	_s [32]uint8,
+	_signedDigest [32]uint8,
+	_preimage []uint8,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction submitSignatureFraud",
+		" params: ",
+		fmt.Sprint(
+			_v,
+			_r,
+			_s,
+			_signedDigest,
+			_preimage,
+		),
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.SubmitSignatureFraud(
+		transactorOptions,
+		_v,
+		_r,
+		_s,
+		_signedDigest,
+		_preimage,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			nil,
+			"submitSignatureFraud",
+			_v,
+			_r,
+			_s,
+			_signedDigest,
+			_preimage,
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction submitSignatureFraud with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbecdsak.contract.SubmitSignatureFraud(
+				newTransactorOptions,
+				_v,
+				_r,
+				_s,
+				_signedDigest,
+				_preimage,
+			)
+			if err != nil {
+				return nil, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					nil,
+					"submitSignatureFraud",
+					_v,
+					_r,
+					_s,
+					_signedDigest,
+					_preimage,
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction submitSignatureFraud with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallSubmitSignatureFraud(
+	_v uint8,
+	_r [32]uint8,
+	_s [32]uint8,
+	_signedDigest [32]uint8,
+	_preimage []uint8,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"submitSignatureFraud",
+		&result,
+		_v,
+		_r,
+		_s,
+		_signedDigest,
+		_preimage,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) SubmitSignatureFraudGasEstimate(
+	_v uint8,
+	_r [32]uint8,
+	_s [32]uint8,
+	_signedDigest [32]uint8,
+	_preimage []uint8,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"submitSignatureFraud",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+		_v,
+		_r,
+		_s,
+		_signedDigest,
+		_preimage,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) Withdraw(
+	_member common.Address,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbecdsakLogger.Debug(
+		"submitting transaction withdraw",
+		" params: ",
+		fmt.Sprint(
+			_member,
+		),
+	)
+
+	fbecdsak.transactionMutex.Lock()
+	defer fbecdsak.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbecdsak.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbecdsak.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbecdsak.contract.Withdraw(
+		transactorOptions,
+		_member,
+	)
+	if err != nil {
+		return transaction, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.transactorOptions.From,
+			nil,
+			"withdraw",
+			_member,
+		)
+	}
+
+	fbecdsakLogger.Infof(
+		"submitted transaction withdraw with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbecdsak.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbecdsak.contract.Withdraw(
+				newTransactorOptions,
+				_member,
+			)
+			if err != nil {
+				return nil, fbecdsak.errorResolver.ResolveError(
+					err,
+					fbecdsak.transactorOptions.From,
+					nil,
+					"withdraw",
+					_member,
+				)
+			}
+
+			fbecdsakLogger.Infof(
+				"submitted transaction withdraw with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbecdsak.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbecdsak *FullyBackedECDSAKeep) CallWithdraw(
+	_member common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.transactorOptions.From,
+		blockNumber, nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"withdraw",
+		&result,
+		_member,
+	)
+
+	return err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) WithdrawGasEstimate(
+	_member common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbecdsak.callerOptions.From,
+		fbecdsak.contractAddress,
+		"withdraw",
+		fbecdsak.contractABI,
+		fbecdsak.transactor,
+		_member,
+	)
+
+	return result, err
+}
+
+// ----- Const Methods ------
+
+func (fbecdsak *FullyBackedECDSAKeep) CheckBondAmount() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsak.contract.CheckBondAmount(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"checkBondAmount",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) CheckBondAmountAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"checkBondAmount",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) CheckSignatureFraud(
+	_v uint8,
+	_r [32]uint8,
+	_s [32]uint8,
+	_signedDigest [32]uint8,
+	_preimage []uint8,
+) (bool, error) {
+	var result bool
+	result, err := fbecdsak.contract.CheckSignatureFraud(
+		fbecdsak.callerOptions,
+		_v,
+		_r,
+		_s,
+		_signedDigest,
+		_preimage,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"checkSignatureFraud",
+			_v,
+			_r,
+			_s,
+			_signedDigest,
+			_preimage,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) CheckSignatureFraudAtBlock(
+	_v uint8,
+	_r [32]uint8,
+	_s [32]uint8,
+	_signedDigest [32]uint8,
+	_preimage []uint8,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"checkSignatureFraud",
+		&result,
+		_v,
+		_r,
+		_s,
+		_signedDigest,
+		_preimage,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) Digest() ([32]uint8, error) {
+	var result [32]uint8
+	result, err := fbecdsak.contract.Digest(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"digest",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) DigestAtBlock(
+	blockNumber *big.Int,
+) ([32]uint8, error) {
+	var result [32]uint8
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"digest",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) Digests(
+	arg0 [32]uint8,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsak.contract.Digests(
+		fbecdsak.callerOptions,
+		arg0,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"digests",
+			arg0,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) DigestsAtBlock(
+	arg0 [32]uint8,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"digests",
+		&result,
+		arg0,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetMemberETHBalance(
+	_member common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsak.contract.GetMemberETHBalance(
+		fbecdsak.callerOptions,
+		_member,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"getMemberETHBalance",
+			_member,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetMemberETHBalanceAtBlock(
+	_member common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"getMemberETHBalance",
+		&result,
+		_member,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetMembers() ([]common.Address, error) {
+	var result []common.Address
+	result, err := fbecdsak.contract.GetMembers(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"getMembers",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetMembersAtBlock(
+	blockNumber *big.Int,
+) ([]common.Address, error) {
+	var result []common.Address
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"getMembers",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetOpenedTimestamp() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsak.contract.GetOpenedTimestamp(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"getOpenedTimestamp",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetOpenedTimestampAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"getOpenedTimestamp",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetOwner() (common.Address, error) {
+	var result common.Address
+	result, err := fbecdsak.contract.GetOwner(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"getOwner",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetOwnerAtBlock(
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"getOwner",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetPublicKey() ([]uint8, error) {
+	var result []uint8
+	result, err := fbecdsak.contract.GetPublicKey(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"getPublicKey",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) GetPublicKeyAtBlock(
+	blockNumber *big.Int,
+) ([]uint8, error) {
+	var result []uint8
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"getPublicKey",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) HonestThreshold() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbecdsak.contract.HonestThreshold(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"honestThreshold",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) HonestThresholdAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"honestThreshold",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) IsActive() (bool, error) {
+	var result bool
+	result, err := fbecdsak.contract.IsActive(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"isActive",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) IsActiveAtBlock(
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"isActive",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) IsAwaitingSignature(
+	_digest [32]uint8,
+) (bool, error) {
+	var result bool
+	result, err := fbecdsak.contract.IsAwaitingSignature(
+		fbecdsak.callerOptions,
+		_digest,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"isAwaitingSignature",
+			_digest,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) IsAwaitingSignatureAtBlock(
+	_digest [32]uint8,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"isAwaitingSignature",
+		&result,
+		_digest,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) IsClosed() (bool, error) {
+	var result bool
+	result, err := fbecdsak.contract.IsClosed(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"isClosed",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) IsClosedAtBlock(
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"isClosed",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) IsTerminated() (bool, error) {
+	var result bool
+	result, err := fbecdsak.contract.IsTerminated(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"isTerminated",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) IsTerminatedAtBlock(
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"isTerminated",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) Members(
+	arg0 *big.Int,
+) (common.Address, error) {
+	var result common.Address
+	result, err := fbecdsak.contract.Members(
+		fbecdsak.callerOptions,
+		arg0,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"members",
+			arg0,
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) MembersAtBlock(
+	arg0 *big.Int,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"members",
+		&result,
+		arg0,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) Owner() (common.Address, error) {
+	var result common.Address
+	result, err := fbecdsak.contract.Owner(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"owner",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) OwnerAtBlock(
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"owner",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) PublicKey() ([]uint8, error) {
+	var result []uint8
+	result, err := fbecdsak.contract.PublicKey(
+		fbecdsak.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbecdsak.errorResolver.ResolveError(
+			err,
+			fbecdsak.callerOptions.From,
+			nil,
+			"publicKey",
+		)
+	}
+
+	return result, err
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) PublicKeyAtBlock(
+	blockNumber *big.Int,
+) ([]uint8, error) {
+	var result []uint8
+
+	err := chainutil.CallAtBlock(
+		fbecdsak.callerOptions.From,
+		blockNumber,
+		nil,
+		fbecdsak.contractABI,
+		fbecdsak.caller,
+		fbecdsak.errorResolver,
+		fbecdsak.contractAddress,
+		"publicKey",
+		&result,
+	)
+
+	return result, err
+}
+
+// ------ Events -------
+
+func (fbecdsak *FullyBackedECDSAKeep) ConflictingPublicKeySubmitted(
+	opts *ethlike.SubscribeOpts,
+	submittingMemberFilter []common.Address,
+) *FbecdsakConflictingPublicKeySubmittedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &FbecdsakConflictingPublicKeySubmittedSubscription{
+		fbecdsak,
+		opts,
+		submittingMemberFilter,
+	}
+}
+
+type FbecdsakConflictingPublicKeySubmittedSubscription struct {
+	contract               *FullyBackedECDSAKeep
+	opts                   *ethlike.SubscribeOpts
+	submittingMemberFilter []common.Address
+}
+
+type fullyBackedECDSAKeepConflictingPublicKeySubmittedFunc func(
+	SubmittingMember common.Address,
+	ConflictingPublicKey []uint8,
+	blockNumber uint64,
+)
+
+func (cpkss *FbecdsakConflictingPublicKeySubmittedSubscription) OnEvent(
+	handler fullyBackedECDSAKeepConflictingPublicKeySubmittedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedECDSAKeepConflictingPublicKeySubmitted)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.SubmittingMember,
+					event.ConflictingPublicKey,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := cpkss.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (cpkss *FbecdsakConflictingPublicKeySubmittedSubscription) Pipe(
+	sink chan *abi.FullyBackedECDSAKeepConflictingPublicKeySubmitted,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(cpkss.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := cpkss.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbecdsakLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - cpkss.opts.PastBlocks
+
+				fbecdsakLogger.Infof(
+					"subscription monitoring fetching past ConflictingPublicKeySubmitted events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := cpkss.contract.PastConflictingPublicKeySubmittedEvents(
+					fromBlock,
+					nil,
+					cpkss.submittingMemberFilter,
+				)
+				if err != nil {
+					fbecdsakLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbecdsakLogger.Infof(
+					"subscription monitoring fetched [%v] past ConflictingPublicKeySubmitted events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := cpkss.contract.watchConflictingPublicKeySubmitted(
+		sink,
+		cpkss.submittingMemberFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) watchConflictingPublicKeySubmitted(
+	sink chan *abi.FullyBackedECDSAKeepConflictingPublicKeySubmitted,
+	submittingMemberFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbecdsak.contract.WatchConflictingPublicKeySubmitted(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			submittingMemberFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbecdsakLogger.Errorf(
+			"subscription to event ConflictingPublicKeySubmitted had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbecdsakLogger.Errorf(
+			"subscription to event ConflictingPublicKeySubmitted failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) PastConflictingPublicKeySubmittedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	submittingMemberFilter []common.Address,
+) ([]*abi.FullyBackedECDSAKeepConflictingPublicKeySubmitted, error) {
+	iterator, err := fbecdsak.contract.FilterConflictingPublicKeySubmitted(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		submittingMemberFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past ConflictingPublicKeySubmitted events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedECDSAKeepConflictingPublicKeySubmitted, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) ERC20RewardDistributed(
+	opts *ethlike.SubscribeOpts,
+	tokenFilter []common.Address,
+) *FbecdsakERC20RewardDistributedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &FbecdsakERC20RewardDistributedSubscription{
+		fbecdsak,
+		opts,
+		tokenFilter,
+	}
+}
+
+type FbecdsakERC20RewardDistributedSubscription struct {
+	contract    *FullyBackedECDSAKeep
+	opts        *ethlike.SubscribeOpts
+	tokenFilter []common.Address
+}
+
+type fullyBackedECDSAKeepERC20RewardDistributedFunc func(
+	Token common.Address,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (ercrds *FbecdsakERC20RewardDistributedSubscription) OnEvent(
+	handler fullyBackedECDSAKeepERC20RewardDistributedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedECDSAKeepERC20RewardDistributed)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Token,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := ercrds.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (ercrds *FbecdsakERC20RewardDistributedSubscription) Pipe(
+	sink chan *abi.FullyBackedECDSAKeepERC20RewardDistributed,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(ercrds.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := ercrds.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbecdsakLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - ercrds.opts.PastBlocks
+
+				fbecdsakLogger.Infof(
+					"subscription monitoring fetching past ERC20RewardDistributed events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := ercrds.contract.PastERC20RewardDistributedEvents(
+					fromBlock,
+					nil,
+					ercrds.tokenFilter,
+				)
+				if err != nil {
+					fbecdsakLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbecdsakLogger.Infof(
+					"subscription monitoring fetched [%v] past ERC20RewardDistributed events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := ercrds.contract.watchERC20RewardDistributed(
+		sink,
+		ercrds.tokenFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) watchERC20RewardDistributed(
+	sink chan *abi.FullyBackedECDSAKeepERC20RewardDistributed,
+	tokenFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbecdsak.contract.WatchERC20RewardDistributed(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			tokenFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbecdsakLogger.Errorf(
+			"subscription to event ERC20RewardDistributed had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbecdsakLogger.Errorf(
+			"subscription to event ERC20RewardDistributed failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) PastERC20RewardDistributedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	tokenFilter []common.Address,
+) ([]*abi.FullyBackedECDSAKeepERC20RewardDistributed, error) {
+	iterator, err := fbecdsak.contract.FilterERC20RewardDistributed(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		tokenFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past ERC20RewardDistributed events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedECDSAKeepERC20RewardDistributed, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) ETHRewardDistributed(
+	opts *ethlike.SubscribeOpts,
+) *FbecdsakETHRewardDistributedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &FbecdsakETHRewardDistributedSubscription{
+		fbecdsak,
+		opts,
+	}
+}
+
+type FbecdsakETHRewardDistributedSubscription struct {
+	contract *FullyBackedECDSAKeep
+	opts     *ethlike.SubscribeOpts
+}
+
+type fullyBackedECDSAKeepETHRewardDistributedFunc func(
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (ethrds *FbecdsakETHRewardDistributedSubscription) OnEvent(
+	handler fullyBackedECDSAKeepETHRewardDistributedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedECDSAKeepETHRewardDistributed)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := ethrds.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (ethrds *FbecdsakETHRewardDistributedSubscription) Pipe(
+	sink chan *abi.FullyBackedECDSAKeepETHRewardDistributed,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(ethrds.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := ethrds.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbecdsakLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - ethrds.opts.PastBlocks
+
+				fbecdsakLogger.Infof(
+					"subscription monitoring fetching past ETHRewardDistributed events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := ethrds.contract.PastETHRewardDistributedEvents(
+					fromBlock,
+					nil,
+				)
+				if err != nil {
+					fbecdsakLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbecdsakLogger.Infof(
+					"subscription monitoring fetched [%v] past ETHRewardDistributed events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := ethrds.contract.watchETHRewardDistributed(
+		sink,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) watchETHRewardDistributed(
+	sink chan *abi.FullyBackedECDSAKeepETHRewardDistributed,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbecdsak.contract.WatchETHRewardDistributed(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbecdsakLogger.Errorf(
+			"subscription to event ETHRewardDistributed had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbecdsakLogger.Errorf(
+			"subscription to event ETHRewardDistributed failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) PastETHRewardDistributedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+) ([]*abi.FullyBackedECDSAKeepETHRewardDistributed, error) {
+	iterator, err := fbecdsak.contract.FilterETHRewardDistributed(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past ETHRewardDistributed events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedECDSAKeepETHRewardDistributed, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) KeepClosed(
+	opts *ethlike.SubscribeOpts,
+) *FbecdsakKeepClosedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &FbecdsakKeepClosedSubscription{
+		fbecdsak,
+		opts,
+	}
+}
+
+type FbecdsakKeepClosedSubscription struct {
+	contract *FullyBackedECDSAKeep
+	opts     *ethlike.SubscribeOpts
+}
+
+type fullyBackedECDSAKeepKeepClosedFunc func(
+	blockNumber uint64,
+)
+
+func (kcs *FbecdsakKeepClosedSubscription) OnEvent(
+	handler fullyBackedECDSAKeepKeepClosedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedECDSAKeepKeepClosed)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := kcs.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (kcs *FbecdsakKeepClosedSubscription) Pipe(
+	sink chan *abi.FullyBackedECDSAKeepKeepClosed,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(kcs.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := kcs.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbecdsakLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - kcs.opts.PastBlocks
+
+				fbecdsakLogger.Infof(
+					"subscription monitoring fetching past KeepClosed events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := kcs.contract.PastKeepClosedEvents(
+					fromBlock,
+					nil,
+				)
+				if err != nil {
+					fbecdsakLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbecdsakLogger.Infof(
+					"subscription monitoring fetched [%v] past KeepClosed events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := kcs.contract.watchKeepClosed(
+		sink,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) watchKeepClosed(
+	sink chan *abi.FullyBackedECDSAKeepKeepClosed,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbecdsak.contract.WatchKeepClosed(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbecdsakLogger.Errorf(
+			"subscription to event KeepClosed had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbecdsakLogger.Errorf(
+			"subscription to event KeepClosed failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) PastKeepClosedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+) ([]*abi.FullyBackedECDSAKeepKeepClosed, error) {
+	iterator, err := fbecdsak.contract.FilterKeepClosed(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past KeepClosed events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedECDSAKeepKeepClosed, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) KeepTerminated(
+	opts *ethlike.SubscribeOpts,
+) *FbecdsakKeepTerminatedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &FbecdsakKeepTerminatedSubscription{
+		fbecdsak,
+		opts,
+	}
+}
+
+type FbecdsakKeepTerminatedSubscription struct {
+	contract *FullyBackedECDSAKeep
+	opts     *ethlike.SubscribeOpts
+}
+
+type fullyBackedECDSAKeepKeepTerminatedFunc func(
+	blockNumber uint64,
+)
+
+func (kts *FbecdsakKeepTerminatedSubscription) OnEvent(
+	handler fullyBackedECDSAKeepKeepTerminatedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedECDSAKeepKeepTerminated)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := kts.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (kts *FbecdsakKeepTerminatedSubscription) Pipe(
+	sink chan *abi.FullyBackedECDSAKeepKeepTerminated,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(kts.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := kts.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbecdsakLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - kts.opts.PastBlocks
+
+				fbecdsakLogger.Infof(
+					"subscription monitoring fetching past KeepTerminated events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := kts.contract.PastKeepTerminatedEvents(
+					fromBlock,
+					nil,
+				)
+				if err != nil {
+					fbecdsakLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbecdsakLogger.Infof(
+					"subscription monitoring fetched [%v] past KeepTerminated events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := kts.contract.watchKeepTerminated(
+		sink,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) watchKeepTerminated(
+	sink chan *abi.FullyBackedECDSAKeepKeepTerminated,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbecdsak.contract.WatchKeepTerminated(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbecdsakLogger.Errorf(
+			"subscription to event KeepTerminated had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbecdsakLogger.Errorf(
+			"subscription to event KeepTerminated failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) PastKeepTerminatedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+) ([]*abi.FullyBackedECDSAKeepKeepTerminated, error) {
+	iterator, err := fbecdsak.contract.FilterKeepTerminated(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past KeepTerminated events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedECDSAKeepKeepTerminated, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) PublicKeyPublished(
+	opts *ethlike.SubscribeOpts,
+) *FbecdsakPublicKeyPublishedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &FbecdsakPublicKeyPublishedSubscription{
+		fbecdsak,
+		opts,
+	}
+}
+
+type FbecdsakPublicKeyPublishedSubscription struct {
+	contract *FullyBackedECDSAKeep
+	opts     *ethlike.SubscribeOpts
+}
+
+type fullyBackedECDSAKeepPublicKeyPublishedFunc func(
+	PublicKey []uint8,
+	blockNumber uint64,
+)
+
+func (pkps *FbecdsakPublicKeyPublishedSubscription) OnEvent(
+	handler fullyBackedECDSAKeepPublicKeyPublishedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedECDSAKeepPublicKeyPublished)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.PublicKey,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := pkps.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (pkps *FbecdsakPublicKeyPublishedSubscription) Pipe(
+	sink chan *abi.FullyBackedECDSAKeepPublicKeyPublished,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(pkps.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := pkps.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbecdsakLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - pkps.opts.PastBlocks
+
+				fbecdsakLogger.Infof(
+					"subscription monitoring fetching past PublicKeyPublished events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := pkps.contract.PastPublicKeyPublishedEvents(
+					fromBlock,
+					nil,
+				)
+				if err != nil {
+					fbecdsakLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbecdsakLogger.Infof(
+					"subscription monitoring fetched [%v] past PublicKeyPublished events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := pkps.contract.watchPublicKeyPublished(
+		sink,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) watchPublicKeyPublished(
+	sink chan *abi.FullyBackedECDSAKeepPublicKeyPublished,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbecdsak.contract.WatchPublicKeyPublished(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbecdsakLogger.Errorf(
+			"subscription to event PublicKeyPublished had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbecdsakLogger.Errorf(
+			"subscription to event PublicKeyPublished failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) PastPublicKeyPublishedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+) ([]*abi.FullyBackedECDSAKeepPublicKeyPublished, error) {
+	iterator, err := fbecdsak.contract.FilterPublicKeyPublished(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past PublicKeyPublished events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedECDSAKeepPublicKeyPublished, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) SignatureRequested(
+	opts *ethlike.SubscribeOpts,
+	digestFilter [][32]uint8,
+) *FbecdsakSignatureRequestedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &FbecdsakSignatureRequestedSubscription{
+		fbecdsak,
+		opts,
+		digestFilter,
+	}
+}
+
+type FbecdsakSignatureRequestedSubscription struct {
+	contract     *FullyBackedECDSAKeep
+	opts         *ethlike.SubscribeOpts
+	digestFilter [][32]uint8
+}
+
+type fullyBackedECDSAKeepSignatureRequestedFunc func(
+	Digest [32]uint8,
+	blockNumber uint64,
+)
+
+func (srs *FbecdsakSignatureRequestedSubscription) OnEvent(
+	handler fullyBackedECDSAKeepSignatureRequestedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedECDSAKeepSignatureRequested)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Digest,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := srs.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (srs *FbecdsakSignatureRequestedSubscription) Pipe(
+	sink chan *abi.FullyBackedECDSAKeepSignatureRequested,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(srs.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := srs.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbecdsakLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - srs.opts.PastBlocks
+
+				fbecdsakLogger.Infof(
+					"subscription monitoring fetching past SignatureRequested events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := srs.contract.PastSignatureRequestedEvents(
+					fromBlock,
+					nil,
+					srs.digestFilter,
+				)
+				if err != nil {
+					fbecdsakLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbecdsakLogger.Infof(
+					"subscription monitoring fetched [%v] past SignatureRequested events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := srs.contract.watchSignatureRequested(
+		sink,
+		srs.digestFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) watchSignatureRequested(
+	sink chan *abi.FullyBackedECDSAKeepSignatureRequested,
+	digestFilter [][32]uint8,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbecdsak.contract.WatchSignatureRequested(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			digestFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbecdsakLogger.Errorf(
+			"subscription to event SignatureRequested had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbecdsakLogger.Errorf(
+			"subscription to event SignatureRequested failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) PastSignatureRequestedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	digestFilter [][32]uint8,
+) ([]*abi.FullyBackedECDSAKeepSignatureRequested, error) {
+	iterator, err := fbecdsak.contract.FilterSignatureRequested(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		digestFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past SignatureRequested events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedECDSAKeepSignatureRequested, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) SignatureSubmitted(
+	opts *ethlike.SubscribeOpts,
+	digestFilter [][32]uint8,
+) *FbecdsakSignatureSubmittedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &FbecdsakSignatureSubmittedSubscription{
+		fbecdsak,
+		opts,
+		digestFilter,
+	}
+}
+
+type FbecdsakSignatureSubmittedSubscription struct {
+	contract     *FullyBackedECDSAKeep
+	opts         *ethlike.SubscribeOpts
+	digestFilter [][32]uint8
+}
+
+type fullyBackedECDSAKeepSignatureSubmittedFunc func(
+	Digest [32]uint8,
+	R [32]uint8,
+	S [32]uint8,
+	RecoveryID uint8,
+	blockNumber uint64,
+)
+
+func (sss *FbecdsakSignatureSubmittedSubscription) OnEvent(
+	handler fullyBackedECDSAKeepSignatureSubmittedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedECDSAKeepSignatureSubmitted)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Digest,
+					event.R,
+					event.S,
+					event.RecoveryID,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := sss.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (sss *FbecdsakSignatureSubmittedSubscription) Pipe(
+	sink chan *abi.FullyBackedECDSAKeepSignatureSubmitted,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(sss.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := sss.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbecdsakLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - sss.opts.PastBlocks
+
+				fbecdsakLogger.Infof(
+					"subscription monitoring fetching past SignatureSubmitted events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := sss.contract.PastSignatureSubmittedEvents(
+					fromBlock,
+					nil,
+					sss.digestFilter,
+				)
+				if err != nil {
+					fbecdsakLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbecdsakLogger.Infof(
+					"subscription monitoring fetched [%v] past SignatureSubmitted events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := sss.contract.watchSignatureSubmitted(
+		sink,
+		sss.digestFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) watchSignatureSubmitted(
+	sink chan *abi.FullyBackedECDSAKeepSignatureSubmitted,
+	digestFilter [][32]uint8,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbecdsak.contract.WatchSignatureSubmitted(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			digestFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbecdsakLogger.Errorf(
+			"subscription to event SignatureSubmitted had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbecdsakLogger.Errorf(
+			"subscription to event SignatureSubmitted failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbecdsak *FullyBackedECDSAKeep) PastSignatureSubmittedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	digestFilter [][32]uint8,
+) ([]*abi.FullyBackedECDSAKeepSignatureSubmitted, error) {
+	iterator, err := fbecdsak.contract.FilterSignatureSubmitted(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		digestFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past SignatureSubmitted events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedECDSAKeepSignatureSubmitted, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
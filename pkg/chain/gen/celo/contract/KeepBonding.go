@@ -0,0 +1,3078 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contract
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	hostchainabi "github.com/celo-org/celo-blockchain/accounts/abi"
+	"github.com/celo-org/celo-blockchain/accounts/abi/bind"
+	"github.com/celo-org/celo-blockchain/accounts/keystore"
+	"github.com/celo-org/celo-blockchain/common"
+	"github.com/celo-org/celo-blockchain/core/types"
+	"github.com/celo-org/celo-blockchain/event"
+
+	"github.com/ipfs/go-log"
+
+	chainutil "github.com/keep-network/keep-common/pkg/chain/celo/celoutil"
+	"github.com/keep-network/keep-common/pkg/chain/ethlike"
+	"github.com/keep-network/keep-common/pkg/subscription"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/celo/abi"
+)
+
+// Create a package-level logger for this contract. The logger exists at
+// package level so that the logger is registered at startup and can be
+// included or excluded from logging at startup by name.
+var kbLogger = log.Logger("keep-contract-KeepBonding")
+
+type KeepBonding struct {
+	contract          *abi.KeepBonding
+	contractAddress   common.Address
+	contractABI       *hostchainabi.ABI
+	caller            bind.ContractCaller
+	transactor        bind.ContractTransactor
+	callerOptions     *bind.CallOpts
+	transactorOptions *bind.TransactOpts
+	errorResolver     *chainutil.ErrorResolver
+	nonceManager      *ethlike.NonceManager
+	miningWaiter      *chainutil.MiningWaiter
+	blockCounter      *ethlike.BlockCounter
+
+	transactionMutex *sync.Mutex
+}
+
+func NewKeepBonding(
+	contractAddress common.Address,
+	chainId *big.Int,
+	accountKey *keystore.Key,
+	backend bind.ContractBackend,
+	nonceManager *ethlike.NonceManager,
+	miningWaiter *chainutil.MiningWaiter,
+	blockCounter *ethlike.BlockCounter,
+	transactionMutex *sync.Mutex,
+) (*KeepBonding, error) {
+	callerOptions := &bind.CallOpts{
+		From: accountKey.Address,
+	}
+
+	// FIXME Switch to bind.NewKeyedTransactorWithChainID when
+	// FIXME celo-org/celo-blockchain merges in changes from upstream
+	// FIXME ethereum/go-ethereum beyond v1.9.25.
+	transactorOptions, err := chainutil.NewKeyedTransactorWithChainID(
+		accountKey.PrivateKey,
+		chainId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate transactor: [%v]", err)
+	}
+
+	contract, err := abi.NewKeepBonding(
+		contractAddress,
+		backend,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to instantiate contract at address: %s [%v]",
+			contractAddress.String(),
+			err,
+		)
+	}
+
+	contractABI, err := hostchainabi.JSON(strings.NewReader(abi.KeepBondingABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate ABI: [%v]", err)
+	}
+
+	return &KeepBonding{
+		contract:          contract,
+		contractAddress:   contractAddress,
+		contractABI:       &contractABI,
+		caller:            backend,
+		transactor:        backend,
+		callerOptions:     callerOptions,
+		transactorOptions: transactorOptions,
+		errorResolver:     chainutil.NewErrorResolver(backend, &contractABI, &contractAddress),
+		nonceManager:      nonceManager,
+		miningWaiter:      miningWaiter,
+		blockCounter:      blockCounter,
+		transactionMutex:  transactionMutex,
+	}, nil
+}
+
+// ----- Non-const Methods ------
+
+// Transaction submission.
+func (kb *KeepBonding) AuthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	kbLogger.Debug(
+		"submitting transaction authorizeSortitionPoolContract",
+		" params: ",
+		fmt.Sprint(
+			_operator,
+			_poolAddress,
+		),
+	)
+
+	kb.transactionMutex.Lock()
+	defer kb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *kb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := kb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := kb.contract.AuthorizeSortitionPoolContract(
+		transactorOptions,
+		_operator,
+		_poolAddress,
+	)
+	if err != nil {
+		return transaction, kb.errorResolver.ResolveError(
+			err,
+			kb.transactorOptions.From,
+			nil,
+			"authorizeSortitionPoolContract",
+			_operator,
+			_poolAddress,
+		)
+	}
+
+	kbLogger.Infof(
+		"submitted transaction authorizeSortitionPoolContract with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go kb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := kb.contract.AuthorizeSortitionPoolContract(
+				newTransactorOptions,
+				_operator,
+				_poolAddress,
+			)
+			if err != nil {
+				return nil, kb.errorResolver.ResolveError(
+					err,
+					kb.transactorOptions.From,
+					nil,
+					"authorizeSortitionPoolContract",
+					_operator,
+					_poolAddress,
+				)
+			}
+
+			kbLogger.Infof(
+				"submitted transaction authorizeSortitionPoolContract with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	kb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (kb *KeepBonding) CallAuthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		kb.transactorOptions.From,
+		blockNumber, nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"authorizeSortitionPoolContract",
+		&result,
+		_operator,
+		_poolAddress,
+	)
+
+	return err
+}
+
+func (kb *KeepBonding) AuthorizeSortitionPoolContractGasEstimate(
+	_operator common.Address,
+	_poolAddress common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		kb.callerOptions.From,
+		kb.contractAddress,
+		"authorizeSortitionPoolContract",
+		kb.contractABI,
+		kb.transactor,
+		_operator,
+		_poolAddress,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (kb *KeepBonding) CreateBond(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	authorizedSortitionPool common.Address,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	kbLogger.Debug(
+		"submitting transaction createBond",
+		" params: ",
+		fmt.Sprint(
+			operator,
+			holder,
+			referenceID,
+			amount,
+			authorizedSortitionPool,
+		),
+	)
+
+	kb.transactionMutex.Lock()
+	defer kb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *kb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := kb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := kb.contract.CreateBond(
+		transactorOptions,
+		operator,
+		holder,
+		referenceID,
+		amount,
+		authorizedSortitionPool,
+	)
+	if err != nil {
+		return transaction, kb.errorResolver.ResolveError(
+			err,
+			kb.transactorOptions.From,
+			nil,
+			"createBond",
+			operator,
+			holder,
+			referenceID,
+			amount,
+			authorizedSortitionPool,
+		)
+	}
+
+	kbLogger.Infof(
+		"submitted transaction createBond with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go kb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := kb.contract.CreateBond(
+				newTransactorOptions,
+				operator,
+				holder,
+				referenceID,
+				amount,
+				authorizedSortitionPool,
+			)
+			if err != nil {
+				return nil, kb.errorResolver.ResolveError(
+					err,
+					kb.transactorOptions.From,
+					nil,
+					"createBond",
+					operator,
+					holder,
+					referenceID,
+					amount,
+					authorizedSortitionPool,
+				)
+			}
+
+			kbLogger.Infof(
+				"submitted transaction createBond with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	kb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (kb *KeepBonding) CallCreateBond(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	authorizedSortitionPool common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		kb.transactorOptions.From,
+		blockNumber, nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"createBond",
+		&result,
+		operator,
+		holder,
+		referenceID,
+		amount,
+		authorizedSortitionPool,
+	)
+
+	return err
+}
+
+func (kb *KeepBonding) CreateBondGasEstimate(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	authorizedSortitionPool common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		kb.callerOptions.From,
+		kb.contractAddress,
+		"createBond",
+		kb.contractABI,
+		kb.transactor,
+		operator,
+		holder,
+		referenceID,
+		amount,
+		authorizedSortitionPool,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (kb *KeepBonding) DeauthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	kbLogger.Debug(
+		"submitting transaction deauthorizeSortitionPoolContract",
+		" params: ",
+		fmt.Sprint(
+			_operator,
+			_poolAddress,
+		),
+	)
+
+	kb.transactionMutex.Lock()
+	defer kb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *kb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := kb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := kb.contract.DeauthorizeSortitionPoolContract(
+		transactorOptions,
+		_operator,
+		_poolAddress,
+	)
+	if err != nil {
+		return transaction, kb.errorResolver.ResolveError(
+			err,
+			kb.transactorOptions.From,
+			nil,
+			"deauthorizeSortitionPoolContract",
+			_operator,
+			_poolAddress,
+		)
+	}
+
+	kbLogger.Infof(
+		"submitted transaction deauthorizeSortitionPoolContract with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go kb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := kb.contract.DeauthorizeSortitionPoolContract(
+				newTransactorOptions,
+				_operator,
+				_poolAddress,
+			)
+			if err != nil {
+				return nil, kb.errorResolver.ResolveError(
+					err,
+					kb.transactorOptions.From,
+					nil,
+					"deauthorizeSortitionPoolContract",
+					_operator,
+					_poolAddress,
+				)
+			}
+
+			kbLogger.Infof(
+				"submitted transaction deauthorizeSortitionPoolContract with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	kb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (kb *KeepBonding) CallDeauthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		kb.transactorOptions.From,
+		blockNumber, nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"deauthorizeSortitionPoolContract",
+		&result,
+		_operator,
+		_poolAddress,
+	)
+
+	return err
+}
+
+func (kb *KeepBonding) DeauthorizeSortitionPoolContractGasEstimate(
+	_operator common.Address,
+	_poolAddress common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		kb.callerOptions.From,
+		kb.contractAddress,
+		"deauthorizeSortitionPoolContract",
+		kb.contractABI,
+		kb.transactor,
+		_operator,
+		_poolAddress,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (kb *KeepBonding) Deposit(
+	operator common.Address,
+	value *big.Int,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	kbLogger.Debug(
+		"submitting transaction deposit",
+		" params: ",
+		fmt.Sprint(
+			operator,
+		),
+		" value: ", value,
+	)
+
+	kb.transactionMutex.Lock()
+	defer kb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *kb.transactorOptions
+
+	transactorOptions.Value = value
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := kb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := kb.contract.Deposit(
+		transactorOptions,
+		operator,
+	)
+	if err != nil {
+		return transaction, kb.errorResolver.ResolveError(
+			err,
+			kb.transactorOptions.From,
+			value,
+			"deposit",
+			operator,
+		)
+	}
+
+	kbLogger.Infof(
+		"submitted transaction deposit with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go kb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := kb.contract.Deposit(
+				newTransactorOptions,
+				operator,
+			)
+			if err != nil {
+				return nil, kb.errorResolver.ResolveError(
+					err,
+					kb.transactorOptions.From,
+					value,
+					"deposit",
+					operator,
+				)
+			}
+
+			kbLogger.Infof(
+				"submitted transaction deposit with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	kb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (kb *KeepBonding) CallDeposit(
+	operator common.Address,
+	value *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		kb.transactorOptions.From,
+		blockNumber, value,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"deposit",
+		&result,
+		operator,
+	)
+
+	return err
+}
+
+func (kb *KeepBonding) DepositGasEstimate(
+	operator common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		kb.callerOptions.From,
+		kb.contractAddress,
+		"deposit",
+		kb.contractABI,
+		kb.transactor,
+		operator,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (kb *KeepBonding) FreeBond(
+	operator common.Address,
+	referenceID *big.Int,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	kbLogger.Debug(
+		"submitting transaction freeBond",
+		" params: ",
+		fmt.Sprint(
+			operator,
+			referenceID,
+		),
+	)
+
+	kb.transactionMutex.Lock()
+	defer kb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *kb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := kb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := kb.contract.FreeBond(
+		transactorOptions,
+		operator,
+		referenceID,
+	)
+	if err != nil {
+		return transaction, kb.errorResolver.ResolveError(
+			err,
+			kb.transactorOptions.From,
+			nil,
+			"freeBond",
+			operator,
+			referenceID,
+		)
+	}
+
+	kbLogger.Infof(
+		"submitted transaction freeBond with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go kb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := kb.contract.FreeBond(
+				newTransactorOptions,
+				operator,
+				referenceID,
+			)
+			if err != nil {
+				return nil, kb.errorResolver.ResolveError(
+					err,
+					kb.transactorOptions.From,
+					nil,
+					"freeBond",
+					operator,
+					referenceID,
+				)
+			}
+
+			kbLogger.Infof(
+				"submitted transaction freeBond with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	kb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (kb *KeepBonding) CallFreeBond(
+	operator common.Address,
+	referenceID *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		kb.transactorOptions.From,
+		blockNumber, nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"freeBond",
+		&result,
+		operator,
+		referenceID,
+	)
+
+	return err
+}
+
+func (kb *KeepBonding) FreeBondGasEstimate(
+	operator common.Address,
+	referenceID *big.Int,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		kb.callerOptions.From,
+		kb.contractAddress,
+		"freeBond",
+		kb.contractABI,
+		kb.transactor,
+		operator,
+		referenceID,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (kb *KeepBonding) ReassignBond(
+	operator common.Address,
+	referenceID *big.Int,
+	newHolder common.Address,
+	newReferenceID *big.Int,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	kbLogger.Debug(
+		"submitting transaction reassignBond",
+		" params: ",
+		fmt.Sprint(
+			operator,
+			referenceID,
+			newHolder,
+			newReferenceID,
+		),
+	)
+
+	kb.transactionMutex.Lock()
+	defer kb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *kb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := kb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := kb.contract.ReassignBond(
+		transactorOptions,
+		operator,
+		referenceID,
+		newHolder,
+		newReferenceID,
+	)
+	if err != nil {
+		return transaction, kb.errorResolver.ResolveError(
+			err,
+			kb.transactorOptions.From,
+			nil,
+			"reassignBond",
+			operator,
+			referenceID,
+			newHolder,
+			newReferenceID,
+		)
+	}
+
+	kbLogger.Infof(
+		"submitted transaction reassignBond with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go kb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := kb.contract.ReassignBond(
+				newTransactorOptions,
+				operator,
+				referenceID,
+				newHolder,
+				newReferenceID,
+			)
+			if err != nil {
+				return nil, kb.errorResolver.ResolveError(
+					err,
+					kb.transactorOptions.From,
+					nil,
+					"reassignBond",
+					operator,
+					referenceID,
+					newHolder,
+					newReferenceID,
+				)
+			}
+
+			kbLogger.Infof(
+				"submitted transaction reassignBond with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	kb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (kb *KeepBonding) CallReassignBond(
+	operator common.Address,
+	referenceID *big.Int,
+	newHolder common.Address,
+	newReferenceID *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		kb.transactorOptions.From,
+		blockNumber, nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"reassignBond",
+		&result,
+		operator,
+		referenceID,
+		newHolder,
+		newReferenceID,
+	)
+
+	return err
+}
+
+func (kb *KeepBonding) ReassignBondGasEstimate(
+	operator common.Address,
+	referenceID *big.Int,
+	newHolder common.Address,
+	newReferenceID *big.Int,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		kb.callerOptions.From,
+		kb.contractAddress,
+		"reassignBond",
+		kb.contractABI,
+		kb.transactor,
+		operator,
+		referenceID,
+		newHolder,
+		newReferenceID,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (kb *KeepBonding) SeizeBond(
+	operator common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	destination common.Address,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	kbLogger.Debug(
+		"submitting transaction seizeBond",
+		" params: ",
+		fmt.Sprint(
+			operator,
+			referenceID,
+			amount,
+			destination,
+		),
+	)
+
+	kb.transactionMutex.Lock()
+	defer kb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *kb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := kb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := kb.contract.SeizeBond(
+		transactorOptions,
+		operator,
+		referenceID,
+		amount,
+		destination,
+	)
+	if err != nil {
+		return transaction, kb.errorResolver.ResolveError(
+			err,
+			kb.transactorOptions.From,
+			nil,
+			"seizeBond",
+			operator,
+			referenceID,
+			amount,
+			destination,
+		)
+	}
+
+	kbLogger.Infof(
+		"submitted transaction seizeBond with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go kb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := kb.contract.SeizeBond(
+				newTransactorOptions,
+				operator,
+				referenceID,
+				amount,
+				destination,
+			)
+			if err != nil {
+				return nil, kb.errorResolver.ResolveError(
+					err,
+					kb.transactorOptions.From,
+					nil,
+					"seizeBond",
+					operator,
+					referenceID,
+					amount,
+					destination,
+				)
+			}
+
+			kbLogger.Infof(
+				"submitted transaction seizeBond with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	kb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (kb *KeepBonding) CallSeizeBond(
+	operator common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	destination common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		kb.transactorOptions.From,
+		blockNumber, nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"seizeBond",
+		&result,
+		operator,
+		referenceID,
+		amount,
+		destination,
+	)
+
+	return err
+}
+
+func (kb *KeepBonding) SeizeBondGasEstimate(
+	operator common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	destination common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		kb.callerOptions.From,
+		kb.contractAddress,
+		"seizeBond",
+		kb.contractABI,
+		kb.transactor,
+		operator,
+		referenceID,
+		amount,
+		destination,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (kb *KeepBonding) Withdraw(
+	amount *big.Int,
+	operator common.Address,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	kbLogger.Debug(
+		"submitting transaction withdraw",
+		" params: ",
+		fmt.Sprint(
+			amount,
+			operator,
+		),
+	)
+
+	kb.transactionMutex.Lock()
+	defer kb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *kb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := kb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := kb.contract.Withdraw(
+		transactorOptions,
+		amount,
+		operator,
+	)
+	if err != nil {
+		return transaction, kb.errorResolver.ResolveError(
+			err,
+			kb.transactorOptions.From,
+			nil,
+			"withdraw",
+			amount,
+			operator,
+		)
+	}
+
+	kbLogger.Infof(
+		"submitted transaction withdraw with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go kb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := kb.contract.Withdraw(
+				newTransactorOptions,
+				amount,
+				operator,
+			)
+			if err != nil {
+				return nil, kb.errorResolver.ResolveError(
+					err,
+					kb.transactorOptions.From,
+					nil,
+					"withdraw",
+					amount,
+					operator,
+				)
+			}
+
+			kbLogger.Infof(
+				"submitted transaction withdraw with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	kb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (kb *KeepBonding) CallWithdraw(
+	amount *big.Int,
+	operator common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		kb.transactorOptions.From,
+		blockNumber, nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"withdraw",
+		&result,
+		amount,
+		operator,
+	)
+
+	return err
+}
+
+func (kb *KeepBonding) WithdrawGasEstimate(
+	amount *big.Int,
+	operator common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		kb.callerOptions.From,
+		kb.contractAddress,
+		"withdraw",
+		kb.contractABI,
+		kb.transactor,
+		amount,
+		operator,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (kb *KeepBonding) WithdrawAsManagedGrantee(
+	amount *big.Int,
+	operator common.Address,
+	managedGrant common.Address,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	kbLogger.Debug(
+		"submitting transaction withdrawAsManagedGrantee",
+		" params: ",
+		fmt.Sprint(
+			amount,
+			operator,
+			managedGrant,
+		),
+	)
+
+	kb.transactionMutex.Lock()
+	defer kb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *kb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := kb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := kb.contract.WithdrawAsManagedGrantee(
+		transactorOptions,
+		amount,
+		operator,
+		managedGrant,
+	)
+	if err != nil {
+		return transaction, kb.errorResolver.ResolveError(
+			err,
+			kb.transactorOptions.From,
+			nil,
+			"withdrawAsManagedGrantee",
+			amount,
+			operator,
+			managedGrant,
+		)
+	}
+
+	kbLogger.Infof(
+		"submitted transaction withdrawAsManagedGrantee with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go kb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := kb.contract.WithdrawAsManagedGrantee(
+				newTransactorOptions,
+				amount,
+				operator,
+				managedGrant,
+			)
+			if err != nil {
+				return nil, kb.errorResolver.ResolveError(
+					err,
+					kb.transactorOptions.From,
+					nil,
+					"withdrawAsManagedGrantee",
+					amount,
+					operator,
+					managedGrant,
+				)
+			}
+
+			kbLogger.Infof(
+				"submitted transaction withdrawAsManagedGrantee with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	kb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (kb *KeepBonding) CallWithdrawAsManagedGrantee(
+	amount *big.Int,
+	operator common.Address,
+	managedGrant common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		kb.transactorOptions.From,
+		blockNumber, nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"withdrawAsManagedGrantee",
+		&result,
+		amount,
+		operator,
+		managedGrant,
+	)
+
+	return err
+}
+
+func (kb *KeepBonding) WithdrawAsManagedGranteeGasEstimate(
+	amount *big.Int,
+	operator common.Address,
+	managedGrant common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		kb.callerOptions.From,
+		kb.contractAddress,
+		"withdrawAsManagedGrantee",
+		kb.contractABI,
+		kb.transactor,
+		amount,
+		operator,
+		managedGrant,
+	)
+
+	return result, err
+}
+
+// ----- Const Methods ------
+
+func (kb *KeepBonding) AuthorizerOf(
+	_operator common.Address,
+) (common.Address, error) {
+	var result common.Address
+	result, err := kb.contract.AuthorizerOf(
+		kb.callerOptions,
+		_operator,
+	)
+
+	if err != nil {
+		return result, kb.errorResolver.ResolveError(
+			err,
+			kb.callerOptions.From,
+			nil,
+			"authorizerOf",
+			_operator,
+		)
+	}
+
+	return result, err
+}
+
+func (kb *KeepBonding) AuthorizerOfAtBlock(
+	_operator common.Address,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := chainutil.CallAtBlock(
+		kb.callerOptions.From,
+		blockNumber,
+		nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"authorizerOf",
+		&result,
+		_operator,
+	)
+
+	return result, err
+}
+
+func (kb *KeepBonding) AvailableUnbondedValue(
+	operator common.Address,
+	bondCreator common.Address,
+	authorizedSortitionPool common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := kb.contract.AvailableUnbondedValue(
+		kb.callerOptions,
+		operator,
+		bondCreator,
+		authorizedSortitionPool,
+	)
+
+	if err != nil {
+		return result, kb.errorResolver.ResolveError(
+			err,
+			kb.callerOptions.From,
+			nil,
+			"availableUnbondedValue",
+			operator,
+			bondCreator,
+			authorizedSortitionPool,
+		)
+	}
+
+	return result, err
+}
+
+func (kb *KeepBonding) AvailableUnbondedValueAtBlock(
+	operator common.Address,
+	bondCreator common.Address,
+	authorizedSortitionPool common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := chainutil.CallAtBlock(
+		kb.callerOptions.From,
+		blockNumber,
+		nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"availableUnbondedValue",
+		&result,
+		operator,
+		bondCreator,
+		authorizedSortitionPool,
+	)
+
+	return result, err
+}
+
+func (kb *KeepBonding) BeneficiaryOf(
+	_operator common.Address,
+) (common.Address, error) {
+	var result common.Address
+	result, err := kb.contract.BeneficiaryOf(
+		kb.callerOptions,
+		_operator,
+	)
+
+	if err != nil {
+		return result, kb.errorResolver.ResolveError(
+			err,
+			kb.callerOptions.From,
+			nil,
+			"beneficiaryOf",
+			_operator,
+		)
+	}
+
+	return result, err
+}
+
+func (kb *KeepBonding) BeneficiaryOfAtBlock(
+	_operator common.Address,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := chainutil.CallAtBlock(
+		kb.callerOptions.From,
+		blockNumber,
+		nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"beneficiaryOf",
+		&result,
+		_operator,
+	)
+
+	return result, err
+}
+
+func (kb *KeepBonding) BondAmount(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := kb.contract.BondAmount(
+		kb.callerOptions,
+		operator,
+		holder,
+		referenceID,
+	)
+
+	if err != nil {
+		return result, kb.errorResolver.ResolveError(
+			err,
+			kb.callerOptions.From,
+			nil,
+			"bondAmount",
+			operator,
+			holder,
+			referenceID,
+		)
+	}
+
+	return result, err
+}
+
+func (kb *KeepBonding) BondAmountAtBlock(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := chainutil.CallAtBlock(
+		kb.callerOptions.From,
+		blockNumber,
+		nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"bondAmount",
+		&result,
+		operator,
+		holder,
+		referenceID,
+	)
+
+	return result, err
+}
+
+func (kb *KeepBonding) HasSecondaryAuthorization(
+	_operator common.Address,
+	_poolAddress common.Address,
+) (bool, error) {
+	var result bool
+	result, err := kb.contract.HasSecondaryAuthorization(
+		kb.callerOptions,
+		_operator,
+		_poolAddress,
+	)
+
+	if err != nil {
+		return result, kb.errorResolver.ResolveError(
+			err,
+			kb.callerOptions.From,
+			nil,
+			"hasSecondaryAuthorization",
+			_operator,
+			_poolAddress,
+		)
+	}
+
+	return result, err
+}
+
+func (kb *KeepBonding) HasSecondaryAuthorizationAtBlock(
+	_operator common.Address,
+	_poolAddress common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := chainutil.CallAtBlock(
+		kb.callerOptions.From,
+		blockNumber,
+		nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"hasSecondaryAuthorization",
+		&result,
+		_operator,
+		_poolAddress,
+	)
+
+	return result, err
+}
+
+func (kb *KeepBonding) IsAuthorizedForOperator(
+	_operator common.Address,
+	_operatorContract common.Address,
+) (bool, error) {
+	var result bool
+	result, err := kb.contract.IsAuthorizedForOperator(
+		kb.callerOptions,
+		_operator,
+		_operatorContract,
+	)
+
+	if err != nil {
+		return result, kb.errorResolver.ResolveError(
+			err,
+			kb.callerOptions.From,
+			nil,
+			"isAuthorizedForOperator",
+			_operator,
+			_operatorContract,
+		)
+	}
+
+	return result, err
+}
+
+func (kb *KeepBonding) IsAuthorizedForOperatorAtBlock(
+	_operator common.Address,
+	_operatorContract common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := chainutil.CallAtBlock(
+		kb.callerOptions.From,
+		blockNumber,
+		nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"isAuthorizedForOperator",
+		&result,
+		_operator,
+		_operatorContract,
+	)
+
+	return result, err
+}
+
+func (kb *KeepBonding) UnbondedValue(
+	arg0 common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := kb.contract.UnbondedValue(
+		kb.callerOptions,
+		arg0,
+	)
+
+	if err != nil {
+		return result, kb.errorResolver.ResolveError(
+			err,
+			kb.callerOptions.From,
+			nil,
+			"unbondedValue",
+			arg0,
+		)
+	}
+
+	return result, err
+}
+
+func (kb *KeepBonding) UnbondedValueAtBlock(
+	arg0 common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := chainutil.CallAtBlock(
+		kb.callerOptions.From,
+		blockNumber,
+		nil,
+		kb.contractABI,
+		kb.caller,
+		kb.errorResolver,
+		kb.contractAddress,
+		"unbondedValue",
+		&result,
+		arg0,
+	)
+
+	return result, err
+}
+
+// ------ Events -------
+
+func (kb *KeepBonding) BondCreated(
+	opts *ethlike.SubscribeOpts,
+	operatorFilter []common.Address,
+	holderFilter []common.Address,
+	sortitionPoolFilter []common.Address,
+) *KbBondCreatedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &KbBondCreatedSubscription{
+		kb,
+		opts,
+		operatorFilter,
+		holderFilter,
+		sortitionPoolFilter,
+	}
+}
+
+type KbBondCreatedSubscription struct {
+	contract            *KeepBonding
+	opts                *ethlike.SubscribeOpts
+	operatorFilter      []common.Address
+	holderFilter        []common.Address
+	sortitionPoolFilter []common.Address
+}
+
+type keepBondingBondCreatedFunc func(
+	Operator common.Address,
+	Holder common.Address,
+	SortitionPool common.Address,
+	ReferenceID *big.Int,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (bcs *KbBondCreatedSubscription) OnEvent(
+	handler keepBondingBondCreatedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.KeepBondingBondCreated)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.Holder,
+					event.SortitionPool,
+					event.ReferenceID,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := bcs.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (bcs *KbBondCreatedSubscription) Pipe(
+	sink chan *abi.KeepBondingBondCreated,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(bcs.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := bcs.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					kbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - bcs.opts.PastBlocks
+
+				kbLogger.Infof(
+					"subscription monitoring fetching past BondCreated events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := bcs.contract.PastBondCreatedEvents(
+					fromBlock,
+					nil,
+					bcs.operatorFilter,
+					bcs.holderFilter,
+					bcs.sortitionPoolFilter,
+				)
+				if err != nil {
+					kbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				kbLogger.Infof(
+					"subscription monitoring fetched [%v] past BondCreated events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := bcs.contract.watchBondCreated(
+		sink,
+		bcs.operatorFilter,
+		bcs.holderFilter,
+		bcs.sortitionPoolFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (kb *KeepBonding) watchBondCreated(
+	sink chan *abi.KeepBondingBondCreated,
+	operatorFilter []common.Address,
+	holderFilter []common.Address,
+	sortitionPoolFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return kb.contract.WatchBondCreated(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			holderFilter,
+			sortitionPoolFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		kbLogger.Errorf(
+			"subscription to event BondCreated had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		kbLogger.Errorf(
+			"subscription to event BondCreated failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (kb *KeepBonding) PastBondCreatedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	holderFilter []common.Address,
+	sortitionPoolFilter []common.Address,
+) ([]*abi.KeepBondingBondCreated, error) {
+	iterator, err := kb.contract.FilterBondCreated(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		holderFilter,
+		sortitionPoolFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondCreated events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.KeepBondingBondCreated, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (kb *KeepBonding) BondReassigned(
+	opts *ethlike.SubscribeOpts,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) *KbBondReassignedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &KbBondReassignedSubscription{
+		kb,
+		opts,
+		operatorFilter,
+		referenceIDFilter,
+	}
+}
+
+type KbBondReassignedSubscription struct {
+	contract          *KeepBonding
+	opts              *ethlike.SubscribeOpts
+	operatorFilter    []common.Address
+	referenceIDFilter []*big.Int
+}
+
+type keepBondingBondReassignedFunc func(
+	Operator common.Address,
+	ReferenceID *big.Int,
+	NewHolder common.Address,
+	NewReferenceID *big.Int,
+	blockNumber uint64,
+)
+
+func (brs *KbBondReassignedSubscription) OnEvent(
+	handler keepBondingBondReassignedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.KeepBondingBondReassigned)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.ReferenceID,
+					event.NewHolder,
+					event.NewReferenceID,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := brs.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (brs *KbBondReassignedSubscription) Pipe(
+	sink chan *abi.KeepBondingBondReassigned,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(brs.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := brs.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					kbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - brs.opts.PastBlocks
+
+				kbLogger.Infof(
+					"subscription monitoring fetching past BondReassigned events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := brs.contract.PastBondReassignedEvents(
+					fromBlock,
+					nil,
+					brs.operatorFilter,
+					brs.referenceIDFilter,
+				)
+				if err != nil {
+					kbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				kbLogger.Infof(
+					"subscription monitoring fetched [%v] past BondReassigned events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := brs.contract.watchBondReassigned(
+		sink,
+		brs.operatorFilter,
+		brs.referenceIDFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (kb *KeepBonding) watchBondReassigned(
+	sink chan *abi.KeepBondingBondReassigned,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return kb.contract.WatchBondReassigned(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			referenceIDFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		kbLogger.Errorf(
+			"subscription to event BondReassigned had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		kbLogger.Errorf(
+			"subscription to event BondReassigned failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (kb *KeepBonding) PastBondReassignedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) ([]*abi.KeepBondingBondReassigned, error) {
+	iterator, err := kb.contract.FilterBondReassigned(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondReassigned events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.KeepBondingBondReassigned, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (kb *KeepBonding) BondReleased(
+	opts *ethlike.SubscribeOpts,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) *KbBondReleasedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &KbBondReleasedSubscription{
+		kb,
+		opts,
+		operatorFilter,
+		referenceIDFilter,
+	}
+}
+
+type KbBondReleasedSubscription struct {
+	contract          *KeepBonding
+	opts              *ethlike.SubscribeOpts
+	operatorFilter    []common.Address
+	referenceIDFilter []*big.Int
+}
+
+type keepBondingBondReleasedFunc func(
+	Operator common.Address,
+	ReferenceID *big.Int,
+	blockNumber uint64,
+)
+
+func (brs *KbBondReleasedSubscription) OnEvent(
+	handler keepBondingBondReleasedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.KeepBondingBondReleased)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.ReferenceID,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := brs.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (brs *KbBondReleasedSubscription) Pipe(
+	sink chan *abi.KeepBondingBondReleased,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(brs.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := brs.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					kbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - brs.opts.PastBlocks
+
+				kbLogger.Infof(
+					"subscription monitoring fetching past BondReleased events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := brs.contract.PastBondReleasedEvents(
+					fromBlock,
+					nil,
+					brs.operatorFilter,
+					brs.referenceIDFilter,
+				)
+				if err != nil {
+					kbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				kbLogger.Infof(
+					"subscription monitoring fetched [%v] past BondReleased events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := brs.contract.watchBondReleased(
+		sink,
+		brs.operatorFilter,
+		brs.referenceIDFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (kb *KeepBonding) watchBondReleased(
+	sink chan *abi.KeepBondingBondReleased,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return kb.contract.WatchBondReleased(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			referenceIDFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		kbLogger.Errorf(
+			"subscription to event BondReleased had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		kbLogger.Errorf(
+			"subscription to event BondReleased failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (kb *KeepBonding) PastBondReleasedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) ([]*abi.KeepBondingBondReleased, error) {
+	iterator, err := kb.contract.FilterBondReleased(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondReleased events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.KeepBondingBondReleased, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (kb *KeepBonding) BondSeized(
+	opts *ethlike.SubscribeOpts,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) *KbBondSeizedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &KbBondSeizedSubscription{
+		kb,
+		opts,
+		operatorFilter,
+		referenceIDFilter,
+	}
+}
+
+type KbBondSeizedSubscription struct {
+	contract          *KeepBonding
+	opts              *ethlike.SubscribeOpts
+	operatorFilter    []common.Address
+	referenceIDFilter []*big.Int
+}
+
+type keepBondingBondSeizedFunc func(
+	Operator common.Address,
+	ReferenceID *big.Int,
+	Destination common.Address,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (bss *KbBondSeizedSubscription) OnEvent(
+	handler keepBondingBondSeizedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.KeepBondingBondSeized)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.ReferenceID,
+					event.Destination,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := bss.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (bss *KbBondSeizedSubscription) Pipe(
+	sink chan *abi.KeepBondingBondSeized,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(bss.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := bss.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					kbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - bss.opts.PastBlocks
+
+				kbLogger.Infof(
+					"subscription monitoring fetching past BondSeized events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := bss.contract.PastBondSeizedEvents(
+					fromBlock,
+					nil,
+					bss.operatorFilter,
+					bss.referenceIDFilter,
+				)
+				if err != nil {
+					kbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				kbLogger.Infof(
+					"subscription monitoring fetched [%v] past BondSeized events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := bss.contract.watchBondSeized(
+		sink,
+		bss.operatorFilter,
+		bss.referenceIDFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (kb *KeepBonding) watchBondSeized(
+	sink chan *abi.KeepBondingBondSeized,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return kb.contract.WatchBondSeized(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			referenceIDFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		kbLogger.Errorf(
+			"subscription to event BondSeized had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		kbLogger.Errorf(
+			"subscription to event BondSeized failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (kb *KeepBonding) PastBondSeizedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) ([]*abi.KeepBondingBondSeized, error) {
+	iterator, err := kb.contract.FilterBondSeized(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondSeized events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.KeepBondingBondSeized, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (kb *KeepBonding) UnbondedValueDeposited(
+	opts *ethlike.SubscribeOpts,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) *KbUnbondedValueDepositedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &KbUnbondedValueDepositedSubscription{
+		kb,
+		opts,
+		operatorFilter,
+		beneficiaryFilter,
+	}
+}
+
+type KbUnbondedValueDepositedSubscription struct {
+	contract          *KeepBonding
+	opts              *ethlike.SubscribeOpts
+	operatorFilter    []common.Address
+	beneficiaryFilter []common.Address
+}
+
+type keepBondingUnbondedValueDepositedFunc func(
+	Operator common.Address,
+	Beneficiary common.Address,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (uvds *KbUnbondedValueDepositedSubscription) OnEvent(
+	handler keepBondingUnbondedValueDepositedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.KeepBondingUnbondedValueDeposited)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.Beneficiary,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := uvds.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (uvds *KbUnbondedValueDepositedSubscription) Pipe(
+	sink chan *abi.KeepBondingUnbondedValueDeposited,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(uvds.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := uvds.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					kbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - uvds.opts.PastBlocks
+
+				kbLogger.Infof(
+					"subscription monitoring fetching past UnbondedValueDeposited events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := uvds.contract.PastUnbondedValueDepositedEvents(
+					fromBlock,
+					nil,
+					uvds.operatorFilter,
+					uvds.beneficiaryFilter,
+				)
+				if err != nil {
+					kbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				kbLogger.Infof(
+					"subscription monitoring fetched [%v] past UnbondedValueDeposited events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := uvds.contract.watchUnbondedValueDeposited(
+		sink,
+		uvds.operatorFilter,
+		uvds.beneficiaryFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (kb *KeepBonding) watchUnbondedValueDeposited(
+	sink chan *abi.KeepBondingUnbondedValueDeposited,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return kb.contract.WatchUnbondedValueDeposited(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			beneficiaryFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		kbLogger.Errorf(
+			"subscription to event UnbondedValueDeposited had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		kbLogger.Errorf(
+			"subscription to event UnbondedValueDeposited failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (kb *KeepBonding) PastUnbondedValueDepositedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) ([]*abi.KeepBondingUnbondedValueDeposited, error) {
+	iterator, err := kb.contract.FilterUnbondedValueDeposited(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		beneficiaryFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past UnbondedValueDeposited events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.KeepBondingUnbondedValueDeposited, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (kb *KeepBonding) UnbondedValueWithdrawn(
+	opts *ethlike.SubscribeOpts,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) *KbUnbondedValueWithdrawnSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &KbUnbondedValueWithdrawnSubscription{
+		kb,
+		opts,
+		operatorFilter,
+		beneficiaryFilter,
+	}
+}
+
+type KbUnbondedValueWithdrawnSubscription struct {
+	contract          *KeepBonding
+	opts              *ethlike.SubscribeOpts
+	operatorFilter    []common.Address
+	beneficiaryFilter []common.Address
+}
+
+type keepBondingUnbondedValueWithdrawnFunc func(
+	Operator common.Address,
+	Beneficiary common.Address,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (uvws *KbUnbondedValueWithdrawnSubscription) OnEvent(
+	handler keepBondingUnbondedValueWithdrawnFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.KeepBondingUnbondedValueWithdrawn)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.Beneficiary,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := uvws.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (uvws *KbUnbondedValueWithdrawnSubscription) Pipe(
+	sink chan *abi.KeepBondingUnbondedValueWithdrawn,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(uvws.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := uvws.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					kbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - uvws.opts.PastBlocks
+
+				kbLogger.Infof(
+					"subscription monitoring fetching past UnbondedValueWithdrawn events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := uvws.contract.PastUnbondedValueWithdrawnEvents(
+					fromBlock,
+					nil,
+					uvws.operatorFilter,
+					uvws.beneficiaryFilter,
+				)
+				if err != nil {
+					kbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				kbLogger.Infof(
+					"subscription monitoring fetched [%v] past UnbondedValueWithdrawn events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := uvws.contract.watchUnbondedValueWithdrawn(
+		sink,
+		uvws.operatorFilter,
+		uvws.beneficiaryFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (kb *KeepBonding) watchUnbondedValueWithdrawn(
+	sink chan *abi.KeepBondingUnbondedValueWithdrawn,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return kb.contract.WatchUnbondedValueWithdrawn(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			beneficiaryFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		kbLogger.Errorf(
+			"subscription to event UnbondedValueWithdrawn had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		kbLogger.Errorf(
+			"subscription to event UnbondedValueWithdrawn failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (kb *KeepBonding) PastUnbondedValueWithdrawnEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) ([]*abi.KeepBondingUnbondedValueWithdrawn, error) {
+	iterator, err := kb.contract.FilterUnbondedValueWithdrawn(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		beneficiaryFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past UnbondedValueWithdrawn events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.KeepBondingUnbondedValueWithdrawn, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,1435 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// FullyBackedECDSAKeepFactoryABI is the input ABI used to generate the binding from.
+const FullyBackedECDSAKeepFactoryABI = "[{\"inputs\":[{\"name\":\"_masterKeepAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_sortitionPoolFactoryAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_bondingAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_randomBeaconAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"name\":\"keepAddress\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"members\",\"type\":\"address[]\",\"internalType\":\"address[]\",\"indexed\":false},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"application\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"honestThreshold\",\"type\":\"uint256\",\"internalType\":\"uint256\",\"indexed\":false}],\"name\":\"FullyBackedECDSAKeepCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"application\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true}],\"name\":\"OperatorBanned\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"name\":\"application\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":true},{\"name\":\"sortitionPool\",\"type\":\"address\",\"internalType\":\"address\",\"indexed\":false}],\"name\":\"SortitionPoolCreated\",\"type\":\"event\"},{\"payable\":true,\"stateMutability\":\"payable\",\"type\":\"fallback\"},{\"constant\":false,\"inputs\":[{\"name\":\"_relayEntry\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"name\":\"__beaconCallback\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_delegatedAuthorityRecipient\",\"type\":\"address\",\"internalType\":\"address\"}],\"name\":\"__isRecognized\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"banKeepMembers\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"bondWeightDivisor\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"callbackGas\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_application\",\"type\":\"address\",\"internalType\":\"address\"}],\"name\":\"createSortitionPool\",\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"defaultMinimumBond\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"index\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"name\":\"getKeepAtIndex\",\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getKeepCount\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_keep\",\"type\":\"address\",\"internalType\":\"address\"}],\"name\":\"getKeepOpenedTimestamp\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_application\",\"type\":\"address\",\"internalType\":\"address\"}],\"name\":\"getSortitionPool\",\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_application\",\"type\":\"address\",\"internalType\":\"address\"}],\"name\":\"getSortitionPoolWeight\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"groupSelectionSeed\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_operator\",\"type\":\"address\",\"internalType\":\"address\"}],\"name\":\"isOperatorAuthorized\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_application\",\"type\":\"address\",\"internalType\":\"address\"}],\"name\":\"isOperatorEligible\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_application\",\"type\":\"address\",\"internalType\":\"address\"}],\"name\":\"isOperatorRegistered\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_application\",\"type\":\"address\",\"internalType\":\"address\"}],\"name\":\"isOperatorUpToDate\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"name\":\"keeps\",\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"masterKeepAddress\",\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"newEntryFeeEstimate\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"newGroupSelectionSeedFee\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_groupSize\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_honestThreshold\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_bond\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_stakeLockDuration\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"name\":\"openKeep\",\"outputs\":[{\"name\":\"keepAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"payable\":true,\"stateMutability\":\"payable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"openKeepFeeEstimate\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_application\",\"type\":\"address\",\"internalType\":\"address\"}],\"name\":\"registerMemberCandidate\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"requestNewGroupSelectionSeed\",\"outputs\":[],\"payable\":true,\"stateMutability\":\"payable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"reseedPool\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_minimumBondableValue\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_groupSize\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"_honestThreshold\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"name\":\"setMinimumBondableValue\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_application\",\"type\":\"address\",\"internalType\":\"address\"}],\"name\":\"updateOperatorStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// FullyBackedECDSAKeepFactory is an auto generated Go binding around an Ethereum contract.
+type FullyBackedECDSAKeepFactory struct {
+	FullyBackedECDSAKeepFactoryCaller     // Read-only binding to the contract
+	FullyBackedECDSAKeepFactoryTransactor // Write-only binding to the contract
+	FullyBackedECDSAKeepFactoryFilterer   // Log filterer for contract events
+}
+
+// FullyBackedECDSAKeepFactoryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type FullyBackedECDSAKeepFactoryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FullyBackedECDSAKeepFactoryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type FullyBackedECDSAKeepFactoryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FullyBackedECDSAKeepFactoryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type FullyBackedECDSAKeepFactoryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FullyBackedECDSAKeepFactorySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type FullyBackedECDSAKeepFactorySession struct {
+	Contract     *FullyBackedECDSAKeepFactory // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                // Call options to use throughout this session
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// FullyBackedECDSAKeepFactoryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type FullyBackedECDSAKeepFactoryCallerSession struct {
+	Contract *FullyBackedECDSAKeepFactoryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                      // Call options to use throughout this session
+}
+
+// FullyBackedECDSAKeepFactoryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type FullyBackedECDSAKeepFactoryTransactorSession struct {
+	Contract     *FullyBackedECDSAKeepFactoryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                      // Transaction auth options to use throughout this session
+}
+
+// FullyBackedECDSAKeepFactoryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type FullyBackedECDSAKeepFactoryRaw struct {
+	Contract *FullyBackedECDSAKeepFactory // Generic contract binding to access the raw methods on
+}
+
+// FullyBackedECDSAKeepFactoryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type FullyBackedECDSAKeepFactoryCallerRaw struct {
+	Contract *FullyBackedECDSAKeepFactoryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// FullyBackedECDSAKeepFactoryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type FullyBackedECDSAKeepFactoryTransactorRaw struct {
+	Contract *FullyBackedECDSAKeepFactoryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewFullyBackedECDSAKeepFactory creates a new instance of FullyBackedECDSAKeepFactory, bound to a specific deployed contract.
+func NewFullyBackedECDSAKeepFactory(address common.Address, backend bind.ContractBackend) (*FullyBackedECDSAKeepFactory, error) {
+	contract, err := bindFullyBackedECDSAKeepFactory(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeepFactory{FullyBackedECDSAKeepFactoryCaller: FullyBackedECDSAKeepFactoryCaller{contract: contract}, FullyBackedECDSAKeepFactoryTransactor: FullyBackedECDSAKeepFactoryTransactor{contract: contract}, FullyBackedECDSAKeepFactoryFilterer: FullyBackedECDSAKeepFactoryFilterer{contract: contract}}, nil
+}
+
+// NewFullyBackedECDSAKeepFactoryCaller creates a new read-only instance of FullyBackedECDSAKeepFactory, bound to a specific deployed contract.
+func NewFullyBackedECDSAKeepFactoryCaller(address common.Address, caller bind.ContractCaller) (*FullyBackedECDSAKeepFactoryCaller, error) {
+	contract, err := bindFullyBackedECDSAKeepFactory(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeepFactoryCaller{contract: contract}, nil
+}
+
+// NewFullyBackedECDSAKeepFactoryTransactor creates a new write-only instance of FullyBackedECDSAKeepFactory, bound to a specific deployed contract.
+func NewFullyBackedECDSAKeepFactoryTransactor(address common.Address, transactor bind.ContractTransactor) (*FullyBackedECDSAKeepFactoryTransactor, error) {
+	contract, err := bindFullyBackedECDSAKeepFactory(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeepFactoryTransactor{contract: contract}, nil
+}
+
+// NewFullyBackedECDSAKeepFactoryFilterer creates a new log filterer instance of FullyBackedECDSAKeepFactory, bound to a specific deployed contract.
+func NewFullyBackedECDSAKeepFactoryFilterer(address common.Address, filterer bind.ContractFilterer) (*FullyBackedECDSAKeepFactoryFilterer, error) {
+	contract, err := bindFullyBackedECDSAKeepFactory(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeepFactoryFilterer{contract: contract}, nil
+}
+
+// bindFullyBackedECDSAKeepFactory binds a generic wrapper to an already deployed contract.
+func bindFullyBackedECDSAKeepFactory(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(FullyBackedECDSAKeepFactoryABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _FullyBackedECDSAKeepFactory.Contract.FullyBackedECDSAKeepFactoryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.FullyBackedECDSAKeepFactoryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.FullyBackedECDSAKeepFactoryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _FullyBackedECDSAKeepFactory.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.contract.Transact(opts, method, params...)
+}
+
+// BondWeightDivisor is a free data retrieval call binding the contract method 0x6b2b7ae2.
+//
+// Solidity: function bondWeightDivisor() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) BondWeightDivisor(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "bondWeightDivisor")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BondWeightDivisor is a free data retrieval call binding the contract method 0x6b2b7ae2.
+//
+// Solidity: function bondWeightDivisor() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) BondWeightDivisor() (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.BondWeightDivisor(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// BondWeightDivisor is a free data retrieval call binding the contract method 0x6b2b7ae2.
+//
+// Solidity: function bondWeightDivisor() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) BondWeightDivisor() (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.BondWeightDivisor(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// CallbackGas is a free data retrieval call binding the contract method 0xa10132ad.
+//
+// Solidity: function callbackGas() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) CallbackGas(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "callbackGas")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CallbackGas is a free data retrieval call binding the contract method 0xa10132ad.
+//
+// Solidity: function callbackGas() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) CallbackGas() (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.CallbackGas(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// CallbackGas is a free data retrieval call binding the contract method 0xa10132ad.
+//
+// Solidity: function callbackGas() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) CallbackGas() (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.CallbackGas(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// DefaultMinimumBond is a free data retrieval call binding the contract method 0xc534544a.
+//
+// Solidity: function defaultMinimumBond() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) DefaultMinimumBond(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "defaultMinimumBond")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DefaultMinimumBond is a free data retrieval call binding the contract method 0xc534544a.
+//
+// Solidity: function defaultMinimumBond() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) DefaultMinimumBond() (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.DefaultMinimumBond(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// DefaultMinimumBond is a free data retrieval call binding the contract method 0xc534544a.
+//
+// Solidity: function defaultMinimumBond() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) DefaultMinimumBond() (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.DefaultMinimumBond(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// GetKeepAtIndex is a free data retrieval call binding the contract method 0x202bb9f0.
+//
+// Solidity: function getKeepAtIndex(uint256 index) view returns(address)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) GetKeepAtIndex(opts *bind.CallOpts, index *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "getKeepAtIndex", index)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetKeepAtIndex is a free data retrieval call binding the contract method 0x202bb9f0.
+//
+// Solidity: function getKeepAtIndex(uint256 index) view returns(address)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) GetKeepAtIndex(index *big.Int) (common.Address, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.GetKeepAtIndex(&_FullyBackedECDSAKeepFactory.CallOpts, index)
+}
+
+// GetKeepAtIndex is a free data retrieval call binding the contract method 0x202bb9f0.
+//
+// Solidity: function getKeepAtIndex(uint256 index) view returns(address)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) GetKeepAtIndex(index *big.Int) (common.Address, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.GetKeepAtIndex(&_FullyBackedECDSAKeepFactory.CallOpts, index)
+}
+
+// GetKeepCount is a free data retrieval call binding the contract method 0xb2dc7593.
+//
+// Solidity: function getKeepCount() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) GetKeepCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "getKeepCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetKeepCount is a free data retrieval call binding the contract method 0xb2dc7593.
+//
+// Solidity: function getKeepCount() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) GetKeepCount() (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.GetKeepCount(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// GetKeepCount is a free data retrieval call binding the contract method 0xb2dc7593.
+//
+// Solidity: function getKeepCount() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) GetKeepCount() (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.GetKeepCount(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// GetKeepOpenedTimestamp is a free data retrieval call binding the contract method 0xd14fede1.
+//
+// Solidity: function getKeepOpenedTimestamp(address _keep) view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) GetKeepOpenedTimestamp(opts *bind.CallOpts, _keep common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "getKeepOpenedTimestamp", _keep)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetKeepOpenedTimestamp is a free data retrieval call binding the contract method 0xd14fede1.
+//
+// Solidity: function getKeepOpenedTimestamp(address _keep) view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) GetKeepOpenedTimestamp(_keep common.Address) (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.GetKeepOpenedTimestamp(&_FullyBackedECDSAKeepFactory.CallOpts, _keep)
+}
+
+// GetKeepOpenedTimestamp is a free data retrieval call binding the contract method 0xd14fede1.
+//
+// Solidity: function getKeepOpenedTimestamp(address _keep) view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) GetKeepOpenedTimestamp(_keep common.Address) (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.GetKeepOpenedTimestamp(&_FullyBackedECDSAKeepFactory.CallOpts, _keep)
+}
+
+// GetSortitionPool is a free data retrieval call binding the contract method 0x09211ceb.
+//
+// Solidity: function getSortitionPool(address _application) view returns(address)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) GetSortitionPool(opts *bind.CallOpts, _application common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "getSortitionPool", _application)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetSortitionPool is a free data retrieval call binding the contract method 0x09211ceb.
+//
+// Solidity: function getSortitionPool(address _application) view returns(address)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) GetSortitionPool(_application common.Address) (common.Address, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.GetSortitionPool(&_FullyBackedECDSAKeepFactory.CallOpts, _application)
+}
+
+// GetSortitionPool is a free data retrieval call binding the contract method 0x09211ceb.
+//
+// Solidity: function getSortitionPool(address _application) view returns(address)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) GetSortitionPool(_application common.Address) (common.Address, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.GetSortitionPool(&_FullyBackedECDSAKeepFactory.CallOpts, _application)
+}
+
+// GetSortitionPoolWeight is a free data retrieval call binding the contract method 0xebcbb39f.
+//
+// Solidity: function getSortitionPoolWeight(address _application) view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) GetSortitionPoolWeight(opts *bind.CallOpts, _application common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "getSortitionPoolWeight", _application)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetSortitionPoolWeight is a free data retrieval call binding the contract method 0xebcbb39f.
+//
+// Solidity: function getSortitionPoolWeight(address _application) view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) GetSortitionPoolWeight(_application common.Address) (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.GetSortitionPoolWeight(&_FullyBackedECDSAKeepFactory.CallOpts, _application)
+}
+
+// GetSortitionPoolWeight is a free data retrieval call binding the contract method 0xebcbb39f.
+//
+// Solidity: function getSortitionPoolWeight(address _application) view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) GetSortitionPoolWeight(_application common.Address) (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.GetSortitionPoolWeight(&_FullyBackedECDSAKeepFactory.CallOpts, _application)
+}
+
+// GroupSelectionSeed is a free data retrieval call binding the contract method 0x79a382e9.
+//
+// Solidity: function groupSelectionSeed() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) GroupSelectionSeed(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "groupSelectionSeed")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GroupSelectionSeed is a free data retrieval call binding the contract method 0x79a382e9.
+//
+// Solidity: function groupSelectionSeed() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) GroupSelectionSeed() (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.GroupSelectionSeed(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// GroupSelectionSeed is a free data retrieval call binding the contract method 0x79a382e9.
+//
+// Solidity: function groupSelectionSeed() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) GroupSelectionSeed() (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.GroupSelectionSeed(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// IsOperatorAuthorized is a free data retrieval call binding the contract method 0x36c2a000.
+//
+// Solidity: function isOperatorAuthorized(address _operator) view returns(bool)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) IsOperatorAuthorized(opts *bind.CallOpts, _operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "isOperatorAuthorized", _operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOperatorAuthorized is a free data retrieval call binding the contract method 0x36c2a000.
+//
+// Solidity: function isOperatorAuthorized(address _operator) view returns(bool)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) IsOperatorAuthorized(_operator common.Address) (bool, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.IsOperatorAuthorized(&_FullyBackedECDSAKeepFactory.CallOpts, _operator)
+}
+
+// IsOperatorAuthorized is a free data retrieval call binding the contract method 0x36c2a000.
+//
+// Solidity: function isOperatorAuthorized(address _operator) view returns(bool)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) IsOperatorAuthorized(_operator common.Address) (bool, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.IsOperatorAuthorized(&_FullyBackedECDSAKeepFactory.CallOpts, _operator)
+}
+
+// IsOperatorEligible is a free data retrieval call binding the contract method 0x5b0d5b8e.
+//
+// Solidity: function isOperatorEligible(address _operator, address _application) view returns(bool)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) IsOperatorEligible(opts *bind.CallOpts, _operator common.Address, _application common.Address) (bool, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "isOperatorEligible", _operator, _application)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOperatorEligible is a free data retrieval call binding the contract method 0x5b0d5b8e.
+//
+// Solidity: function isOperatorEligible(address _operator, address _application) view returns(bool)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) IsOperatorEligible(_operator common.Address, _application common.Address) (bool, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.IsOperatorEligible(&_FullyBackedECDSAKeepFactory.CallOpts, _operator, _application)
+}
+
+// IsOperatorEligible is a free data retrieval call binding the contract method 0x5b0d5b8e.
+//
+// Solidity: function isOperatorEligible(address _operator, address _application) view returns(bool)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) IsOperatorEligible(_operator common.Address, _application common.Address) (bool, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.IsOperatorEligible(&_FullyBackedECDSAKeepFactory.CallOpts, _operator, _application)
+}
+
+// IsOperatorRegistered is a free data retrieval call binding the contract method 0xb7a6f483.
+//
+// Solidity: function isOperatorRegistered(address _operator, address _application) view returns(bool)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) IsOperatorRegistered(opts *bind.CallOpts, _operator common.Address, _application common.Address) (bool, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "isOperatorRegistered", _operator, _application)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOperatorRegistered is a free data retrieval call binding the contract method 0xb7a6f483.
+//
+// Solidity: function isOperatorRegistered(address _operator, address _application) view returns(bool)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) IsOperatorRegistered(_operator common.Address, _application common.Address) (bool, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.IsOperatorRegistered(&_FullyBackedECDSAKeepFactory.CallOpts, _operator, _application)
+}
+
+// IsOperatorRegistered is a free data retrieval call binding the contract method 0xb7a6f483.
+//
+// Solidity: function isOperatorRegistered(address _operator, address _application) view returns(bool)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) IsOperatorRegistered(_operator common.Address, _application common.Address) (bool, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.IsOperatorRegistered(&_FullyBackedECDSAKeepFactory.CallOpts, _operator, _application)
+}
+
+// IsOperatorUpToDate is a free data retrieval call binding the contract method 0xa23af330.
+//
+// Solidity: function isOperatorUpToDate(address _operator, address _application) view returns(bool)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) IsOperatorUpToDate(opts *bind.CallOpts, _operator common.Address, _application common.Address) (bool, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "isOperatorUpToDate", _operator, _application)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOperatorUpToDate is a free data retrieval call binding the contract method 0xa23af330.
+//
+// Solidity: function isOperatorUpToDate(address _operator, address _application) view returns(bool)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) IsOperatorUpToDate(_operator common.Address, _application common.Address) (bool, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.IsOperatorUpToDate(&_FullyBackedECDSAKeepFactory.CallOpts, _operator, _application)
+}
+
+// IsOperatorUpToDate is a free data retrieval call binding the contract method 0xa23af330.
+//
+// Solidity: function isOperatorUpToDate(address _operator, address _application) view returns(bool)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) IsOperatorUpToDate(_operator common.Address, _application common.Address) (bool, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.IsOperatorUpToDate(&_FullyBackedECDSAKeepFactory.CallOpts, _operator, _application)
+}
+
+// Keeps is a free data retrieval call binding the contract method 0x8701af42.
+//
+// Solidity: function keeps(uint256 ) view returns(address)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) Keeps(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "keeps", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Keeps is a free data retrieval call binding the contract method 0x8701af42.
+//
+// Solidity: function keeps(uint256 ) view returns(address)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) Keeps(arg0 *big.Int) (common.Address, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.Keeps(&_FullyBackedECDSAKeepFactory.CallOpts, arg0)
+}
+
+// Keeps is a free data retrieval call binding the contract method 0x8701af42.
+//
+// Solidity: function keeps(uint256 ) view returns(address)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) Keeps(arg0 *big.Int) (common.Address, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.Keeps(&_FullyBackedECDSAKeepFactory.CallOpts, arg0)
+}
+
+// MasterKeepAddress is a free data retrieval call binding the contract method 0x5cef8417.
+//
+// Solidity: function masterKeepAddress() view returns(address)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) MasterKeepAddress(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "masterKeepAddress")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// MasterKeepAddress is a free data retrieval call binding the contract method 0x5cef8417.
+//
+// Solidity: function masterKeepAddress() view returns(address)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) MasterKeepAddress() (common.Address, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.MasterKeepAddress(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// MasterKeepAddress is a free data retrieval call binding the contract method 0x5cef8417.
+//
+// Solidity: function masterKeepAddress() view returns(address)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) MasterKeepAddress() (common.Address, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.MasterKeepAddress(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// NewEntryFeeEstimate is a free data retrieval call binding the contract method 0x51489985.
+//
+// Solidity: function newEntryFeeEstimate() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) NewEntryFeeEstimate(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "newEntryFeeEstimate")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NewEntryFeeEstimate is a free data retrieval call binding the contract method 0x51489985.
+//
+// Solidity: function newEntryFeeEstimate() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) NewEntryFeeEstimate() (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.NewEntryFeeEstimate(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// NewEntryFeeEstimate is a free data retrieval call binding the contract method 0x51489985.
+//
+// Solidity: function newEntryFeeEstimate() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) NewEntryFeeEstimate() (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.NewEntryFeeEstimate(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// NewGroupSelectionSeedFee is a free data retrieval call binding the contract method 0x38400400.
+//
+// Solidity: function newGroupSelectionSeedFee() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) NewGroupSelectionSeedFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "newGroupSelectionSeedFee")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NewGroupSelectionSeedFee is a free data retrieval call binding the contract method 0x38400400.
+//
+// Solidity: function newGroupSelectionSeedFee() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) NewGroupSelectionSeedFee() (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.NewGroupSelectionSeedFee(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// NewGroupSelectionSeedFee is a free data retrieval call binding the contract method 0x38400400.
+//
+// Solidity: function newGroupSelectionSeedFee() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) NewGroupSelectionSeedFee() (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.NewGroupSelectionSeedFee(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// OpenKeepFeeEstimate is a free data retrieval call binding the contract method 0xbc2c289f.
+//
+// Solidity: function openKeepFeeEstimate() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) OpenKeepFeeEstimate(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "openKeepFeeEstimate")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// OpenKeepFeeEstimate is a free data retrieval call binding the contract method 0xbc2c289f.
+//
+// Solidity: function openKeepFeeEstimate() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) OpenKeepFeeEstimate() (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.OpenKeepFeeEstimate(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// OpenKeepFeeEstimate is a free data retrieval call binding the contract method 0xbc2c289f.
+//
+// Solidity: function openKeepFeeEstimate() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) OpenKeepFeeEstimate() (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.OpenKeepFeeEstimate(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// ReseedPool is a free data retrieval call binding the contract method 0xa5b31055.
+//
+// Solidity: function reseedPool() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCaller) ReseedPool(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _FullyBackedECDSAKeepFactory.contract.Call(opts, &out, "reseedPool")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ReseedPool is a free data retrieval call binding the contract method 0xa5b31055.
+//
+// Solidity: function reseedPool() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) ReseedPool() (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.ReseedPool(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// ReseedPool is a free data retrieval call binding the contract method 0xa5b31055.
+//
+// Solidity: function reseedPool() view returns(uint256)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryCallerSession) ReseedPool() (*big.Int, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.ReseedPool(&_FullyBackedECDSAKeepFactory.CallOpts)
+}
+
+// BeaconCallback is a paid mutator transaction binding the contract method 0x356e0728.
+//
+// Solidity: function __beaconCallback(uint256 _relayEntry) returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactor) BeaconCallback(opts *bind.TransactOpts, _relayEntry *big.Int) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.contract.Transact(opts, "__beaconCallback", _relayEntry)
+}
+
+// BeaconCallback is a paid mutator transaction binding the contract method 0x356e0728.
+//
+// Solidity: function __beaconCallback(uint256 _relayEntry) returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) BeaconCallback(_relayEntry *big.Int) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.BeaconCallback(&_FullyBackedECDSAKeepFactory.TransactOpts, _relayEntry)
+}
+
+// BeaconCallback is a paid mutator transaction binding the contract method 0x356e0728.
+//
+// Solidity: function __beaconCallback(uint256 _relayEntry) returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactorSession) BeaconCallback(_relayEntry *big.Int) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.BeaconCallback(&_FullyBackedECDSAKeepFactory.TransactOpts, _relayEntry)
+}
+
+// IsRecognized is a paid mutator transaction binding the contract method 0xd870c034.
+//
+// Solidity: function __isRecognized(address _delegatedAuthorityRecipient) returns(bool)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactor) IsRecognized(opts *bind.TransactOpts, _delegatedAuthorityRecipient common.Address) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.contract.Transact(opts, "__isRecognized", _delegatedAuthorityRecipient)
+}
+
+// IsRecognized is a paid mutator transaction binding the contract method 0xd870c034.
+//
+// Solidity: function __isRecognized(address _delegatedAuthorityRecipient) returns(bool)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) IsRecognized(_delegatedAuthorityRecipient common.Address) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.IsRecognized(&_FullyBackedECDSAKeepFactory.TransactOpts, _delegatedAuthorityRecipient)
+}
+
+// IsRecognized is a paid mutator transaction binding the contract method 0xd870c034.
+//
+// Solidity: function __isRecognized(address _delegatedAuthorityRecipient) returns(bool)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactorSession) IsRecognized(_delegatedAuthorityRecipient common.Address) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.IsRecognized(&_FullyBackedECDSAKeepFactory.TransactOpts, _delegatedAuthorityRecipient)
+}
+
+// BanKeepMembers is a paid mutator transaction binding the contract method 0x567b5daa.
+//
+// Solidity: function banKeepMembers() returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactor) BanKeepMembers(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.contract.Transact(opts, "banKeepMembers")
+}
+
+// BanKeepMembers is a paid mutator transaction binding the contract method 0x567b5daa.
+//
+// Solidity: function banKeepMembers() returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) BanKeepMembers() (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.BanKeepMembers(&_FullyBackedECDSAKeepFactory.TransactOpts)
+}
+
+// BanKeepMembers is a paid mutator transaction binding the contract method 0x567b5daa.
+//
+// Solidity: function banKeepMembers() returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactorSession) BanKeepMembers() (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.BanKeepMembers(&_FullyBackedECDSAKeepFactory.TransactOpts)
+}
+
+// CreateSortitionPool is a paid mutator transaction binding the contract method 0xa3c28a13.
+//
+// Solidity: function createSortitionPool(address _application) returns(address)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactor) CreateSortitionPool(opts *bind.TransactOpts, _application common.Address) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.contract.Transact(opts, "createSortitionPool", _application)
+}
+
+// CreateSortitionPool is a paid mutator transaction binding the contract method 0xa3c28a13.
+//
+// Solidity: function createSortitionPool(address _application) returns(address)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) CreateSortitionPool(_application common.Address) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.CreateSortitionPool(&_FullyBackedECDSAKeepFactory.TransactOpts, _application)
+}
+
+// CreateSortitionPool is a paid mutator transaction binding the contract method 0xa3c28a13.
+//
+// Solidity: function createSortitionPool(address _application) returns(address)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactorSession) CreateSortitionPool(_application common.Address) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.CreateSortitionPool(&_FullyBackedECDSAKeepFactory.TransactOpts, _application)
+}
+
+// OpenKeep is a paid mutator transaction binding the contract method 0xca050cfd.
+//
+// Solidity: function openKeep(uint256 _groupSize, uint256 _honestThreshold, address _owner, uint256 _bond, uint256 _stakeLockDuration) payable returns(address keepAddress)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactor) OpenKeep(opts *bind.TransactOpts, _groupSize *big.Int, _honestThreshold *big.Int, _owner common.Address, _bond *big.Int, _stakeLockDuration *big.Int) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.contract.Transact(opts, "openKeep", _groupSize, _honestThreshold, _owner, _bond, _stakeLockDuration)
+}
+
+// OpenKeep is a paid mutator transaction binding the contract method 0xca050cfd.
+//
+// Solidity: function openKeep(uint256 _groupSize, uint256 _honestThreshold, address _owner, uint256 _bond, uint256 _stakeLockDuration) payable returns(address keepAddress)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) OpenKeep(_groupSize *big.Int, _honestThreshold *big.Int, _owner common.Address, _bond *big.Int, _stakeLockDuration *big.Int) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.OpenKeep(&_FullyBackedECDSAKeepFactory.TransactOpts, _groupSize, _honestThreshold, _owner, _bond, _stakeLockDuration)
+}
+
+// OpenKeep is a paid mutator transaction binding the contract method 0xca050cfd.
+//
+// Solidity: function openKeep(uint256 _groupSize, uint256 _honestThreshold, address _owner, uint256 _bond, uint256 _stakeLockDuration) payable returns(address keepAddress)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactorSession) OpenKeep(_groupSize *big.Int, _honestThreshold *big.Int, _owner common.Address, _bond *big.Int, _stakeLockDuration *big.Int) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.OpenKeep(&_FullyBackedECDSAKeepFactory.TransactOpts, _groupSize, _honestThreshold, _owner, _bond, _stakeLockDuration)
+}
+
+// RegisterMemberCandidate is a paid mutator transaction binding the contract method 0x92ae584a.
+//
+// Solidity: function registerMemberCandidate(address _application) returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactor) RegisterMemberCandidate(opts *bind.TransactOpts, _application common.Address) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.contract.Transact(opts, "registerMemberCandidate", _application)
+}
+
+// RegisterMemberCandidate is a paid mutator transaction binding the contract method 0x92ae584a.
+//
+// Solidity: function registerMemberCandidate(address _application) returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) RegisterMemberCandidate(_application common.Address) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.RegisterMemberCandidate(&_FullyBackedECDSAKeepFactory.TransactOpts, _application)
+}
+
+// RegisterMemberCandidate is a paid mutator transaction binding the contract method 0x92ae584a.
+//
+// Solidity: function registerMemberCandidate(address _application) returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactorSession) RegisterMemberCandidate(_application common.Address) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.RegisterMemberCandidate(&_FullyBackedECDSAKeepFactory.TransactOpts, _application)
+}
+
+// RequestNewGroupSelectionSeed is a paid mutator transaction binding the contract method 0x21f74e56.
+//
+// Solidity: function requestNewGroupSelectionSeed() payable returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactor) RequestNewGroupSelectionSeed(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.contract.Transact(opts, "requestNewGroupSelectionSeed")
+}
+
+// RequestNewGroupSelectionSeed is a paid mutator transaction binding the contract method 0x21f74e56.
+//
+// Solidity: function requestNewGroupSelectionSeed() payable returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) RequestNewGroupSelectionSeed() (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.RequestNewGroupSelectionSeed(&_FullyBackedECDSAKeepFactory.TransactOpts)
+}
+
+// RequestNewGroupSelectionSeed is a paid mutator transaction binding the contract method 0x21f74e56.
+//
+// Solidity: function requestNewGroupSelectionSeed() payable returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactorSession) RequestNewGroupSelectionSeed() (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.RequestNewGroupSelectionSeed(&_FullyBackedECDSAKeepFactory.TransactOpts)
+}
+
+// SetMinimumBondableValue is a paid mutator transaction binding the contract method 0xb8ee9156.
+//
+// Solidity: function setMinimumBondableValue(uint256 _minimumBondableValue, uint256 _groupSize, uint256 _honestThreshold) returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactor) SetMinimumBondableValue(opts *bind.TransactOpts, _minimumBondableValue *big.Int, _groupSize *big.Int, _honestThreshold *big.Int) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.contract.Transact(opts, "setMinimumBondableValue", _minimumBondableValue, _groupSize, _honestThreshold)
+}
+
+// SetMinimumBondableValue is a paid mutator transaction binding the contract method 0xb8ee9156.
+//
+// Solidity: function setMinimumBondableValue(uint256 _minimumBondableValue, uint256 _groupSize, uint256 _honestThreshold) returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) SetMinimumBondableValue(_minimumBondableValue *big.Int, _groupSize *big.Int, _honestThreshold *big.Int) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.SetMinimumBondableValue(&_FullyBackedECDSAKeepFactory.TransactOpts, _minimumBondableValue, _groupSize, _honestThreshold)
+}
+
+// SetMinimumBondableValue is a paid mutator transaction binding the contract method 0xb8ee9156.
+//
+// Solidity: function setMinimumBondableValue(uint256 _minimumBondableValue, uint256 _groupSize, uint256 _honestThreshold) returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactorSession) SetMinimumBondableValue(_minimumBondableValue *big.Int, _groupSize *big.Int, _honestThreshold *big.Int) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.SetMinimumBondableValue(&_FullyBackedECDSAKeepFactory.TransactOpts, _minimumBondableValue, _groupSize, _honestThreshold)
+}
+
+// UpdateOperatorStatus is a paid mutator transaction binding the contract method 0xeca4a80d.
+//
+// Solidity: function updateOperatorStatus(address _operator, address _application) returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactor) UpdateOperatorStatus(opts *bind.TransactOpts, _operator common.Address, _application common.Address) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.contract.Transact(opts, "updateOperatorStatus", _operator, _application)
+}
+
+// UpdateOperatorStatus is a paid mutator transaction binding the contract method 0xeca4a80d.
+//
+// Solidity: function updateOperatorStatus(address _operator, address _application) returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) UpdateOperatorStatus(_operator common.Address, _application common.Address) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.UpdateOperatorStatus(&_FullyBackedECDSAKeepFactory.TransactOpts, _operator, _application)
+}
+
+// UpdateOperatorStatus is a paid mutator transaction binding the contract method 0xeca4a80d.
+//
+// Solidity: function updateOperatorStatus(address _operator, address _application) returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactorSession) UpdateOperatorStatus(_operator common.Address, _application common.Address) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.UpdateOperatorStatus(&_FullyBackedECDSAKeepFactory.TransactOpts, _operator, _application)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactor) Fallback(opts *bind.TransactOpts, calldata []byte) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.contract.RawTransact(opts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactorySession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.Fallback(&_FullyBackedECDSAKeepFactory.TransactOpts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryTransactorSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _FullyBackedECDSAKeepFactory.Contract.Fallback(&_FullyBackedECDSAKeepFactory.TransactOpts, calldata)
+}
+
+// FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreatedIterator is returned from FilterFullyBackedECDSAKeepCreated and is used to iterate over the raw logs and unpacked data for FullyBackedECDSAKeepCreated events raised by the FullyBackedECDSAKeepFactory contract.
+type FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreatedIterator struct {
+	Event *FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreated represents a FullyBackedECDSAKeepCreated event raised by the FullyBackedECDSAKeepFactory contract.
+type FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreated struct {
+	KeepAddress     common.Address
+	Members         []common.Address
+	Owner           common.Address
+	Application     common.Address
+	HonestThreshold *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterFullyBackedECDSAKeepCreated is a free log retrieval operation binding the contract event 0x41fba7b79d7eb1f0e327059c2cee8c915ec77ce5ee9ab36dec965d8181654917.
+//
+// Solidity: event FullyBackedECDSAKeepCreated(address indexed keepAddress, address[] members, address indexed owner, address indexed application, uint256 honestThreshold)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryFilterer) FilterFullyBackedECDSAKeepCreated(opts *bind.FilterOpts, keepAddress []common.Address, owner []common.Address, application []common.Address) (*FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreatedIterator, error) {
+
+	var keepAddressRule []interface{}
+	for _, keepAddressItem := range keepAddress {
+		keepAddressRule = append(keepAddressRule, keepAddressItem)
+	}
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var applicationRule []interface{}
+	for _, applicationItem := range application {
+		applicationRule = append(applicationRule, applicationItem)
+	}
+
+	logs, sub, err := _FullyBackedECDSAKeepFactory.contract.FilterLogs(opts, "FullyBackedECDSAKeepCreated", keepAddressRule, ownerRule, applicationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreatedIterator{contract: _FullyBackedECDSAKeepFactory.contract, event: "FullyBackedECDSAKeepCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchFullyBackedECDSAKeepCreated is a free log subscription operation binding the contract event 0x41fba7b79d7eb1f0e327059c2cee8c915ec77ce5ee9ab36dec965d8181654917.
+//
+// Solidity: event FullyBackedECDSAKeepCreated(address indexed keepAddress, address[] members, address indexed owner, address indexed application, uint256 honestThreshold)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryFilterer) WatchFullyBackedECDSAKeepCreated(opts *bind.WatchOpts, sink chan<- *FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreated, keepAddress []common.Address, owner []common.Address, application []common.Address) (event.Subscription, error) {
+
+	var keepAddressRule []interface{}
+	for _, keepAddressItem := range keepAddress {
+		keepAddressRule = append(keepAddressRule, keepAddressItem)
+	}
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var applicationRule []interface{}
+	for _, applicationItem := range application {
+		applicationRule = append(applicationRule, applicationItem)
+	}
+
+	logs, sub, err := _FullyBackedECDSAKeepFactory.contract.WatchLogs(opts, "FullyBackedECDSAKeepCreated", keepAddressRule, ownerRule, applicationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreated)
+				if err := _FullyBackedECDSAKeepFactory.contract.UnpackLog(event, "FullyBackedECDSAKeepCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFullyBackedECDSAKeepCreated is a log parse operation binding the contract event 0x41fba7b79d7eb1f0e327059c2cee8c915ec77ce5ee9ab36dec965d8181654917.
+//
+// Solidity: event FullyBackedECDSAKeepCreated(address indexed keepAddress, address[] members, address indexed owner, address indexed application, uint256 honestThreshold)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryFilterer) ParseFullyBackedECDSAKeepCreated(log types.Log) (*FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreated, error) {
+	event := new(FullyBackedECDSAKeepFactoryFullyBackedECDSAKeepCreated)
+	if err := _FullyBackedECDSAKeepFactory.contract.UnpackLog(event, "FullyBackedECDSAKeepCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// FullyBackedECDSAKeepFactoryOperatorBannedIterator is returned from FilterOperatorBanned and is used to iterate over the raw logs and unpacked data for OperatorBanned events raised by the FullyBackedECDSAKeepFactory contract.
+type FullyBackedECDSAKeepFactoryOperatorBannedIterator struct {
+	Event *FullyBackedECDSAKeepFactoryOperatorBanned // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedECDSAKeepFactoryOperatorBannedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedECDSAKeepFactoryOperatorBanned)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedECDSAKeepFactoryOperatorBanned)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedECDSAKeepFactoryOperatorBannedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedECDSAKeepFactoryOperatorBannedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedECDSAKeepFactoryOperatorBanned represents a OperatorBanned event raised by the FullyBackedECDSAKeepFactory contract.
+type FullyBackedECDSAKeepFactoryOperatorBanned struct {
+	Operator    common.Address
+	Application common.Address
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterOperatorBanned is a free log retrieval operation binding the contract event 0x8303b66802d9f0f82b0299303b3f20d7f55815385a912b1b7fc79224e59fa12b.
+//
+// Solidity: event OperatorBanned(address indexed operator, address indexed application)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryFilterer) FilterOperatorBanned(opts *bind.FilterOpts, operator []common.Address, application []common.Address) (*FullyBackedECDSAKeepFactoryOperatorBannedIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var applicationRule []interface{}
+	for _, applicationItem := range application {
+		applicationRule = append(applicationRule, applicationItem)
+	}
+
+	logs, sub, err := _FullyBackedECDSAKeepFactory.contract.FilterLogs(opts, "OperatorBanned", operatorRule, applicationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeepFactoryOperatorBannedIterator{contract: _FullyBackedECDSAKeepFactory.contract, event: "OperatorBanned", logs: logs, sub: sub}, nil
+}
+
+// WatchOperatorBanned is a free log subscription operation binding the contract event 0x8303b66802d9f0f82b0299303b3f20d7f55815385a912b1b7fc79224e59fa12b.
+//
+// Solidity: event OperatorBanned(address indexed operator, address indexed application)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryFilterer) WatchOperatorBanned(opts *bind.WatchOpts, sink chan<- *FullyBackedECDSAKeepFactoryOperatorBanned, operator []common.Address, application []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var applicationRule []interface{}
+	for _, applicationItem := range application {
+		applicationRule = append(applicationRule, applicationItem)
+	}
+
+	logs, sub, err := _FullyBackedECDSAKeepFactory.contract.WatchLogs(opts, "OperatorBanned", operatorRule, applicationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedECDSAKeepFactoryOperatorBanned)
+				if err := _FullyBackedECDSAKeepFactory.contract.UnpackLog(event, "OperatorBanned", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOperatorBanned is a log parse operation binding the contract event 0x8303b66802d9f0f82b0299303b3f20d7f55815385a912b1b7fc79224e59fa12b.
+//
+// Solidity: event OperatorBanned(address indexed operator, address indexed application)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryFilterer) ParseOperatorBanned(log types.Log) (*FullyBackedECDSAKeepFactoryOperatorBanned, error) {
+	event := new(FullyBackedECDSAKeepFactoryOperatorBanned)
+	if err := _FullyBackedECDSAKeepFactory.contract.UnpackLog(event, "OperatorBanned", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// FullyBackedECDSAKeepFactorySortitionPoolCreatedIterator is returned from FilterSortitionPoolCreated and is used to iterate over the raw logs and unpacked data for SortitionPoolCreated events raised by the FullyBackedECDSAKeepFactory contract.
+type FullyBackedECDSAKeepFactorySortitionPoolCreatedIterator struct {
+	Event *FullyBackedECDSAKeepFactorySortitionPoolCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FullyBackedECDSAKeepFactorySortitionPoolCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FullyBackedECDSAKeepFactorySortitionPoolCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FullyBackedECDSAKeepFactorySortitionPoolCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FullyBackedECDSAKeepFactorySortitionPoolCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FullyBackedECDSAKeepFactorySortitionPoolCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FullyBackedECDSAKeepFactorySortitionPoolCreated represents a SortitionPoolCreated event raised by the FullyBackedECDSAKeepFactory contract.
+type FullyBackedECDSAKeepFactorySortitionPoolCreated struct {
+	Application   common.Address
+	SortitionPool common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterSortitionPoolCreated is a free log retrieval operation binding the contract event 0x693c68cd964926544cbaee02b00d9352aa98c6de2484515e8bd74130c005e6af.
+//
+// Solidity: event SortitionPoolCreated(address indexed application, address sortitionPool)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryFilterer) FilterSortitionPoolCreated(opts *bind.FilterOpts, application []common.Address) (*FullyBackedECDSAKeepFactorySortitionPoolCreatedIterator, error) {
+
+	var applicationRule []interface{}
+	for _, applicationItem := range application {
+		applicationRule = append(applicationRule, applicationItem)
+	}
+
+	logs, sub, err := _FullyBackedECDSAKeepFactory.contract.FilterLogs(opts, "SortitionPoolCreated", applicationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &FullyBackedECDSAKeepFactorySortitionPoolCreatedIterator{contract: _FullyBackedECDSAKeepFactory.contract, event: "SortitionPoolCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchSortitionPoolCreated is a free log subscription operation binding the contract event 0x693c68cd964926544cbaee02b00d9352aa98c6de2484515e8bd74130c005e6af.
+//
+// Solidity: event SortitionPoolCreated(address indexed application, address sortitionPool)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryFilterer) WatchSortitionPoolCreated(opts *bind.WatchOpts, sink chan<- *FullyBackedECDSAKeepFactorySortitionPoolCreated, application []common.Address) (event.Subscription, error) {
+
+	var applicationRule []interface{}
+	for _, applicationItem := range application {
+		applicationRule = append(applicationRule, applicationItem)
+	}
+
+	logs, sub, err := _FullyBackedECDSAKeepFactory.contract.WatchLogs(opts, "SortitionPoolCreated", applicationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FullyBackedECDSAKeepFactorySortitionPoolCreated)
+				if err := _FullyBackedECDSAKeepFactory.contract.UnpackLog(event, "SortitionPoolCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSortitionPoolCreated is a log parse operation binding the contract event 0x693c68cd964926544cbaee02b00d9352aa98c6de2484515e8bd74130c005e6af.
+//
+// Solidity: event SortitionPoolCreated(address indexed application, address sortitionPool)
+func (_FullyBackedECDSAKeepFactory *FullyBackedECDSAKeepFactoryFilterer) ParseSortitionPoolCreated(log types.Log) (*FullyBackedECDSAKeepFactorySortitionPoolCreated, error) {
+	event := new(FullyBackedECDSAKeepFactorySortitionPoolCreated)
+	if err := _FullyBackedECDSAKeepFactory.contract.UnpackLog(event, "SortitionPoolCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
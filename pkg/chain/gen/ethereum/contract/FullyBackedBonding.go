@@ -0,0 +1,4501 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contract
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	hostchainabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/ipfs/go-log"
+
+	chainutil "github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+	"github.com/keep-network/keep-common/pkg/chain/ethlike"
+	"github.com/keep-network/keep-common/pkg/subscription"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/gen/ethereum/abi"
+)
+
+// Create a package-level logger for this contract. The logger exists at
+// package level so that the logger is registered at startup and can be
+// included or excluded from logging at startup by name.
+var fbbLogger = log.Logger("keep-contract-FullyBackedBonding")
+
+type FullyBackedBonding struct {
+	contract          *abi.FullyBackedBonding
+	contractAddress   common.Address
+	contractABI       *hostchainabi.ABI
+	caller            bind.ContractCaller
+	transactor        bind.ContractTransactor
+	callerOptions     *bind.CallOpts
+	transactorOptions *bind.TransactOpts
+	errorResolver     *chainutil.ErrorResolver
+	nonceManager      *ethlike.NonceManager
+	miningWaiter      *chainutil.MiningWaiter
+	blockCounter      *ethlike.BlockCounter
+
+	transactionMutex *sync.Mutex
+}
+
+func NewFullyBackedBonding(
+	contractAddress common.Address,
+	chainId *big.Int,
+	accountKey *keystore.Key,
+	backend bind.ContractBackend,
+	nonceManager *ethlike.NonceManager,
+	miningWaiter *chainutil.MiningWaiter,
+	blockCounter *ethlike.BlockCounter,
+	transactionMutex *sync.Mutex,
+) (*FullyBackedBonding, error) {
+	callerOptions := &bind.CallOpts{
+		From: accountKey.Address,
+	}
+
+	// FIXME Switch to bind.NewKeyedTransactorWithChainID when
+	// FIXME celo-org/celo-blockchain merges in changes from upstream
+	// FIXME ethereum/go-ethereum beyond v1.9.25.
+	transactorOptions, err := chainutil.NewKeyedTransactorWithChainID(
+		accountKey.PrivateKey,
+		chainId,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate transactor: [%v]", err)
+	}
+
+	contract, err := abi.NewFullyBackedBonding(
+		contractAddress,
+		backend,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to instantiate contract at address: %s [%v]",
+			contractAddress.String(),
+			err,
+		)
+	}
+
+	contractABI, err := hostchainabi.JSON(strings.NewReader(abi.FullyBackedBondingABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate ABI: [%v]", err)
+	}
+
+	return &FullyBackedBonding{
+		contract:          contract,
+		contractAddress:   contractAddress,
+		contractABI:       &contractABI,
+		caller:            backend,
+		transactor:        backend,
+		callerOptions:     callerOptions,
+		transactorOptions: transactorOptions,
+		errorResolver:     chainutil.NewErrorResolver(backend, &contractABI, &contractAddress),
+		nonceManager:      nonceManager,
+		miningWaiter:      miningWaiter,
+		blockCounter:      blockCounter,
+		transactionMutex:  transactionMutex,
+	}, nil
+}
+
+// ----- Non-const Methods ------
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) AuthorizeOperatorContract(
+	_operator common.Address,
+	_operatorContract common.Address,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction authorizeOperatorContract",
+		" params: ",
+		fmt.Sprint(
+			_operator,
+			_operatorContract,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.AuthorizeOperatorContract(
+		transactorOptions,
+		_operator,
+		_operatorContract,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"authorizeOperatorContract",
+			_operator,
+			_operatorContract,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction authorizeOperatorContract with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbb.contract.AuthorizeOperatorContract(
+				newTransactorOptions,
+				_operator,
+				_operatorContract,
+			)
+			if err != nil {
+				return nil, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"authorizeOperatorContract",
+					_operator,
+					_operatorContract,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction authorizeOperatorContract with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallAuthorizeOperatorContract(
+	_operator common.Address,
+	_operatorContract common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"authorizeOperatorContract",
+		&result,
+		_operator,
+		_operatorContract,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) AuthorizeOperatorContractGasEstimate(
+	_operator common.Address,
+	_operatorContract common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"authorizeOperatorContract",
+		fbb.contractABI,
+		fbb.transactor,
+		_operator,
+		_operatorContract,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) AuthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction authorizeSortitionPoolContract",
+		" params: ",
+		fmt.Sprint(
+			_operator,
+			_poolAddress,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.AuthorizeSortitionPoolContract(
+		transactorOptions,
+		_operator,
+		_poolAddress,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"authorizeSortitionPoolContract",
+			_operator,
+			_poolAddress,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction authorizeSortitionPoolContract with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbb.contract.AuthorizeSortitionPoolContract(
+				newTransactorOptions,
+				_operator,
+				_poolAddress,
+			)
+			if err != nil {
+				return nil, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"authorizeSortitionPoolContract",
+					_operator,
+					_poolAddress,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction authorizeSortitionPoolContract with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallAuthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"authorizeSortitionPoolContract",
+		&result,
+		_operator,
+		_poolAddress,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) AuthorizeSortitionPoolContractGasEstimate(
+	_operator common.Address,
+	_poolAddress common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"authorizeSortitionPoolContract",
+		fbb.contractABI,
+		fbb.transactor,
+		_operator,
+		_poolAddress,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) ClaimDelegatedAuthority(
+	delegatedAuthoritySource common.Address,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction claimDelegatedAuthority",
+		" params: ",
+		fmt.Sprint(
+			delegatedAuthoritySource,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.ClaimDelegatedAuthority(
+		transactorOptions,
+		delegatedAuthoritySource,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"claimDelegatedAuthority",
+			delegatedAuthoritySource,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction claimDelegatedAuthority with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbb.contract.ClaimDelegatedAuthority(
+				newTransactorOptions,
+				delegatedAuthoritySource,
+			)
+			if err != nil {
+				return nil, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"claimDelegatedAuthority",
+					delegatedAuthoritySource,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction claimDelegatedAuthority with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallClaimDelegatedAuthority(
+	delegatedAuthoritySource common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"claimDelegatedAuthority",
+		&result,
+		delegatedAuthoritySource,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) ClaimDelegatedAuthorityGasEstimate(
+	delegatedAuthoritySource common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"claimDelegatedAuthority",
+		fbb.contractABI,
+		fbb.transactor,
+		delegatedAuthoritySource,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) CreateBond(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	authorizedSortitionPool common.Address,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction createBond",
+		" params: ",
+		fmt.Sprint(
+			operator,
+			holder,
+			referenceID,
+			amount,
+			authorizedSortitionPool,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.CreateBond(
+		transactorOptions,
+		operator,
+		holder,
+		referenceID,
+		amount,
+		authorizedSortitionPool,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"createBond",
+			operator,
+			holder,
+			referenceID,
+			amount,
+			authorizedSortitionPool,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction createBond with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbb.contract.CreateBond(
+				newTransactorOptions,
+				operator,
+				holder,
+				referenceID,
+				amount,
+				authorizedSortitionPool,
+			)
+			if err != nil {
+				return nil, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"createBond",
+					operator,
+					holder,
+					referenceID,
+					amount,
+					authorizedSortitionPool,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction createBond with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallCreateBond(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	authorizedSortitionPool common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"createBond",
+		&result,
+		operator,
+		holder,
+		referenceID,
+		amount,
+		authorizedSortitionPool,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) CreateBondGasEstimate(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	authorizedSortitionPool common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"createBond",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+		holder,
+		referenceID,
+		amount,
+		authorizedSortitionPool,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) DeauthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction deauthorizeSortitionPoolContract",
+		" params: ",
+		fmt.Sprint(
+			_operator,
+			_poolAddress,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.DeauthorizeSortitionPoolContract(
+		transactorOptions,
+		_operator,
+		_poolAddress,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"deauthorizeSortitionPoolContract",
+			_operator,
+			_poolAddress,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction deauthorizeSortitionPoolContract with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbb.contract.DeauthorizeSortitionPoolContract(
+				newTransactorOptions,
+				_operator,
+				_poolAddress,
+			)
+			if err != nil {
+				return nil, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"deauthorizeSortitionPoolContract",
+					_operator,
+					_poolAddress,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction deauthorizeSortitionPoolContract with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallDeauthorizeSortitionPoolContract(
+	_operator common.Address,
+	_poolAddress common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"deauthorizeSortitionPoolContract",
+		&result,
+		_operator,
+		_poolAddress,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) DeauthorizeSortitionPoolContractGasEstimate(
+	_operator common.Address,
+	_poolAddress common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"deauthorizeSortitionPoolContract",
+		fbb.contractABI,
+		fbb.transactor,
+		_operator,
+		_poolAddress,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) Delegate(
+	operator common.Address,
+	beneficiary common.Address,
+	authorizer common.Address,
+	value *big.Int,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction delegate",
+		" params: ",
+		fmt.Sprint(
+			operator,
+			beneficiary,
+			authorizer,
+		),
+		" value: ", value,
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	transactorOptions.Value = value
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.Delegate(
+		transactorOptions,
+		operator,
+		beneficiary,
+		authorizer,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			value,
+			"delegate",
+			operator,
+			beneficiary,
+			authorizer,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction delegate with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbb.contract.Delegate(
+				newTransactorOptions,
+				operator,
+				beneficiary,
+				authorizer,
+			)
+			if err != nil {
+				return nil, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					value,
+					"delegate",
+					operator,
+					beneficiary,
+					authorizer,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction delegate with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallDelegate(
+	operator common.Address,
+	beneficiary common.Address,
+	authorizer common.Address,
+	value *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, value,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"delegate",
+		&result,
+		operator,
+		beneficiary,
+		authorizer,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) DelegateGasEstimate(
+	operator common.Address,
+	beneficiary common.Address,
+	authorizer common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"delegate",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+		beneficiary,
+		authorizer,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) Deposit(
+	operator common.Address,
+	value *big.Int,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction deposit",
+		" params: ",
+		fmt.Sprint(
+			operator,
+		),
+		" value: ", value,
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	transactorOptions.Value = value
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.Deposit(
+		transactorOptions,
+		operator,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			value,
+			"deposit",
+			operator,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction deposit with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbb.contract.Deposit(
+				newTransactorOptions,
+				operator,
+			)
+			if err != nil {
+				return nil, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					value,
+					"deposit",
+					operator,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction deposit with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallDeposit(
+	operator common.Address,
+	value *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, value,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"deposit",
+		&result,
+		operator,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) DepositGasEstimate(
+	operator common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"deposit",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) FreeBond(
+	operator common.Address,
+	referenceID *big.Int,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction freeBond",
+		" params: ",
+		fmt.Sprint(
+			operator,
+			referenceID,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.FreeBond(
+		transactorOptions,
+		operator,
+		referenceID,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"freeBond",
+			operator,
+			referenceID,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction freeBond with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbb.contract.FreeBond(
+				newTransactorOptions,
+				operator,
+				referenceID,
+			)
+			if err != nil {
+				return nil, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"freeBond",
+					operator,
+					referenceID,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction freeBond with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallFreeBond(
+	operator common.Address,
+	referenceID *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"freeBond",
+		&result,
+		operator,
+		referenceID,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) FreeBondGasEstimate(
+	operator common.Address,
+	referenceID *big.Int,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"freeBond",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+		referenceID,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) ReassignBond(
+	operator common.Address,
+	referenceID *big.Int,
+	newHolder common.Address,
+	newReferenceID *big.Int,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction reassignBond",
+		" params: ",
+		fmt.Sprint(
+			operator,
+			referenceID,
+			newHolder,
+			newReferenceID,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.ReassignBond(
+		transactorOptions,
+		operator,
+		referenceID,
+		newHolder,
+		newReferenceID,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"reassignBond",
+			operator,
+			referenceID,
+			newHolder,
+			newReferenceID,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction reassignBond with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbb.contract.ReassignBond(
+				newTransactorOptions,
+				operator,
+				referenceID,
+				newHolder,
+				newReferenceID,
+			)
+			if err != nil {
+				return nil, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"reassignBond",
+					operator,
+					referenceID,
+					newHolder,
+					newReferenceID,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction reassignBond with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallReassignBond(
+	operator common.Address,
+	referenceID *big.Int,
+	newHolder common.Address,
+	newReferenceID *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"reassignBond",
+		&result,
+		operator,
+		referenceID,
+		newHolder,
+		newReferenceID,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) ReassignBondGasEstimate(
+	operator common.Address,
+	referenceID *big.Int,
+	newHolder common.Address,
+	newReferenceID *big.Int,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"reassignBond",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+		referenceID,
+		newHolder,
+		newReferenceID,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) SeizeBond(
+	operator common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	destination common.Address,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction seizeBond",
+		" params: ",
+		fmt.Sprint(
+			operator,
+			referenceID,
+			amount,
+			destination,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.SeizeBond(
+		transactorOptions,
+		operator,
+		referenceID,
+		amount,
+		destination,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"seizeBond",
+			operator,
+			referenceID,
+			amount,
+			destination,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction seizeBond with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbb.contract.SeizeBond(
+				newTransactorOptions,
+				operator,
+				referenceID,
+				amount,
+				destination,
+			)
+			if err != nil {
+				return nil, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"seizeBond",
+					operator,
+					referenceID,
+					amount,
+					destination,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction seizeBond with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallSeizeBond(
+	operator common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	destination common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"seizeBond",
+		&result,
+		operator,
+		referenceID,
+		amount,
+		destination,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) SeizeBondGasEstimate(
+	operator common.Address,
+	referenceID *big.Int,
+	amount *big.Int,
+	destination common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"seizeBond",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+		referenceID,
+		amount,
+		destination,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) TopUp(
+	operator common.Address,
+	value *big.Int,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction topUp",
+		" params: ",
+		fmt.Sprint(
+			operator,
+		),
+		" value: ", value,
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	transactorOptions.Value = value
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.TopUp(
+		transactorOptions,
+		operator,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			value,
+			"topUp",
+			operator,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction topUp with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbb.contract.TopUp(
+				newTransactorOptions,
+				operator,
+			)
+			if err != nil {
+				return nil, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					value,
+					"topUp",
+					operator,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction topUp with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallTopUp(
+	operator common.Address,
+	value *big.Int,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, value,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"topUp",
+		&result,
+		operator,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) TopUpGasEstimate(
+	operator common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"topUp",
+		fbb.contractABI,
+		fbb.transactor,
+		operator,
+	)
+
+	return result, err
+}
+
+// Transaction submission.
+func (fbb *FullyBackedBonding) Withdraw(
+	amount *big.Int,
+	operator common.Address,
+
+	transactionOptions ...chainutil.TransactionOptions,
+) (*types.Transaction, error) {
+	fbbLogger.Debug(
+		"submitting transaction withdraw",
+		" params: ",
+		fmt.Sprint(
+			amount,
+			operator,
+		),
+	)
+
+	fbb.transactionMutex.Lock()
+	defer fbb.transactionMutex.Unlock()
+
+	// create a copy
+	transactorOptions := new(bind.TransactOpts)
+	*transactorOptions = *fbb.transactorOptions
+
+	if len(transactionOptions) > 1 {
+		return nil, fmt.Errorf(
+			"could not process multiple transaction options sets",
+		)
+	} else if len(transactionOptions) > 0 {
+		transactionOptions[0].Apply(transactorOptions)
+	}
+
+	nonce, err := fbb.nonceManager.CurrentNonce()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve account nonce: %v", err)
+	}
+
+	transactorOptions.Nonce = new(big.Int).SetUint64(nonce)
+
+	transaction, err := fbb.contract.Withdraw(
+		transactorOptions,
+		amount,
+		operator,
+	)
+	if err != nil {
+		return transaction, fbb.errorResolver.ResolveError(
+			err,
+			fbb.transactorOptions.From,
+			nil,
+			"withdraw",
+			amount,
+			operator,
+		)
+	}
+
+	fbbLogger.Infof(
+		"submitted transaction withdraw with id: [%s] and nonce [%v]",
+		transaction.Hash(),
+		transaction.Nonce(),
+	)
+
+	go fbb.miningWaiter.ForceMining(
+		transaction,
+		transactorOptions,
+		func(newTransactorOptions *bind.TransactOpts) (*types.Transaction, error) {
+			// If original transactor options has a non-zero gas limit, that
+			// means the client code set it on their own. In that case, we
+			// should rewrite the gas limit from the original transaction
+			// for each resubmission. If the gas limit is not set by the client
+			// code, let the the submitter re-estimate the gas limit on each
+			// resubmission.
+			if transactorOptions.GasLimit != 0 {
+				newTransactorOptions.GasLimit = transactorOptions.GasLimit
+			}
+
+			transaction, err := fbb.contract.Withdraw(
+				newTransactorOptions,
+				amount,
+				operator,
+			)
+			if err != nil {
+				return nil, fbb.errorResolver.ResolveError(
+					err,
+					fbb.transactorOptions.From,
+					nil,
+					"withdraw",
+					amount,
+					operator,
+				)
+			}
+
+			fbbLogger.Infof(
+				"submitted transaction withdraw with id: [%s] and nonce [%v]",
+				transaction.Hash(),
+				transaction.Nonce(),
+			)
+
+			return transaction, nil
+		},
+	)
+
+	fbb.nonceManager.IncrementNonce()
+
+	return transaction, err
+}
+
+// Non-mutating call, not a transaction submission.
+func (fbb *FullyBackedBonding) CallWithdraw(
+	amount *big.Int,
+	operator common.Address,
+	blockNumber *big.Int,
+) error {
+	var result interface{} = nil
+
+	err := chainutil.CallAtBlock(
+		fbb.transactorOptions.From,
+		blockNumber, nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"withdraw",
+		&result,
+		amount,
+		operator,
+	)
+
+	return err
+}
+
+func (fbb *FullyBackedBonding) WithdrawGasEstimate(
+	amount *big.Int,
+	operator common.Address,
+) (uint64, error) {
+	var result uint64
+
+	result, err := chainutil.EstimateGas(
+		fbb.callerOptions.From,
+		fbb.contractAddress,
+		"withdraw",
+		fbb.contractABI,
+		fbb.transactor,
+		amount,
+		operator,
+	)
+
+	return result, err
+}
+
+// ----- Const Methods ------
+
+func (fbb *FullyBackedBonding) AuthorizerOf(
+	_operator common.Address,
+) (common.Address, error) {
+	var result common.Address
+	result, err := fbb.contract.AuthorizerOf(
+		fbb.callerOptions,
+		_operator,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"authorizerOf",
+			_operator,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) AuthorizerOfAtBlock(
+	_operator common.Address,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := chainutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"authorizerOf",
+		&result,
+		_operator,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) AvailableUnbondedValue(
+	operator common.Address,
+	bondCreator common.Address,
+	authorizedSortitionPool common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.AvailableUnbondedValue(
+		fbb.callerOptions,
+		operator,
+		bondCreator,
+		authorizedSortitionPool,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"availableUnbondedValue",
+			operator,
+			bondCreator,
+			authorizedSortitionPool,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) AvailableUnbondedValueAtBlock(
+	operator common.Address,
+	bondCreator common.Address,
+	authorizedSortitionPool common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := chainutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"availableUnbondedValue",
+		&result,
+		operator,
+		bondCreator,
+		authorizedSortitionPool,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) BalanceOf(
+	_address common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.BalanceOf(
+		fbb.callerOptions,
+		_address,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"balanceOf",
+			_address,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) BalanceOfAtBlock(
+	_address common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := chainutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"balanceOf",
+		&result,
+		_address,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) BeneficiaryOf(
+	_operator common.Address,
+) (common.Address, error) {
+	var result common.Address
+	result, err := fbb.contract.BeneficiaryOf(
+		fbb.callerOptions,
+		_operator,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"beneficiaryOf",
+			_operator,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) BeneficiaryOfAtBlock(
+	_operator common.Address,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := chainutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"beneficiaryOf",
+		&result,
+		_operator,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) BondAmount(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.BondAmount(
+		fbb.callerOptions,
+		operator,
+		holder,
+		referenceID,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"bondAmount",
+			operator,
+			holder,
+			referenceID,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) BondAmountAtBlock(
+	operator common.Address,
+	holder common.Address,
+	referenceID *big.Int,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := chainutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"bondAmount",
+		&result,
+		operator,
+		holder,
+		referenceID,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) DELEGATIONLOCKPERIOD() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.DELEGATIONLOCKPERIOD(
+		fbb.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"dELEGATIONLOCKPERIOD",
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) DELEGATIONLOCKPERIODAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := chainutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"dELEGATIONLOCKPERIOD",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) GetAuthoritySource(
+	operatorContract common.Address,
+) (common.Address, error) {
+	var result common.Address
+	result, err := fbb.contract.GetAuthoritySource(
+		fbb.callerOptions,
+		operatorContract,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"getAuthoritySource",
+			operatorContract,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) GetAuthoritySourceAtBlock(
+	operatorContract common.Address,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := chainutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"getAuthoritySource",
+		&result,
+		operatorContract,
+	)
+
+	return result, err
+}
+
+type DelegationInfo struct {
+	CreatedAt     *big.Int
+	UndelegatedAt *big.Int
+}
+
+func (fbb *FullyBackedBonding) GetDelegationInfo(
+	operator common.Address,
+) (DelegationInfo, error) {
+	var result DelegationInfo
+	result, err := fbb.contract.GetDelegationInfo(
+		fbb.callerOptions,
+		operator,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"getDelegationInfo",
+			operator,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) GetDelegationInfoAtBlock(
+	operator common.Address,
+	blockNumber *big.Int,
+) (DelegationInfo, error) {
+	var result DelegationInfo
+
+	err := chainutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"getDelegationInfo",
+		&result,
+		operator,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) HasSecondaryAuthorization(
+	_operator common.Address,
+	_poolAddress common.Address,
+) (bool, error) {
+	var result bool
+	result, err := fbb.contract.HasSecondaryAuthorization(
+		fbb.callerOptions,
+		_operator,
+		_poolAddress,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"hasSecondaryAuthorization",
+			_operator,
+			_poolAddress,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) HasSecondaryAuthorizationAtBlock(
+	_operator common.Address,
+	_poolAddress common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := chainutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"hasSecondaryAuthorization",
+		&result,
+		_operator,
+		_poolAddress,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) InitializationPeriod() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.InitializationPeriod(
+		fbb.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"initializationPeriod",
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) InitializationPeriodAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := chainutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"initializationPeriod",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) IsApprovedOperatorContract(
+	_operatorContract common.Address,
+) (bool, error) {
+	var result bool
+	result, err := fbb.contract.IsApprovedOperatorContract(
+		fbb.callerOptions,
+		_operatorContract,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"isApprovedOperatorContract",
+			_operatorContract,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) IsApprovedOperatorContractAtBlock(
+	_operatorContract common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := chainutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"isApprovedOperatorContract",
+		&result,
+		_operatorContract,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) IsAuthorizedForOperator(
+	_operator common.Address,
+	_operatorContract common.Address,
+) (bool, error) {
+	var result bool
+	result, err := fbb.contract.IsAuthorizedForOperator(
+		fbb.callerOptions,
+		_operator,
+		_operatorContract,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"isAuthorizedForOperator",
+			_operator,
+			_operatorContract,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) IsAuthorizedForOperatorAtBlock(
+	_operator common.Address,
+	_operatorContract common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := chainutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"isAuthorizedForOperator",
+		&result,
+		_operator,
+		_operatorContract,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) IsInitialized(
+	operator common.Address,
+	bondCreator common.Address,
+) (bool, error) {
+	var result bool
+	result, err := fbb.contract.IsInitialized(
+		fbb.callerOptions,
+		operator,
+		bondCreator,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"isInitialized",
+			operator,
+			bondCreator,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) IsInitializedAtBlock(
+	operator common.Address,
+	bondCreator common.Address,
+	blockNumber *big.Int,
+) (bool, error) {
+	var result bool
+
+	err := chainutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"isInitialized",
+		&result,
+		operator,
+		bondCreator,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) MINIMUMDELEGATIONDEPOSIT() (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.MINIMUMDELEGATIONDEPOSIT(
+		fbb.callerOptions,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"mINIMUMDELEGATIONDEPOSIT",
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) MINIMUMDELEGATIONDEPOSITAtBlock(
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := chainutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"mINIMUMDELEGATIONDEPOSIT",
+		&result,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) OwnerOf(
+	_operator common.Address,
+) (common.Address, error) {
+	var result common.Address
+	result, err := fbb.contract.OwnerOf(
+		fbb.callerOptions,
+		_operator,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"ownerOf",
+			_operator,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) OwnerOfAtBlock(
+	_operator common.Address,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	var result common.Address
+
+	err := chainutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"ownerOf",
+		&result,
+		_operator,
+	)
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) UnbondedValue(
+	arg0 common.Address,
+) (*big.Int, error) {
+	var result *big.Int
+	result, err := fbb.contract.UnbondedValue(
+		fbb.callerOptions,
+		arg0,
+	)
+
+	if err != nil {
+		return result, fbb.errorResolver.ResolveError(
+			err,
+			fbb.callerOptions.From,
+			nil,
+			"unbondedValue",
+			arg0,
+		)
+	}
+
+	return result, err
+}
+
+func (fbb *FullyBackedBonding) UnbondedValueAtBlock(
+	arg0 common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	var result *big.Int
+
+	err := chainutil.CallAtBlock(
+		fbb.callerOptions.From,
+		blockNumber,
+		nil,
+		fbb.contractABI,
+		fbb.caller,
+		fbb.errorResolver,
+		fbb.contractAddress,
+		"unbondedValue",
+		&result,
+		arg0,
+	)
+
+	return result, err
+}
+
+// ------ Events -------
+
+func (fbb *FullyBackedBonding) BondCreated(
+	opts *ethlike.SubscribeOpts,
+	operatorFilter []common.Address,
+	holderFilter []common.Address,
+	sortitionPoolFilter []common.Address,
+) *FbbBondCreatedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &FbbBondCreatedSubscription{
+		fbb,
+		opts,
+		operatorFilter,
+		holderFilter,
+		sortitionPoolFilter,
+	}
+}
+
+type FbbBondCreatedSubscription struct {
+	contract            *FullyBackedBonding
+	opts                *ethlike.SubscribeOpts
+	operatorFilter      []common.Address
+	holderFilter        []common.Address
+	sortitionPoolFilter []common.Address
+}
+
+type fullyBackedBondingBondCreatedFunc func(
+	Operator common.Address,
+	Holder common.Address,
+	SortitionPool common.Address,
+	ReferenceID *big.Int,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (bcs *FbbBondCreatedSubscription) OnEvent(
+	handler fullyBackedBondingBondCreatedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedBondingBondCreated)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.Holder,
+					event.SortitionPool,
+					event.ReferenceID,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := bcs.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (bcs *FbbBondCreatedSubscription) Pipe(
+	sink chan *abi.FullyBackedBondingBondCreated,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(bcs.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := bcs.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - bcs.opts.PastBlocks
+
+				fbbLogger.Infof(
+					"subscription monitoring fetching past BondCreated events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := bcs.contract.PastBondCreatedEvents(
+					fromBlock,
+					nil,
+					bcs.operatorFilter,
+					bcs.holderFilter,
+					bcs.sortitionPoolFilter,
+				)
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbbLogger.Infof(
+					"subscription monitoring fetched [%v] past BondCreated events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := bcs.contract.watchBondCreated(
+		sink,
+		bcs.operatorFilter,
+		bcs.holderFilter,
+		bcs.sortitionPoolFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbb *FullyBackedBonding) watchBondCreated(
+	sink chan *abi.FullyBackedBondingBondCreated,
+	operatorFilter []common.Address,
+	holderFilter []common.Address,
+	sortitionPoolFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbb.contract.WatchBondCreated(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			holderFilter,
+			sortitionPoolFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbbLogger.Errorf(
+			"subscription to event BondCreated had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbbLogger.Errorf(
+			"subscription to event BondCreated failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbb *FullyBackedBonding) PastBondCreatedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	holderFilter []common.Address,
+	sortitionPoolFilter []common.Address,
+) ([]*abi.FullyBackedBondingBondCreated, error) {
+	iterator, err := fbb.contract.FilterBondCreated(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		holderFilter,
+		sortitionPoolFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondCreated events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingBondCreated, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) BondReassigned(
+	opts *ethlike.SubscribeOpts,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) *FbbBondReassignedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &FbbBondReassignedSubscription{
+		fbb,
+		opts,
+		operatorFilter,
+		referenceIDFilter,
+	}
+}
+
+type FbbBondReassignedSubscription struct {
+	contract          *FullyBackedBonding
+	opts              *ethlike.SubscribeOpts
+	operatorFilter    []common.Address
+	referenceIDFilter []*big.Int
+}
+
+type fullyBackedBondingBondReassignedFunc func(
+	Operator common.Address,
+	ReferenceID *big.Int,
+	NewHolder common.Address,
+	NewReferenceID *big.Int,
+	blockNumber uint64,
+)
+
+func (brs *FbbBondReassignedSubscription) OnEvent(
+	handler fullyBackedBondingBondReassignedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedBondingBondReassigned)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.ReferenceID,
+					event.NewHolder,
+					event.NewReferenceID,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := brs.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (brs *FbbBondReassignedSubscription) Pipe(
+	sink chan *abi.FullyBackedBondingBondReassigned,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(brs.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := brs.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - brs.opts.PastBlocks
+
+				fbbLogger.Infof(
+					"subscription monitoring fetching past BondReassigned events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := brs.contract.PastBondReassignedEvents(
+					fromBlock,
+					nil,
+					brs.operatorFilter,
+					brs.referenceIDFilter,
+				)
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbbLogger.Infof(
+					"subscription monitoring fetched [%v] past BondReassigned events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := brs.contract.watchBondReassigned(
+		sink,
+		brs.operatorFilter,
+		brs.referenceIDFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbb *FullyBackedBonding) watchBondReassigned(
+	sink chan *abi.FullyBackedBondingBondReassigned,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbb.contract.WatchBondReassigned(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			referenceIDFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbbLogger.Errorf(
+			"subscription to event BondReassigned had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbbLogger.Errorf(
+			"subscription to event BondReassigned failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbb *FullyBackedBonding) PastBondReassignedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) ([]*abi.FullyBackedBondingBondReassigned, error) {
+	iterator, err := fbb.contract.FilterBondReassigned(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondReassigned events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingBondReassigned, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) BondReleased(
+	opts *ethlike.SubscribeOpts,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) *FbbBondReleasedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &FbbBondReleasedSubscription{
+		fbb,
+		opts,
+		operatorFilter,
+		referenceIDFilter,
+	}
+}
+
+type FbbBondReleasedSubscription struct {
+	contract          *FullyBackedBonding
+	opts              *ethlike.SubscribeOpts
+	operatorFilter    []common.Address
+	referenceIDFilter []*big.Int
+}
+
+type fullyBackedBondingBondReleasedFunc func(
+	Operator common.Address,
+	ReferenceID *big.Int,
+	blockNumber uint64,
+)
+
+func (brs *FbbBondReleasedSubscription) OnEvent(
+	handler fullyBackedBondingBondReleasedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedBondingBondReleased)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.ReferenceID,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := brs.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (brs *FbbBondReleasedSubscription) Pipe(
+	sink chan *abi.FullyBackedBondingBondReleased,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(brs.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := brs.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - brs.opts.PastBlocks
+
+				fbbLogger.Infof(
+					"subscription monitoring fetching past BondReleased events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := brs.contract.PastBondReleasedEvents(
+					fromBlock,
+					nil,
+					brs.operatorFilter,
+					brs.referenceIDFilter,
+				)
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbbLogger.Infof(
+					"subscription monitoring fetched [%v] past BondReleased events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := brs.contract.watchBondReleased(
+		sink,
+		brs.operatorFilter,
+		brs.referenceIDFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbb *FullyBackedBonding) watchBondReleased(
+	sink chan *abi.FullyBackedBondingBondReleased,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbb.contract.WatchBondReleased(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			referenceIDFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbbLogger.Errorf(
+			"subscription to event BondReleased had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbbLogger.Errorf(
+			"subscription to event BondReleased failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbb *FullyBackedBonding) PastBondReleasedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) ([]*abi.FullyBackedBondingBondReleased, error) {
+	iterator, err := fbb.contract.FilterBondReleased(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondReleased events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingBondReleased, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) BondSeized(
+	opts *ethlike.SubscribeOpts,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) *FbbBondSeizedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &FbbBondSeizedSubscription{
+		fbb,
+		opts,
+		operatorFilter,
+		referenceIDFilter,
+	}
+}
+
+type FbbBondSeizedSubscription struct {
+	contract          *FullyBackedBonding
+	opts              *ethlike.SubscribeOpts
+	operatorFilter    []common.Address
+	referenceIDFilter []*big.Int
+}
+
+type fullyBackedBondingBondSeizedFunc func(
+	Operator common.Address,
+	ReferenceID *big.Int,
+	Destination common.Address,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (bss *FbbBondSeizedSubscription) OnEvent(
+	handler fullyBackedBondingBondSeizedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedBondingBondSeized)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.ReferenceID,
+					event.Destination,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := bss.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (bss *FbbBondSeizedSubscription) Pipe(
+	sink chan *abi.FullyBackedBondingBondSeized,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(bss.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := bss.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - bss.opts.PastBlocks
+
+				fbbLogger.Infof(
+					"subscription monitoring fetching past BondSeized events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := bss.contract.PastBondSeizedEvents(
+					fromBlock,
+					nil,
+					bss.operatorFilter,
+					bss.referenceIDFilter,
+				)
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbbLogger.Infof(
+					"subscription monitoring fetched [%v] past BondSeized events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := bss.contract.watchBondSeized(
+		sink,
+		bss.operatorFilter,
+		bss.referenceIDFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbb *FullyBackedBonding) watchBondSeized(
+	sink chan *abi.FullyBackedBondingBondSeized,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbb.contract.WatchBondSeized(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			referenceIDFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbbLogger.Errorf(
+			"subscription to event BondSeized had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbbLogger.Errorf(
+			"subscription to event BondSeized failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbb *FullyBackedBonding) PastBondSeizedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	referenceIDFilter []*big.Int,
+) ([]*abi.FullyBackedBondingBondSeized, error) {
+	iterator, err := fbb.contract.FilterBondSeized(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		referenceIDFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past BondSeized events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingBondSeized, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) Delegated(
+	opts *ethlike.SubscribeOpts,
+	ownerFilter []common.Address,
+	operatorFilter []common.Address,
+) *FbbDelegatedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &FbbDelegatedSubscription{
+		fbb,
+		opts,
+		ownerFilter,
+		operatorFilter,
+	}
+}
+
+type FbbDelegatedSubscription struct {
+	contract       *FullyBackedBonding
+	opts           *ethlike.SubscribeOpts
+	ownerFilter    []common.Address
+	operatorFilter []common.Address
+}
+
+type fullyBackedBondingDelegatedFunc func(
+	Owner common.Address,
+	Operator common.Address,
+	blockNumber uint64,
+)
+
+func (ds *FbbDelegatedSubscription) OnEvent(
+	handler fullyBackedBondingDelegatedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedBondingDelegated)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Owner,
+					event.Operator,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := ds.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (ds *FbbDelegatedSubscription) Pipe(
+	sink chan *abi.FullyBackedBondingDelegated,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(ds.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := ds.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - ds.opts.PastBlocks
+
+				fbbLogger.Infof(
+					"subscription monitoring fetching past Delegated events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := ds.contract.PastDelegatedEvents(
+					fromBlock,
+					nil,
+					ds.ownerFilter,
+					ds.operatorFilter,
+				)
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbbLogger.Infof(
+					"subscription monitoring fetched [%v] past Delegated events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := ds.contract.watchDelegated(
+		sink,
+		ds.ownerFilter,
+		ds.operatorFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbb *FullyBackedBonding) watchDelegated(
+	sink chan *abi.FullyBackedBondingDelegated,
+	ownerFilter []common.Address,
+	operatorFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbb.contract.WatchDelegated(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			ownerFilter,
+			operatorFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbbLogger.Errorf(
+			"subscription to event Delegated had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbbLogger.Errorf(
+			"subscription to event Delegated failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbb *FullyBackedBonding) PastDelegatedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	ownerFilter []common.Address,
+	operatorFilter []common.Address,
+) ([]*abi.FullyBackedBondingDelegated, error) {
+	iterator, err := fbb.contract.FilterDelegated(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		ownerFilter,
+		operatorFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past Delegated events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingDelegated, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) OperatorDelegated(
+	opts *ethlike.SubscribeOpts,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+	authorizerFilter []common.Address,
+) *FbbOperatorDelegatedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &FbbOperatorDelegatedSubscription{
+		fbb,
+		opts,
+		operatorFilter,
+		beneficiaryFilter,
+		authorizerFilter,
+	}
+}
+
+type FbbOperatorDelegatedSubscription struct {
+	contract          *FullyBackedBonding
+	opts              *ethlike.SubscribeOpts
+	operatorFilter    []common.Address
+	beneficiaryFilter []common.Address
+	authorizerFilter  []common.Address
+}
+
+type fullyBackedBondingOperatorDelegatedFunc func(
+	Operator common.Address,
+	Beneficiary common.Address,
+	Authorizer common.Address,
+	Value *big.Int,
+	blockNumber uint64,
+)
+
+func (ods *FbbOperatorDelegatedSubscription) OnEvent(
+	handler fullyBackedBondingOperatorDelegatedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedBondingOperatorDelegated)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.Beneficiary,
+					event.Authorizer,
+					event.Value,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := ods.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (ods *FbbOperatorDelegatedSubscription) Pipe(
+	sink chan *abi.FullyBackedBondingOperatorDelegated,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(ods.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := ods.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - ods.opts.PastBlocks
+
+				fbbLogger.Infof(
+					"subscription monitoring fetching past OperatorDelegated events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := ods.contract.PastOperatorDelegatedEvents(
+					fromBlock,
+					nil,
+					ods.operatorFilter,
+					ods.beneficiaryFilter,
+					ods.authorizerFilter,
+				)
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbbLogger.Infof(
+					"subscription monitoring fetched [%v] past OperatorDelegated events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := ods.contract.watchOperatorDelegated(
+		sink,
+		ods.operatorFilter,
+		ods.beneficiaryFilter,
+		ods.authorizerFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbb *FullyBackedBonding) watchOperatorDelegated(
+	sink chan *abi.FullyBackedBondingOperatorDelegated,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+	authorizerFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbb.contract.WatchOperatorDelegated(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			beneficiaryFilter,
+			authorizerFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbbLogger.Errorf(
+			"subscription to event OperatorDelegated had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbbLogger.Errorf(
+			"subscription to event OperatorDelegated failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbb *FullyBackedBonding) PastOperatorDelegatedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+	authorizerFilter []common.Address,
+) ([]*abi.FullyBackedBondingOperatorDelegated, error) {
+	iterator, err := fbb.contract.FilterOperatorDelegated(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		beneficiaryFilter,
+		authorizerFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past OperatorDelegated events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingOperatorDelegated, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) OperatorToppedUp(
+	opts *ethlike.SubscribeOpts,
+	operatorFilter []common.Address,
+) *FbbOperatorToppedUpSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &FbbOperatorToppedUpSubscription{
+		fbb,
+		opts,
+		operatorFilter,
+	}
+}
+
+type FbbOperatorToppedUpSubscription struct {
+	contract       *FullyBackedBonding
+	opts           *ethlike.SubscribeOpts
+	operatorFilter []common.Address
+}
+
+type fullyBackedBondingOperatorToppedUpFunc func(
+	Operator common.Address,
+	Value *big.Int,
+	blockNumber uint64,
+)
+
+func (otus *FbbOperatorToppedUpSubscription) OnEvent(
+	handler fullyBackedBondingOperatorToppedUpFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedBondingOperatorToppedUp)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.Value,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := otus.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (otus *FbbOperatorToppedUpSubscription) Pipe(
+	sink chan *abi.FullyBackedBondingOperatorToppedUp,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(otus.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := otus.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - otus.opts.PastBlocks
+
+				fbbLogger.Infof(
+					"subscription monitoring fetching past OperatorToppedUp events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := otus.contract.PastOperatorToppedUpEvents(
+					fromBlock,
+					nil,
+					otus.operatorFilter,
+				)
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbbLogger.Infof(
+					"subscription monitoring fetched [%v] past OperatorToppedUp events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := otus.contract.watchOperatorToppedUp(
+		sink,
+		otus.operatorFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbb *FullyBackedBonding) watchOperatorToppedUp(
+	sink chan *abi.FullyBackedBondingOperatorToppedUp,
+	operatorFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbb.contract.WatchOperatorToppedUp(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbbLogger.Errorf(
+			"subscription to event OperatorToppedUp had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbbLogger.Errorf(
+			"subscription to event OperatorToppedUp failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbb *FullyBackedBonding) PastOperatorToppedUpEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+) ([]*abi.FullyBackedBondingOperatorToppedUp, error) {
+	iterator, err := fbb.contract.FilterOperatorToppedUp(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past OperatorToppedUp events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingOperatorToppedUp, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) UnbondedValueDeposited(
+	opts *ethlike.SubscribeOpts,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) *FbbUnbondedValueDepositedSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &FbbUnbondedValueDepositedSubscription{
+		fbb,
+		opts,
+		operatorFilter,
+		beneficiaryFilter,
+	}
+}
+
+type FbbUnbondedValueDepositedSubscription struct {
+	contract          *FullyBackedBonding
+	opts              *ethlike.SubscribeOpts
+	operatorFilter    []common.Address
+	beneficiaryFilter []common.Address
+}
+
+type fullyBackedBondingUnbondedValueDepositedFunc func(
+	Operator common.Address,
+	Beneficiary common.Address,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (uvds *FbbUnbondedValueDepositedSubscription) OnEvent(
+	handler fullyBackedBondingUnbondedValueDepositedFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedBondingUnbondedValueDeposited)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.Beneficiary,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := uvds.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (uvds *FbbUnbondedValueDepositedSubscription) Pipe(
+	sink chan *abi.FullyBackedBondingUnbondedValueDeposited,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(uvds.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := uvds.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - uvds.opts.PastBlocks
+
+				fbbLogger.Infof(
+					"subscription monitoring fetching past UnbondedValueDeposited events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := uvds.contract.PastUnbondedValueDepositedEvents(
+					fromBlock,
+					nil,
+					uvds.operatorFilter,
+					uvds.beneficiaryFilter,
+				)
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbbLogger.Infof(
+					"subscription monitoring fetched [%v] past UnbondedValueDeposited events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := uvds.contract.watchUnbondedValueDeposited(
+		sink,
+		uvds.operatorFilter,
+		uvds.beneficiaryFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbb *FullyBackedBonding) watchUnbondedValueDeposited(
+	sink chan *abi.FullyBackedBondingUnbondedValueDeposited,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbb.contract.WatchUnbondedValueDeposited(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			beneficiaryFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbbLogger.Errorf(
+			"subscription to event UnbondedValueDeposited had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbbLogger.Errorf(
+			"subscription to event UnbondedValueDeposited failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbb *FullyBackedBonding) PastUnbondedValueDepositedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) ([]*abi.FullyBackedBondingUnbondedValueDeposited, error) {
+	iterator, err := fbb.contract.FilterUnbondedValueDeposited(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		beneficiaryFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past UnbondedValueDeposited events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingUnbondedValueDeposited, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (fbb *FullyBackedBonding) UnbondedValueWithdrawn(
+	opts *ethlike.SubscribeOpts,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) *FbbUnbondedValueWithdrawnSubscription {
+	if opts == nil {
+		opts = new(ethlike.SubscribeOpts)
+	}
+	if opts.Tick == 0 {
+		opts.Tick = chainutil.DefaultSubscribeOptsTick
+	}
+	if opts.PastBlocks == 0 {
+		opts.PastBlocks = chainutil.DefaultSubscribeOptsPastBlocks
+	}
+
+	return &FbbUnbondedValueWithdrawnSubscription{
+		fbb,
+		opts,
+		operatorFilter,
+		beneficiaryFilter,
+	}
+}
+
+type FbbUnbondedValueWithdrawnSubscription struct {
+	contract          *FullyBackedBonding
+	opts              *ethlike.SubscribeOpts
+	operatorFilter    []common.Address
+	beneficiaryFilter []common.Address
+}
+
+type fullyBackedBondingUnbondedValueWithdrawnFunc func(
+	Operator common.Address,
+	Beneficiary common.Address,
+	Amount *big.Int,
+	blockNumber uint64,
+)
+
+func (uvws *FbbUnbondedValueWithdrawnSubscription) OnEvent(
+	handler fullyBackedBondingUnbondedValueWithdrawnFunc,
+) subscription.EventSubscription {
+	eventChan := make(chan *abi.FullyBackedBondingUnbondedValueWithdrawn)
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventChan:
+				handler(
+					event.Operator,
+					event.Beneficiary,
+					event.Amount,
+					event.Raw.BlockNumber,
+				)
+			}
+		}
+	}()
+
+	sub := uvws.Pipe(eventChan)
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (uvws *FbbUnbondedValueWithdrawnSubscription) Pipe(
+	sink chan *abi.FullyBackedBondingUnbondedValueWithdrawn,
+) subscription.EventSubscription {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(uvws.opts.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				lastBlock, err := uvws.contract.blockCounter.CurrentBlock()
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+				}
+				fromBlock := lastBlock - uvws.opts.PastBlocks
+
+				fbbLogger.Infof(
+					"subscription monitoring fetching past UnbondedValueWithdrawn events "+
+						"starting from block [%v]",
+					fromBlock,
+				)
+				events, err := uvws.contract.PastUnbondedValueWithdrawnEvents(
+					fromBlock,
+					nil,
+					uvws.operatorFilter,
+					uvws.beneficiaryFilter,
+				)
+				if err != nil {
+					fbbLogger.Errorf(
+						"subscription failed to pull events: [%v]",
+						err,
+					)
+					continue
+				}
+				fbbLogger.Infof(
+					"subscription monitoring fetched [%v] past UnbondedValueWithdrawn events",
+					len(events),
+				)
+
+				for _, event := range events {
+					sink <- event
+				}
+			}
+		}
+	}()
+
+	sub := uvws.contract.watchUnbondedValueWithdrawn(
+		sink,
+		uvws.operatorFilter,
+		uvws.beneficiaryFilter,
+	)
+
+	return subscription.NewEventSubscription(func() {
+		sub.Unsubscribe()
+		cancelCtx()
+	})
+}
+
+func (fbb *FullyBackedBonding) watchUnbondedValueWithdrawn(
+	sink chan *abi.FullyBackedBondingUnbondedValueWithdrawn,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) event.Subscription {
+	subscribeFn := func(ctx context.Context) (event.Subscription, error) {
+		return fbb.contract.WatchUnbondedValueWithdrawn(
+			&bind.WatchOpts{Context: ctx},
+			sink,
+			operatorFilter,
+			beneficiaryFilter,
+		)
+	}
+
+	thresholdViolatedFn := func(elapsed time.Duration) {
+		fbbLogger.Errorf(
+			"subscription to event UnbondedValueWithdrawn had to be "+
+				"retried [%s] since the last attempt; please inspect "+
+				"host chain connectivity",
+			elapsed,
+		)
+	}
+
+	subscriptionFailedFn := func(err error) {
+		fbbLogger.Errorf(
+			"subscription to event UnbondedValueWithdrawn failed "+
+				"with error: [%v]; resubscription attempt will be "+
+				"performed",
+			err,
+		)
+	}
+
+	return chainutil.WithResubscription(
+		chainutil.SubscriptionBackoffMax,
+		subscribeFn,
+		chainutil.SubscriptionAlertThreshold,
+		thresholdViolatedFn,
+		subscriptionFailedFn,
+	)
+}
+
+func (fbb *FullyBackedBonding) PastUnbondedValueWithdrawnEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	operatorFilter []common.Address,
+	beneficiaryFilter []common.Address,
+) ([]*abi.FullyBackedBondingUnbondedValueWithdrawn, error) {
+	iterator, err := fbb.contract.FilterUnbondedValueWithdrawn(
+		&bind.FilterOpts{
+			Start: startBlock,
+			End:   endBlock,
+		},
+		operatorFilter,
+		beneficiaryFilter,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving past UnbondedValueWithdrawn events: [%v]",
+			err,
+		)
+	}
+
+	events := make([]*abi.FullyBackedBondingUnbondedValueWithdrawn, 0)
+
+	for iterator.Next() {
+		event := iterator.Event
+		events = append(events, event)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,110 @@
+package local
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+)
+
+// localApplication is an application other than tBTC opening keeps on the
+// local chain.
+type localApplication struct {
+	chain *localChain
+
+	applicationAddress common.Address
+}
+
+// ApplicationHandle returns a handle of the application with the given ID.
+// The handle of the tBTC application of the local chain is returned for the
+// tBTC application ID.
+func (lc *localChain) ApplicationHandle(
+	applicationID chain.ID,
+) (chain.BondedECDSAKeepApplicationHandle, error) {
+	applicationAddress, err := fromChainID(applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if applicationAddress == common.BigToAddress(tbtcApplicationID) {
+		return lc.TBTCApplicationHandle()
+	}
+
+	return &localApplication{
+		chain:              lc,
+		applicationAddress: applicationAddress,
+	}, nil
+}
+
+// GetKeepApplication returns the ID of the application which opened the keep
+// with the given ID.
+func (lc *localChain) GetKeepApplication(keepID chain.ID) (chain.ID, error) {
+	keepAddress, err := fromChainID(keepID)
+	if err != nil {
+		return nil, err
+	}
+
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return nil, fmt.Errorf("no keep with address [%v]", keepAddress)
+	}
+
+	return localChainID(keep.application), nil
+}
+
+func (la *localApplication) ID() chain.ID {
+	return localChainID(la.applicationAddress)
+}
+
+func (la *localApplication) RegisterAsMemberCandidate() error {
+	la.chain.localChainMutex.Lock()
+	defer la.chain.localChainMutex.Unlock()
+
+	registeredOperators, ok := la.chain.registeredOperators[la.applicationAddress]
+	if !ok {
+		registeredOperators = make(map[common.Address]bool)
+		la.chain.registeredOperators[la.applicationAddress] = registeredOperators
+	}
+
+	registeredOperators[la.chain.OperatorAddress()] = true
+
+	return nil
+}
+
+func (la *localApplication) IsRegisteredForApplication() (bool, error) {
+	la.chain.localChainMutex.Lock()
+	defer la.chain.localChainMutex.Unlock()
+
+	return la.chain.registeredOperators[la.applicationAddress][la.chain.OperatorAddress()], nil
+}
+
+// IsEligibleForApplication returns true as all operators are eligible on the
+// local chain.
+func (la *localApplication) IsEligibleForApplication() (bool, error) {
+	return true, nil
+}
+
+// IsStatusUpToDateForApplication returns true as the status of operators
+// never gets out of date on the local chain.
+func (la *localApplication) IsStatusUpToDateForApplication() (bool, error) {
+	return true, nil
+}
+
+func (la *localApplication) UpdateStatusForApplication() error {
+	return nil
+}
+
+// MinimumBond returns zero as there are no bonds on the local chain.
+func (la *localApplication) MinimumBond() (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+// AvailableUnbondedValue returns zero as there are no bonds on the local
+// chain.
+func (la *localApplication) AvailableUnbondedValue() (*big.Int, error) {
+	return big.NewInt(0), nil
+}
@@ -12,6 +12,10 @@ import (
 	"github.com/keep-network/keep-ecdsa/pkg/utils/byteutils"
 )
 
+// WithdrawETHRewardFee is the fee of withdrawing rewards from a keep on the
+// local chain, in wei.
+const WithdrawETHRewardFee = 1000
+
 type keepStatus int
 
 const (
@@ -20,22 +24,53 @@ const (
 	terminated
 )
 
+// localKeep is a handle of a keep bound to the view of the local chain of
+// a specific operator.
 type localKeep struct {
-	chain  *localChain
-	keepID common.Address
-	owner  common.Address
+	*keepState
+
+	chain *localChain
+}
+
+// keepState is the state of the keep shared by all operator views of the
+// local chain.
+type keepState struct {
+	keepID      common.Address
+	owner       common.Address
+	application common.Address
 
-	publicKey    [64]byte
-	members      []common.Address
-	status       keepStatus
-	latestDigest [32]byte
+	publicKey            [64]byte
+	publicKeySubmissions map[common.Address][64]byte
+	members              []common.Address
+	status               keepStatus
+	latestDigest         [32]byte
+	openedTimestamp      time.Time
+
+	signatureRequestedBlocks map[[32]byte]uint64
 
 	signatureRequestedHandlers map[int]func(event *chain.SignatureRequestedEvent)
 
+	conflictingPublicKeyHandlers map[int]func(event *chain.ConflictingPublicKeySubmittedEvent)
+	publicKeyPublishedHandlers   map[int]func(event *chain.PublicKeyPublishedEvent)
+
 	keepClosedHandlers     map[int]func(event *chain.KeepClosedEvent)
 	keepTerminatedHandlers map[int]func(event *chain.KeepTerminatedEvent)
 
 	signatureSubmittedEvents []*chain.SignatureSubmittedEvent
+
+	memberETHBalances map[common.Address]*big.Int
+}
+
+// bindKeep returns a handle of the given keep bound to the chain view.
+func (lc *localChain) bindKeep(keep *localKeep) *localKeep {
+	if keep == nil {
+		return nil
+	}
+
+	return &localKeep{
+		keepState: keep.keepState,
+		chain:     lc,
+	}
 }
 
 func (lc *localChain) GetKeepWithID(
@@ -46,22 +81,37 @@ func (lc *localChain) GetKeepWithID(
 		return nil, err
 	}
 
-	return lc.keeps[keepAddress], nil
+	lc.localChainMutex.Lock()
+	keep, ok := lc.keeps[keepAddress]
+	lc.localChainMutex.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf(
+			"failed to find keep with address: [%s]",
+			keepAddress.String(),
+		)
+	}
+
+	return lc.bindKeep(keep), nil
 }
 
 func (lc *localChain) GetKeepAtIndex(
 	keepIndex *big.Int,
 ) (chain.BondedECDSAKeepHandle, error) {
 	lc.localChainMutex.Lock()
-	defer lc.localChainMutex.Unlock()
 
 	index := int(keepIndex.Uint64())
 
-	if index > len(lc.keepAddresses) {
+	if index >= len(lc.keepAddresses) {
+		lc.localChainMutex.Unlock()
 		return nil, fmt.Errorf("out of bounds")
 	}
 
-	return lc.GetKeepWithID(localChainID(lc.keepAddresses[index]))
+	keepAddress := lc.keepAddresses[index]
+
+	lc.localChainMutex.Unlock()
+
+	return lc.GetKeepWithID(localChainID(keepAddress))
 }
 
 func (lk *localKeep) ID() chain.ID {
@@ -91,29 +141,92 @@ func (lk *localKeep) OnSignatureRequested(
 func (lk *localKeep) OnConflictingPublicKeySubmitted(
 	handler func(event *chain.ConflictingPublicKeySubmittedEvent),
 ) (subscription.EventSubscription, error) {
-	panic("implement")
+	lk.chain.localChainMutex.Lock()
+	defer lk.chain.localChainMutex.Unlock()
+
+	handlerID := generateHandlerID()
+
+	lk.conflictingPublicKeyHandlers[handlerID] = handler
+
+	return subscription.NewEventSubscription(func() {
+		lk.chain.localChainMutex.Lock()
+		defer lk.chain.localChainMutex.Unlock()
+
+		delete(lk.conflictingPublicKeyHandlers, handlerID)
+	}), nil
 }
 
 func (lk *localKeep) OnPublicKeyPublished(
 	handler func(event *chain.PublicKeyPublishedEvent),
 ) (subscription.EventSubscription, error) {
-	panic("implement")
+	lk.chain.localChainMutex.Lock()
+	defer lk.chain.localChainMutex.Unlock()
+
+	handlerID := generateHandlerID()
+
+	lk.publicKeyPublishedHandlers[handlerID] = handler
+
+	return subscription.NewEventSubscription(func() {
+		lk.chain.localChainMutex.Lock()
+		defer lk.chain.localChainMutex.Unlock()
+
+		delete(lk.publicKeyPublishedHandlers, handlerID)
+	}), nil
 }
 
-// SubmitKeepPublicKey checks if public key has been already submitted for given
-// keep address, if not it stores the key in a map.
+// SubmitKeepPublicKey records the public key submitted by the operator of the
+// chain view. Each operator can submit the public key only once. The first
+// submitted key becomes the public key of the keep; keys submitted later by
+// other operators which do not match it are reported as conflicting.
 func (lk *localKeep) SubmitKeepPublicKey(publicKey [64]byte) error {
 	lk.chain.localChainMutex.Lock()
 	defer lk.chain.localChainMutex.Unlock()
 
-	if lk.publicKey != [64]byte{} {
+	operatorAddress := lk.chain.OperatorAddress()
+
+	if _, ok := lk.publicKeySubmissions[operatorAddress]; ok {
 		return fmt.Errorf(
 			"public key already submitted for keep [%s]",
 			lk.ID().String(),
 		)
 	}
 
-	lk.publicKey = publicKey
+	lk.publicKeySubmissions[operatorAddress] = publicKey
+
+	if lk.publicKey == [64]byte{} {
+		lk.publicKey = publicKey
+
+		publicKeyPublishedEvent := &chain.PublicKeyPublishedEvent{
+			PublicKey: publicKey[:],
+		}
+
+		for _, handler := range lk.publicKeyPublishedHandlers {
+			go func(
+				handler func(event *chain.PublicKeyPublishedEvent),
+				publicKeyPublishedEvent *chain.PublicKeyPublishedEvent,
+			) {
+				handler(publicKeyPublishedEvent)
+			}(handler, publicKeyPublishedEvent)
+		}
+
+		return nil
+	}
+
+	if lk.publicKey != publicKey {
+		conflictingPublicKeyEvent := &chain.ConflictingPublicKeySubmittedEvent{
+			SubmittingMember:     localChainID(operatorAddress),
+			ConflictingPublicKey: publicKey[:],
+		}
+
+		for _, handler := range lk.conflictingPublicKeyHandlers {
+			go func(
+				handler func(event *chain.ConflictingPublicKeySubmittedEvent),
+				conflictingPublicKeyEvent *chain.ConflictingPublicKeySubmittedEvent,
+			) {
+				handler(conflictingPublicKeyEvent)
+			}(handler, conflictingPublicKeyEvent)
+		}
+	}
 
 	return nil
 }
@@ -209,17 +322,38 @@ func (lk *localKeep) IsActive() (bool, error) {
 }
 
 func (lk *localKeep) LatestDigest() ([32]byte, error) {
-	panic("implement")
+	lk.chain.localChainMutex.Lock()
+	defer lk.chain.localChainMutex.Unlock()
+
+	return lk.latestDigest, nil
 }
 
 func (lk *localKeep) SignatureRequestedBlock(digest [32]byte) (uint64, error) {
-	panic("implement")
+	lk.chain.localChainMutex.Lock()
+	defer lk.chain.localChainMutex.Unlock()
+
+	blockNumber, ok := lk.signatureRequestedBlocks[digest]
+	if !ok {
+		return 0, fmt.Errorf(
+			"signature for digest [%x] has not been requested from keep [%s]",
+			digest,
+			lk.ID().String(),
+		)
+	}
+
+	return blockNumber, nil
 }
 
+// GetPublicKey returns the public key of the keep or an empty slice if the
+// public key has not been submitted yet.
 func (lk *localKeep) GetPublicKey() ([]uint8, error) {
 	lk.chain.localChainMutex.Lock()
 	defer lk.chain.localChainMutex.Unlock()
 
+	if lk.publicKey == [64]byte{} {
+		return []uint8{}, nil
+	}
+
 	return lk.publicKey[:], nil
 }
 
@@ -275,12 +409,20 @@ func (lk *localKeep) GetOwner() (chain.ID, error) {
 	return localChainID(lk.owner), nil
 }
 
+// GetHonestThreshold returns the honest threshold of the keep. Keeps on the
+// local chain require all members to be honest.
 func (lk *localKeep) GetHonestThreshold() (uint64, error) {
-	panic("implement")
+	lk.chain.localChainMutex.Lock()
+	defer lk.chain.localChainMutex.Unlock()
+
+	return uint64(len(lk.members)), nil
 }
 
 func (lk *localKeep) GetOpenedTimestamp() (time.Time, error) {
-	panic("implement")
+	lk.chain.localChainMutex.Lock()
+	defer lk.chain.localChainMutex.Unlock()
+
+	return lk.openedTimestamp, nil
 }
 
 func (lk *localKeep) PastSignatureSubmittedEvents(
@@ -292,6 +434,52 @@ func (lk *localKeep) PastSignatureSubmittedEvents(
 	return lk.signatureSubmittedEvents, nil
 }
 
+// GetMemberETHBalance returns the reward balance of the operator held by the
+// keep.
+func (lk *localKeep) GetMemberETHBalance() (*big.Int, error) {
+	lk.chain.localChainMutex.Lock()
+	defer lk.chain.localChainMutex.Unlock()
+
+	balance, ok := lk.memberETHBalances[lk.chain.OperatorAddress()]
+	if !ok {
+		return big.NewInt(0), nil
+	}
+
+	return new(big.Int).Set(balance), nil
+}
+
+// WithdrawETHRewardFeeEstimate returns the fixed fee of withdrawing rewards
+// on the local chain. The estimation fails if the operator's balance is zero,
+// as the withdrawal transaction would revert.
+func (lk *localKeep) WithdrawETHRewardFeeEstimate() (*big.Int, error) {
+	lk.chain.localChainMutex.Lock()
+	defer lk.chain.localChainMutex.Unlock()
+
+	balance, ok := lk.memberETHBalances[lk.chain.OperatorAddress()]
+	if !ok || balance.Sign() == 0 {
+		return nil, fmt.Errorf("no funds to withdraw")
+	}
+
+	return big.NewInt(WithdrawETHRewardFee), nil
+}
+
+// WithdrawETHReward withdraws the operator's reward balance held by the keep.
+func (lk *localKeep) WithdrawETHReward() error {
+	lk.chain.localChainMutex.Lock()
+	defer lk.chain.localChainMutex.Unlock()
+
+	operatorAddress := lk.chain.OperatorAddress()
+
+	balance, ok := lk.memberETHBalances[operatorAddress]
+	if !ok || balance.Sign() == 0 {
+		return fmt.Errorf("no funds to withdraw")
+	}
+
+	delete(lk.memberETHBalances, operatorAddress)
+
+	return nil
+}
+
 func (lc *localChain) RequestSignature(keepAddress common.Address, digest [32]byte) error {
 	lc.localChainMutex.Lock()
 	defer lc.localChainMutex.Unlock()
@@ -312,7 +500,13 @@ func (lc *localChain) RequestSignature(keepAddress common.Address, digest [32]by
 		)
 	}
 
+	currentBlock, err := lc.BlockCounter().CurrentBlock()
+	if err != nil {
+		return err
+	}
+
 	keep.latestDigest = digest
+	keep.signatureRequestedBlocks[digest] = currentBlock
 
 	signatureRequestedEvent := &chain.SignatureRequestedEvent{
 		Digest: digest,
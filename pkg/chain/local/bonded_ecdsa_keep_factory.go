@@ -2,6 +2,8 @@ package local
 
 import (
 	"fmt"
+	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/keep-network/keep-ecdsa/pkg/chain"
@@ -10,12 +12,18 @@ import (
 func (c *localChain) createKeep(
 	keepAddress common.Address,
 ) error {
-	return c.createKeepWithMembers(keepAddress, keepAddress, []common.Address{})
+	return c.createKeepWithMembers(
+		keepAddress,
+		keepAddress,
+		common.BigToAddress(tbtcApplicationID),
+		[]common.Address{},
+	)
 }
 
 func (c *localChain) createKeepWithMembers(
 	keepAddress common.Address,
 	ownerAddress common.Address,
+	applicationAddress common.Address,
 	members []common.Address,
 ) error {
 	c.localChainMutex.Lock()
@@ -28,36 +36,44 @@ func (c *localChain) createKeepWithMembers(
 		)
 	}
 
-	localKeep := &localKeep{
-		chain:                      c,
-		keepID:                     keepAddress,
-		owner:                      ownerAddress,
-		publicKey:                  [64]byte{},
-		members:                    members,
-		signatureRequestedHandlers: make(map[int]func(event *chain.SignatureRequestedEvent)),
-		keepClosedHandlers:         make(map[int]func(event *chain.KeepClosedEvent)),
-		keepTerminatedHandlers:     make(map[int]func(event *chain.KeepTerminatedEvent)),
-		signatureSubmittedEvents:   make([]*chain.SignatureSubmittedEvent, 0),
+	currentBlock, err := c.BlockCounter().CurrentBlock()
+	if err != nil {
+		return err
 	}
 
-	c.keeps[keepAddress] = localKeep
-	c.keepAddresses = append(c.keepAddresses, keepAddress)
-
-	// Ignore errors as the local version never errors.
-	operatorIndex := localKeep.unsafeOperatorIndex()
-
-	keepCreatedEvent := &chain.BondedECDSAKeepCreatedEvent{
-		Keep:                 localKeep,
-		ThisOperatorIsMember: operatorIndex > -1,
+	keep := &localKeep{
+		keepState: &keepState{
+			keepID:                       keepAddress,
+			owner:                        ownerAddress,
+			application:                  applicationAddress,
+			publicKey:                    [64]byte{},
+			publicKeySubmissions:         make(map[common.Address][64]byte),
+			members:                      members,
+			openedTimestamp:              time.Now(),
+			signatureRequestedBlocks:     make(map[[32]byte]uint64),
+			signatureRequestedHandlers:   make(map[int]func(event *chain.SignatureRequestedEvent)),
+			conflictingPublicKeyHandlers: make(map[int]func(event *chain.ConflictingPublicKeySubmittedEvent)),
+			publicKeyPublishedHandlers:   make(map[int]func(event *chain.PublicKeyPublishedEvent)),
+			keepClosedHandlers:           make(map[int]func(event *chain.KeepClosedEvent)),
+			keepTerminatedHandlers:       make(map[int]func(event *chain.KeepTerminatedEvent)),
+			signatureSubmittedEvents:     make([]*chain.SignatureSubmittedEvent, 0),
+			memberETHBalances:            make(map[common.Address]*big.Int),
+		},
+		chain: c,
 	}
 
+	c.keeps[keepAddress] = keep
+	c.keepAddresses = append(c.keepAddresses, keepAddress)
+
+	// Each handler builds the event from the perspective of the operator of
+	// the chain view it has been registered with.
 	for _, handler := range c.keepCreatedHandlers {
 		go func(
-			handler func(event *chain.BondedECDSAKeepCreatedEvent),
-			keepCreatedEvent *chain.BondedECDSAKeepCreatedEvent,
+			handler func(keep *localKeep, blockNumber uint64),
+			keep *localKeep,
 		) {
-			handler(keepCreatedEvent)
-		}(handler, keepCreatedEvent)
+			handler(keep, currentBlock)
+		}(handler, keep)
 	}
 
 	return nil
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math/big"
 	"math/rand"
 	"reflect"
 	"testing"
@@ -101,3 +102,62 @@ func TestRequestSignature(t *testing.T) {
 		t.Fatal(ctx.Err())
 	}
 }
+
+func TestWithdrawETHReward(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	localChain := initializeLocalChain(ctx)
+	keepAddress := common.Address([20]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1})
+
+	keep := localChain.OpenKeep(
+		keepAddress,
+		emptyAddress,
+		[]common.Address{generateAddress(), localChain.OperatorAddress()},
+	)
+
+	if _, err := keep.WithdrawETHRewardFeeEstimate(); err == nil {
+		t.Fatal("expected fee estimation to fail for zero balance")
+	}
+
+	err := localChain.DistributeETHReward(keepAddress, big.NewInt(2001))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	balance, err := keep.GetMemberETHBalance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Cmp(big.NewInt(1001)) != 0 {
+		t.Errorf(
+			"unexpected balance\nexpected: [%v]\nactual:   [%v]",
+			1001,
+			balance,
+		)
+	}
+
+	fee, err := keep.WithdrawETHRewardFeeEstimate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee.Cmp(big.NewInt(WithdrawETHRewardFee)) != 0 {
+		t.Errorf(
+			"unexpected fee\nexpected: [%v]\nactual:   [%v]",
+			WithdrawETHRewardFee,
+			fee,
+		)
+	}
+
+	if err := keep.WithdrawETHReward(); err != nil {
+		t.Fatal(err)
+	}
+
+	balance, err = keep.GetMemberETHBalance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Sign() != 0 {
+		t.Errorf("unexpected balance after withdrawal: [%v]", balance)
+	}
+}
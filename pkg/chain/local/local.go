@@ -3,8 +3,6 @@ package local
 import (
 	"context"
 	cecdsa "crypto/ecdsa"
-	"crypto/elliptic"
-	crand "crypto/rand"
 	"fmt"
 	"math/big"
 	"math/rand"
@@ -16,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/keep-network/keep-common/pkg/subscription"
 	corechain "github.com/keep-network/keep-core/pkg/chain"
+	"github.com/keep-network/keep-core/pkg/operator"
 	"github.com/keep-network/keep-ecdsa/pkg/chain"
 
 	commonLocal "github.com/keep-network/keep-common/pkg/chain/local"
@@ -31,10 +30,23 @@ type Chain interface {
 		ownerAddress common.Address,
 		members []common.Address,
 	) chain.BondedECDSAKeepHandle
+	OpenKeepForApplication(
+		keepAddress common.Address,
+		ownerAddress common.Address,
+		applicationAddress common.Address,
+		members []common.Address,
+	) chain.BondedECDSAKeepHandle
 	CloseKeep(keepAddress common.Address) error
 	TerminateKeep(keepAddress common.Address) error
 	RequestSignature(keepAddress common.Address, digest [32]byte) error
+	DistributeETHReward(keepAddress common.Address, value *big.Int) error
 	AuthorizeOperator(operatorAddress common.Address)
+
+	// WithOperator returns a view of the chain for the operator with the
+	// given key. All views share the state of the chain so that a keep
+	// opened with one view is seen by all of them, but events and keep
+	// handles are delivered from the perspective of the view's operator.
+	WithOperator(operatorKey *cecdsa.PrivateKey) Chain
 }
 
 // localChain is an implementation of ethereum blockchain interface.
@@ -42,6 +54,15 @@ type Chain interface {
 // It mocks the behaviour of a real blockchain, without the complexity of deployments,
 // accounts, async transactions and so on. For use in tests ONLY.
 type localChain struct {
+	*localChainState
+
+	operatorKey *cecdsa.PrivateKey
+	signer      corechain.Signing
+}
+
+// localChainState is the state of the local chain shared by all operator
+// views of the chain.
+type localChainState struct {
 	localChainMutex sync.Mutex
 
 	blockCounter     corechain.BlockCounter
@@ -50,12 +71,14 @@ type localChain struct {
 	keepAddresses []common.Address
 	keeps         map[common.Address]*localKeep
 
-	keepCreatedHandlers map[int]func(event *chain.BondedECDSAKeepCreatedEvent)
-
-	operatorKey *cecdsa.PrivateKey
-	signer      corechain.Signing
+	keepCreatedHandlers map[int]func(keep *localKeep, blockNumber uint64)
 
 	authorizations map[common.Address]bool
+
+	// Operators registered in pools of applications other than tBTC.
+	registeredOperators map[common.Address]map[common.Address]bool
+
+	tbtc *tbtcState
 }
 
 // Connect performs initialization for the local chain, wrapped in the provided
@@ -66,20 +89,22 @@ func Connect(ctx context.Context) Chain {
 		panic(err) // should never happen
 	}
 
-	operatorKey, err := cecdsa.GenerateKey(elliptic.P256(), crand.Reader)
+	operatorPrivateKey, _, err := operator.GenerateKeyPair()
 	if err != nil {
 		panic(err)
 	}
-
-	signer := commonLocal.NewSigner(operatorKey)
+	operatorKey := (*cecdsa.PrivateKey)(operatorPrivateKey)
 
 	localChain := &localChain{
-		blockCounter:        blockCounter,
-		keeps:               make(map[common.Address]*localKeep),
-		keepCreatedHandlers: make(map[int]func(event *chain.BondedECDSAKeepCreatedEvent)),
-		operatorKey:         operatorKey,
-		signer:              signer,
-		authorizations:      make(map[common.Address]bool),
+		localChainState: &localChainState{
+			blockCounter:        blockCounter,
+			keeps:               make(map[common.Address]*localKeep),
+			keepCreatedHandlers: make(map[int]func(keep *localKeep, blockNumber uint64)),
+			authorizations:      make(map[common.Address]bool),
+			registeredOperators: make(map[common.Address]map[common.Address]bool),
+		},
+		operatorKey: operatorKey,
+		signer:      commonLocal.NewSigner(operatorKey),
 	}
 
 	// block 0 must be stored manually as it is not delivered by the block counter
@@ -90,6 +115,14 @@ func Connect(ctx context.Context) Chain {
 	return localChain
 }
 
+func (lc *localChain) WithOperator(operatorKey *cecdsa.PrivateKey) Chain {
+	return &localChain{
+		localChainState: lc.localChainState,
+		operatorKey:     operatorKey,
+		signer:          commonLocal.NewSigner(operatorKey),
+	}
+}
+
 func (lc *localChain) Name() string {
 	return "local"
 }
@@ -108,23 +141,44 @@ func (lc *localChain) observeBlocksTimestamps(ctx context.Context) {
 }
 
 func (lc *localChain) OperatorAddress() common.Address {
-	return common.BytesToAddress(lc.signer.PublicKey())
+	return common.Address(operator.PubkeyToAddress(lc.operatorKey.PublicKey))
 }
 
 func (lc *localChain) OperatorID() chain.ID {
-	return localChainID(common.BytesToAddress(lc.signer.PublicKey()))
+	return localChainID(lc.OperatorAddress())
 }
 
 func (lc *localChain) Signing() corechain.Signing {
 	return commonLocal.NewSigner(lc.operatorKey)
 }
 
+// OpenKeep opens a keep on behalf of the tBTC application of the local chain.
 func (lc *localChain) OpenKeep(
 	keepAddress common.Address,
 	ownerAddress common.Address,
 	members []common.Address,
 ) chain.BondedECDSAKeepHandle {
-	err := lc.createKeepWithMembers(keepAddress, ownerAddress, members)
+	return lc.OpenKeepForApplication(
+		keepAddress,
+		ownerAddress,
+		common.BigToAddress(tbtcApplicationID),
+		members,
+	)
+}
+
+// OpenKeepForApplication opens a keep on behalf of the given application.
+func (lc *localChain) OpenKeepForApplication(
+	keepAddress common.Address,
+	ownerAddress common.Address,
+	applicationAddress common.Address,
+	members []common.Address,
+) chain.BondedECDSAKeepHandle {
+	err := lc.createKeepWithMembers(
+		keepAddress,
+		ownerAddress,
+		applicationAddress,
+		members,
+	)
 	if err != nil {
 		panic(err)
 	}
@@ -142,6 +196,54 @@ func (lc *localChain) TerminateKeep(keepAddress common.Address) error {
 	return lc.terminateKeep(keepAddress)
 }
 
+// DistributeETHReward distributes the given reward value equally among
+// members of the keep. The remainder of the division is added to the
+// balance of the last member, as in the keep contract.
+func (lc *localChain) DistributeETHReward(
+	keepAddress common.Address,
+	value *big.Int,
+) error {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	keep, ok := lc.keeps[keepAddress]
+	if !ok {
+		return fmt.Errorf(
+			"failed to find keep with address: [%s]",
+			keepAddress.String(),
+		)
+	}
+
+	if len(keep.members) == 0 {
+		return fmt.Errorf(
+			"keep [%s] has no members",
+			keepAddress.String(),
+		)
+	}
+
+	membersCount := big.NewInt(int64(len(keep.members)))
+	dividend, remainder := new(big.Int).DivMod(
+		value,
+		membersCount,
+		new(big.Int),
+	)
+
+	for i, member := range keep.members {
+		reward := new(big.Int).Set(dividend)
+		if i == len(keep.members)-1 {
+			reward.Add(reward, remainder)
+		}
+
+		balance, ok := keep.memberETHBalances[member]
+		if !ok {
+			balance = big.NewInt(0)
+		}
+		keep.memberETHBalances[member] = new(big.Int).Add(balance, reward)
+	}
+
+	return nil
+}
+
 func (lc *localChain) AuthorizeOperator(operator common.Address) {
 	lc.localChainMutex.Lock()
 	defer lc.localChainMutex.Unlock()
@@ -153,6 +255,11 @@ func (lc *localChain) StakeMonitor() (corechain.StakeMonitor, error) {
 	return nil, nil // not implemented.
 }
 
+// Bonding returns an error as there are no bonds on the local chain.
+func (lc *localChain) Bonding() (chain.BondingHandle, error) {
+	return nil, fmt.Errorf("bonding is not supported by the local chain")
+}
+
 // OnBondedECDSAKeepCreated is a callback that is invoked when an on-chain
 // notification of a new ECDSA keep creation is seen.
 func (lc *localChain) OnBondedECDSAKeepCreated(
@@ -163,7 +270,18 @@ func (lc *localChain) OnBondedECDSAKeepCreated(
 
 	handlerID := generateHandlerID()
 
-	lc.keepCreatedHandlers[handlerID] = handler
+	lc.keepCreatedHandlers[handlerID] = func(keep *localKeep, blockNumber uint64) {
+		keep = lc.bindKeep(keep)
+
+		handler(&chain.BondedECDSAKeepCreatedEvent{
+			Keep:                 keep,
+			MemberIDs:            toIDSlice(keep.members),
+			Application:          localChainID(keep.application),
+			HonestThreshold:      uint64(len(keep.members)),
+			BlockNumber:          blockNumber,
+			ThisOperatorIsMember: keep.unsafeOperatorIndex() > -1,
+		})
+	}
 
 	return subscription.NewEventSubscription(func() {
 		lc.localChainMutex.Lock()
@@ -196,6 +314,11 @@ func (lc *localChain) GetKeepCount() (*big.Int, error) {
 	return big.NewInt(int64(len(lc.keeps))), nil
 }
 
+// KeepFactories returns the local chain as the only keep factory.
+func (lc *localChain) KeepFactories() []chain.BondedECDSAKeepFactory {
+	return []chain.BondedECDSAKeepFactory{lc}
+}
+
 func (lc *localChain) BlockTimestamp(blockNumber *big.Int) (uint64, error) {
 	blockTimestamp, ok := lc.blocksTimestamps.Load(blockNumber.Uint64())
 	if !ok {
@@ -34,7 +34,9 @@ func TestOnBondedECDSAKeepCreated(t *testing.T) {
 
 	keep := localChain.OpenKeep(keepAddress, emptyAddress, []common.Address{})
 	expectedEvent := &chain.BondedECDSAKeepCreatedEvent{
-		Keep: keep,
+		Keep:        keep,
+		MemberIDs:   []chain.ID{},
+		Application: localChainID(common.BigToAddress(tbtcApplicationID)),
 	}
 
 	select {
@@ -99,13 +99,20 @@ func (cl *ChainLogger) KeepAddressCalls() int {
 // TBTCLocalChain represents variables and state relative to the TBTC chain
 type TBTCLocalChain struct {
 	*localChain
+	*tbtcState
+}
 
+// tbtcState is the state of the TBTC application shared by all operator views
+// of the local chain.
+type tbtcState struct {
 	tbtcLocalChainMutex sync.Mutex
 
 	logger *ChainLogger
 
 	alwaysFailingTransactions map[string]bool
 
+	registeredOperators map[common.Address]bool
+
 	deposits                              map[string]*localDeposit
 	depositCreatedHandlers                map[int]func(depositAddress string)
 	depositRegisteredPubkeyHandlers       map[int]func(depositAddress string)
@@ -114,17 +121,12 @@ type TBTCLocalChain struct {
 	depositRedeemedHandlers               map[int]func(depositAddress string)
 }
 
-func (lc *localChain) TBTCApplicationHandle() (chain.TBTCHandle, error) {
-	return NewTBTCLocalChain(context.Background()), nil
-}
-
-// NewTBTCLocalChain creates a new TBTCLocalChain
-func NewTBTCLocalChain(ctx context.Context) *TBTCLocalChain {
-	return &TBTCLocalChain{
-		localChain: Connect(ctx).(*localChain),
-		logger:     &ChainLogger{},
+func newTBTCState() *tbtcState {
+	return &tbtcState{
+		logger: &ChainLogger{},
 
 		alwaysFailingTransactions:             make(map[string]bool),
+		registeredOperators:                   make(map[common.Address]bool),
 		deposits:                              make(map[string]*localDeposit),
 		depositCreatedHandlers:                make(map[int]func(depositAddress string)),
 		depositRegisteredPubkeyHandlers:       make(map[int]func(depositAddress string)),
@@ -134,6 +136,33 @@ func NewTBTCLocalChain(ctx context.Context) *TBTCLocalChain {
 	}
 }
 
+// TBTCApplicationHandle returns the TBTC application of the local chain. The
+// application state is shared by all operator views of the chain.
+func (lc *localChain) TBTCApplicationHandle() (chain.TBTCHandle, error) {
+	lc.localChainMutex.Lock()
+	defer lc.localChainMutex.Unlock()
+
+	if lc.tbtc == nil {
+		lc.tbtc = newTBTCState()
+	}
+
+	return &TBTCLocalChain{
+		localChain: lc,
+		tbtcState:  lc.tbtc,
+	}, nil
+}
+
+// NewTBTCLocalChain creates a new TBTCLocalChain
+func NewTBTCLocalChain(ctx context.Context) *TBTCLocalChain {
+	localChain := Connect(ctx).(*localChain)
+	localChain.tbtc = newTBTCState()
+
+	return &TBTCLocalChain{
+		localChain: localChain,
+		tbtcState:  localChain.tbtc,
+	}
+}
+
 // ID implements the ID method in the chain.TBTCHandle interface.
 func (tlc *TBTCLocalChain) ID() chain.ID {
 	return localChainID(common.BigToAddress(tbtcApplicationID))
@@ -142,31 +171,53 @@ func (tlc *TBTCLocalChain) ID() chain.ID {
 // RegisterAsMemberCandidate registers client as a candidate to be selected
 // to a keep.
 func (tlc *TBTCLocalChain) RegisterAsMemberCandidate() error {
+	tlc.tbtcLocalChainMutex.Lock()
+	defer tlc.tbtcLocalChainMutex.Unlock()
+
+	tlc.registeredOperators[tlc.OperatorAddress()] = true
+
 	return nil
 }
 
 // IsRegisteredForApplication implements the IsRegisteredForApplication method
 // in the chain.TBTCHandle interface.
 func (tlc *TBTCLocalChain) IsRegisteredForApplication() (bool, error) {
-	panic("implement")
+	tlc.tbtcLocalChainMutex.Lock()
+	defer tlc.tbtcLocalChainMutex.Unlock()
+
+	return tlc.registeredOperators[tlc.OperatorAddress()], nil
 }
 
 // IsEligibleForApplication implements the IsEligibleForApplication method in
-// the chain.TBTCHandle interface.
+// the chain.TBTCHandle interface. All operators are eligible on the local
+// chain.
 func (tlc *TBTCLocalChain) IsEligibleForApplication() (bool, error) {
-	panic("implement")
+	return true, nil
 }
 
 // IsStatusUpToDateForApplication implements the IsStatusUpToDateForApplication
-// method in the chain.TBTCHandle interface.
-func (lc *localChain) IsStatusUpToDateForApplication() (bool, error) {
-	panic("implement")
+// method in the chain.TBTCHandle interface. The status of operators never gets
+// out of date on the local chain.
+func (tlc *TBTCLocalChain) IsStatusUpToDateForApplication() (bool, error) {
+	return true, nil
 }
 
 // UpdateStatusForApplication implements the UpdateStatusForApplication method
 // in the chain.TBTCHandle interface.
 func (tlc *TBTCLocalChain) UpdateStatusForApplication() error {
-	panic("implement")
+	return nil
+}
+
+// MinimumBond implements the MinimumBond method in the chain.TBTCHandle
+// interface. There are no bonds on the local chain.
+func (tlc *TBTCLocalChain) MinimumBond() (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+// AvailableUnbondedValue implements the AvailableUnbondedValue method in the
+// chain.TBTCHandle interface. There are no bonds on the local chain.
+func (tlc *TBTCLocalChain) AvailableUnbondedValue() (*big.Int, error) {
+	return big.NewInt(0), nil
 }
 
 // CreateDeposit creates a new deposit by mutating the local TBTC chain
@@ -0,0 +1,78 @@
+package chain
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// DefaultNetworkName is the name of the EVM network the client operates on
+// when no other network is configured.
+const DefaultNetworkName = "ethereum"
+
+// FeeModel determines how transaction fees are set on an EVM network.
+type FeeModel string
+
+const (
+	// FeeModelAuto uses EIP-1559 transactions if the network reports a base
+	// fee in block headers and legacy transactions otherwise.
+	FeeModelAuto FeeModel = ""
+	// FeeModelEIP1559 uses EIP-1559 transactions with a base fee and
+	// a priority fee.
+	FeeModelEIP1559 FeeModel = "eip1559"
+	// FeeModelLegacy uses legacy transactions with a single gas price, even
+	// if the network supports EIP-1559 transactions.
+	FeeModelLegacy FeeModel = "legacy"
+)
+
+var networkNamePattern = regexp.MustCompile("^[a-z][a-z0-9-_]*$")
+
+// NetworkConfig describes the EVM network used as the host chain. Contract
+// bindings are network-agnostic, so any EVM network with the Keep contracts
+// deployed can be used by configuring its name, chain ID and fee model along
+// with the contract addresses.
+type NetworkConfig struct {
+	// Name is the name of the network. It identifies the network in logs and
+	// the transaction ledger, and data of networks other than ethereum is
+	// stored in a subdirectory of the data directory named after the network.
+	// Defaults to ethereum.
+	Name string
+	// ChainID is the expected chain ID of the network. The client refuses to
+	// connect to a node reporting another chain ID. Not verified if zero.
+	ChainID uint64
+	// FeeModel determines how transaction fees are set. Defaults to automatic
+	// detection.
+	FeeModel FeeModel
+}
+
+// NetworkName returns the configured network name or the default one.
+func (nc *NetworkConfig) NetworkName() string {
+	if nc == nil || len(nc.Name) == 0 {
+		return DefaultNetworkName
+	}
+
+	return nc.Name
+}
+
+// Validate checks whether the network configuration is correct.
+func (nc *NetworkConfig) Validate() error {
+	if nc == nil {
+		return nil
+	}
+
+	if len(nc.Name) > 0 && !networkNamePattern.MatchString(nc.Name) {
+		return fmt.Errorf(
+			"invalid network name: [%v]; network name must start with "+
+				"a lowercase letter and then consist solely of lowercase "+
+				"letters, numbers, -, or _",
+			nc.Name,
+		)
+	}
+
+	switch nc.FeeModel {
+	case FeeModelAuto, FeeModelEIP1559, FeeModelLegacy:
+	default:
+		return fmt.Errorf("unsupported fee model: [%v]", nc.FeeModel)
+	}
+
+	return nil
+}
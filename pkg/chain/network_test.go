@@ -0,0 +1,85 @@
+package chain
+
+import "testing"
+
+func TestNetworkConfigNetworkName(t *testing.T) {
+	var tests = map[string]struct {
+		config       *NetworkConfig
+		expectedName string
+	}{
+		"nil config": {
+			config:       nil,
+			expectedName: "ethereum",
+		},
+		"name not set": {
+			config:       &NetworkConfig{ChainID: 1},
+			expectedName: "ethereum",
+		},
+		"name set": {
+			config:       &NetworkConfig{Name: "arbitrum"},
+			expectedName: "arbitrum",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			name := test.config.NetworkName()
+			if name != test.expectedName {
+				t.Errorf(
+					"unexpected name\nexpected: %v\nactual:   %v",
+					test.expectedName,
+					name,
+				)
+			}
+		})
+	}
+}
+
+func TestNetworkConfigValidate(t *testing.T) {
+	var tests = map[string]struct {
+		config        *NetworkConfig
+		expectedError string
+	}{
+		"empty config": {
+			config: &NetworkConfig{},
+		},
+		"valid config": {
+			config: &NetworkConfig{
+				Name:     "polygon-pos",
+				ChainID:  137,
+				FeeModel: FeeModelLegacy,
+			},
+		},
+		"invalid name": {
+			config: &NetworkConfig{Name: "Polygon"},
+			expectedError: "invalid network name: [Polygon]; network name " +
+				"must start with a lowercase letter and then consist solely " +
+				"of lowercase letters, numbers, -, or _",
+		},
+		"unsupported fee model": {
+			config:        &NetworkConfig{FeeModel: "eip2930"},
+			expectedError: "unsupported fee model: [eip2930]",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := test.config.Validate()
+
+			if len(test.expectedError) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: [%v]", err)
+				}
+				return
+			}
+
+			if err == nil || err.Error() != test.expectedError {
+				t.Errorf(
+					"unexpected error\nexpected: %v\nactual:   %v",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
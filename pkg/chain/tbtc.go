@@ -29,11 +29,11 @@ type Deposit interface {
 	Keep(depositAddress string) (BondedECDSAKeepHandle, error)
 
 	// RetrieveSignerPubkey retrieves the signer public key for the
-	// provided deposit.
+	// provided deposit. Submitted with UrgencyNormal.
 	RetrieveSignerPubkey(depositAddress string) error
 
 	// ProvideRedemptionSignature provides the redemption signature for the
-	// provided deposit.
+	// provided deposit. Submitted with UrgencyCritical.
 	ProvideRedemptionSignature(
 		depositAddress string,
 		v uint8,
@@ -42,7 +42,7 @@ type Deposit interface {
 	) error
 
 	// IncreaseRedemptionFee increases the redemption fee for the
-	// provided deposit.
+	// provided deposit. Submitted with UrgencyNormal.
 	IncreaseRedemptionFee(
 		depositAddress string,
 		previousOutputValueBytes [8]uint8,
@@ -50,7 +50,7 @@ type Deposit interface {
 	) error
 
 	// ProvideRedemptionProof provides the redemption proof for the
-	// provided deposit.
+	// provided deposit. Submitted with UrgencyCritical.
 	ProvideRedemptionProof(
 		depositAddress string,
 		txVersion [4]uint8,
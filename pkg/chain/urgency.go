@@ -0,0 +1,92 @@
+package chain
+
+import (
+	"fmt"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum"
+)
+
+// Urgency is the urgency class of a state-changing host chain operation. The
+// urgency class determines the fee ceiling, the priority fee and the
+// resubmission interval used when a transaction is submitted to the host
+// chain, so that e.g. a signature submission racing a redemption deadline is
+// not capped at the same gas price as a routine sortition pool status update.
+type Urgency int
+
+const (
+	// UrgencyLow is the urgency class of maintenance operations that can
+	// wait for lower fees, such as sortition pool registration, status
+	// updates and reward withdrawals.
+	UrgencyLow Urgency = iota
+	// UrgencyNormal is the urgency class of application notifications, such
+	// as retrieving the signer public key to a tBTC deposit.
+	UrgencyNormal
+	// UrgencyCritical is the urgency class of operations which have to land
+	// on-chain before a deadline, such as signature, public key and
+	// redemption proof submissions.
+	UrgencyCritical
+)
+
+// Urgencies lists all supported urgency classes.
+var Urgencies = []Urgency{UrgencyLow, UrgencyNormal, UrgencyCritical}
+
+func (u Urgency) String() string {
+	switch u {
+	case UrgencyLow:
+		return "low"
+	case UrgencyNormal:
+		return "normal"
+	case UrgencyCritical:
+		return "critical"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(u))
+	}
+}
+
+// UrgencyClassConfig stores transaction submission settings for a single
+// urgency class. Properties left unset fall back to the global settings of the
+// host chain configuration.
+type UrgencyClassConfig struct {
+	// MaxGasFeeCap specifies the maximum gas fee cap the client is willing to
+	// pay for a transaction of this urgency class. For chains which don't
+	// support EIP-1559 this value works as the maximum gas price.
+	// A value can be provided in `wei`, `Gwei` or `ether`, e.g. `800.5 Gwei`.
+	MaxGasFeeCap *ethereum.Wei
+
+	// GasTipCap specifies the priority fee set on EIP-1559 transactions of
+	// this urgency class. Ignored for chains which don't support EIP-1559.
+	GasTipCap *ethereum.Wei
+
+	// MiningCheckInterval is the interval in seconds in which the mining
+	// status of a transaction of this urgency class is checked. If the
+	// transaction is not mined within this time, the fee is increased and the
+	// transaction is resubmitted.
+	MiningCheckInterval int
+}
+
+// UrgencyConfig stores transaction submission settings for all urgency
+// classes.
+type UrgencyConfig struct {
+	Critical UrgencyClassConfig
+	Normal   UrgencyClassConfig
+	Low      UrgencyClassConfig
+}
+
+// Class returns settings of the given urgency class. If the class is unknown,
+// empty settings are returned so that global host chain settings are used.
+func (uc *UrgencyConfig) Class(urgency Urgency) UrgencyClassConfig {
+	if uc == nil {
+		return UrgencyClassConfig{}
+	}
+
+	switch urgency {
+	case UrgencyCritical:
+		return uc.Critical
+	case UrgencyNormal:
+		return uc.Normal
+	case UrgencyLow:
+		return uc.Low
+	default:
+		return UrgencyClassConfig{}
+	}
+}
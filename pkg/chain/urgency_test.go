@@ -0,0 +1,86 @@
+package chain
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/keep-network/keep-common/pkg/chain/ethereum"
+)
+
+func TestUrgencyConfigClass(t *testing.T) {
+	config := &UrgencyConfig{
+		Critical: UrgencyClassConfig{
+			MaxGasFeeCap:        ethereum.WrapWei(big.NewInt(1000)),
+			GasTipCap:           ethereum.WrapWei(big.NewInt(10)),
+			MiningCheckInterval: 15,
+		},
+		Low: UrgencyClassConfig{
+			MaxGasFeeCap: ethereum.WrapWei(big.NewInt(100)),
+		},
+	}
+
+	var tests = map[string]struct {
+		config        *UrgencyConfig
+		urgency       Urgency
+		expectedClass UrgencyClassConfig
+	}{
+		"critical": {
+			config:        config,
+			urgency:       UrgencyCritical,
+			expectedClass: config.Critical,
+		},
+		"normal, not configured": {
+			config:        config,
+			urgency:       UrgencyNormal,
+			expectedClass: UrgencyClassConfig{},
+		},
+		"low": {
+			config:        config,
+			urgency:       UrgencyLow,
+			expectedClass: config.Low,
+		},
+		"unknown urgency": {
+			config:        config,
+			urgency:       Urgency(7),
+			expectedClass: UrgencyClassConfig{},
+		},
+		"nil config": {
+			config:        nil,
+			urgency:       UrgencyCritical,
+			expectedClass: UrgencyClassConfig{},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			class := test.config.Class(test.urgency)
+			if !reflect.DeepEqual(test.expectedClass, class) {
+				t.Errorf(
+					"unexpected class\nexpected: %+v\nactual:   %+v",
+					test.expectedClass,
+					class,
+				)
+			}
+		})
+	}
+}
+
+func TestUrgencyString(t *testing.T) {
+	var tests = map[Urgency]string{
+		UrgencyLow:      "low",
+		UrgencyNormal:   "normal",
+		UrgencyCritical: "critical",
+		Urgency(7):      "unknown(7)",
+	}
+
+	for urgency, expected := range tests {
+		if urgency.String() != expected {
+			t.Errorf(
+				"unexpected string\nexpected: %s\nactual:   %s",
+				expected,
+				urgency.String(),
+			)
+		}
+	}
+}
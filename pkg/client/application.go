@@ -0,0 +1,95 @@
+package client
+
+import (
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+)
+
+// sanctionedApplications are applications approved by the operator on a chain
+// mapped by their IDs to their handles in keep factories having signers' pools
+// for them. The operator is registered in signers' pools of the sanctioned
+// applications and generates keys only for keeps opened by them. There are no
+// handles if no factory has a pool for the application.
+type sanctionedApplications map[string][]chain.BondedECDSAKeepApplicationHandle
+
+// newSanctionedApplications looks up handles of applications with the given
+// IDs in all keep factories of the chain. The tBTC application of the chain
+// is always sanctioned if its handle is not nil.
+func newSanctionedApplications(
+	hostChain chain.Handle,
+	applicationIDs []chain.ID,
+	tbtcHandle chain.TBTCHandle,
+) sanctionedApplications {
+	if tbtcHandle != nil {
+		applicationIDs = append(applicationIDs, tbtcHandle.ID())
+	}
+
+	applications := make(sanctionedApplications)
+
+	for _, applicationID := range applicationIDs {
+		if _, ok := applications[applicationID.String()]; ok {
+			continue
+		}
+
+		handles := []chain.BondedECDSAKeepApplicationHandle{}
+		for _, keepFactory := range hostChain.KeepFactories() {
+			application, err := keepFactory.ApplicationHandle(applicationID)
+			if err != nil {
+				logger.Debugf(
+					"sanctioned application [%s] has no signers' pool in "+
+						"one of keep factories on chain [%s]: [%v]",
+					applicationID,
+					hostChain.Name(),
+					err,
+				)
+				continue
+			}
+
+			handles = append(handles, application)
+		}
+
+		if len(handles) == 0 {
+			logger.Errorf(
+				"failed to look up sanctioned application [%s] in any keep "+
+					"factory on chain [%s]; operator WILL NOT BE REGISTERED "+
+					"for the application",
+				applicationID,
+				hostChain.Name(),
+			)
+		}
+
+		applications[applicationID.String()] = handles
+	}
+
+	for applicationID, handles := range applications {
+		logger.Infof(
+			"application [%s] is sanctioned on chain [%s] in [%d] keep factories",
+			applicationID,
+			hostChain.Name(),
+			len(handles),
+		)
+	}
+
+	return applications
+}
+
+// isSanctioned checks whether the application with the given ID has been
+// approved by the operator.
+func (sa sanctionedApplications) isSanctioned(applicationID chain.ID) bool {
+	if applicationID == nil {
+		return false
+	}
+
+	_, ok := sa[applicationID.String()]
+	return ok
+}
+
+// handles returns handles of all sanctioned applications in all keep
+// factories they could be looked up in.
+func (sa sanctionedApplications) handles() []chain.BondedECDSAKeepApplicationHandle {
+	handles := make([]chain.BondedECDSAKeepApplicationHandle, 0, len(sa))
+	for _, applicationHandles := range sa {
+		handles = append(handles, applicationHandles...)
+	}
+
+	return handles
+}
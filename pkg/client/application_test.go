@@ -0,0 +1,175 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	chainLocal "github.com/keep-network/keep-ecdsa/pkg/chain/local"
+	"github.com/keep-network/keep-ecdsa/pkg/client/event"
+)
+
+func TestSanctionedApplications(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	localChain := chainLocal.Connect(ctx)
+
+	tbtcHandle, err := localChain.TBTCApplicationHandle()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sanctionedApplicationID, err := localChain.UnmarshalID(
+		"0x4e09cadc7037afa36603138d1c0b76fe2aa5039c",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unsanctionedApplicationID, err := localChain.UnmarshalID(
+		"0x39122253af729AA39FE886A105B6a580C0d54F80",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	applications := newSanctionedApplications(
+		localChain,
+		[]chain.ID{sanctionedApplicationID},
+		tbtcHandle,
+	)
+
+	var tests = map[string]struct {
+		applicationID      chain.ID
+		expectedSanctioned bool
+	}{
+		"configured application": {
+			applicationID:      sanctionedApplicationID,
+			expectedSanctioned: true,
+		},
+		"tbtc application": {
+			applicationID:      tbtcHandle.ID(),
+			expectedSanctioned: true,
+		},
+		"other application": {
+			applicationID:      unsanctionedApplicationID,
+			expectedSanctioned: false,
+		},
+		"unknown application": {
+			applicationID:      nil,
+			expectedSanctioned: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			isSanctioned := applications.isSanctioned(test.applicationID)
+			if isSanctioned != test.expectedSanctioned {
+				t.Errorf(
+					"unexpected sanction\nexpected: [%v]\nactual:   [%v]",
+					test.expectedSanctioned,
+					isSanctioned,
+				)
+			}
+		})
+	}
+
+	if len(applications.handles()) != 2 {
+		t.Errorf(
+			"unexpected number of handles\nexpected: [%v]\nactual:   [%v]",
+			2,
+			len(applications.handles()),
+		)
+	}
+}
+
+func TestSanctionedApplicationsKeepApplication(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	localChain := chainLocal.Connect(ctx)
+
+	applicationAddress := common.HexToAddress(
+		"0x4e09cadc7037afa36603138d1c0b76fe2aa5039c",
+	)
+	keep := localChain.OpenKeepForApplication(
+		common.HexToAddress("0xa5FA806723A7c7c8523F33c39686f20b52612877"),
+		common.HexToAddress("0x39122253af729AA39FE886A105B6a580C0d54F80"),
+		applicationAddress,
+		[]common.Address{localChain.OperatorAddress()},
+	)
+
+	keepApplication, err := localChain.GetKeepApplication(keep.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if newSanctionedApplications(localChain, nil, nil).isSanctioned(keepApplication) {
+		t.Errorf("keep application should not be sanctioned")
+	}
+
+	applicationID, err := localChain.UnmarshalID(applicationAddress.Hex())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	applications := newSanctionedApplications(
+		localChain,
+		[]chain.ID{applicationID},
+		nil,
+	)
+	if !applications.isSanctioned(keepApplication) {
+		t.Errorf("keep application should be sanctioned")
+	}
+}
+
+func TestGenerateKeyForKeepOfUnsanctionedApplication(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	localChain := chainLocal.Connect(ctx)
+
+	keep := localChain.OpenKeepForApplication(
+		common.HexToAddress("0xa5FA806723A7c7c8523F33c39686f20b52612877"),
+		common.HexToAddress("0x39122253af729AA39FE886A105B6a580C0d54F80"),
+		common.HexToAddress("0x4e09cadc7037afa36603138d1c0b76fe2aa5039c"),
+		[]common.Address{localChain.OperatorAddress()},
+	)
+
+	keepApplication, err := localChain.GetKeepApplication(keep.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, keepsRegistry := newTestKeepsRegistry(localChain)
+	eventDeduplicator := event.NewDeduplicator(keepsRegistry, localChain)
+
+	if !eventDeduplicator.NotifyKeyGenStarted(keep.ID()) {
+		t.Fatal("key generation should be started")
+	}
+
+	generateKeyForKeep(
+		ctx,
+		localChain,
+		newSanctionedApplications(localChain, nil, nil),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		keepsRegistry,
+		nil,
+		eventDeduplicator,
+		keep,
+		keepApplication,
+		nil,
+		0,
+	)
+
+	if !eventDeduplicator.NotifyKeyGenStarted(keep.ID()) {
+		t.Errorf("key generation should be completed for skipped keep")
+	}
+}
@@ -11,12 +11,13 @@ import (
 
 	"github.com/ipfs/go-log"
 
-	"github.com/keep-network/keep-common/pkg/persistence"
 	"github.com/keep-network/keep-common/pkg/subscription"
 	"github.com/keep-network/keep-common/pkg/wrappers"
 	corechain "github.com/keep-network/keep-core/pkg/chain"
 	"github.com/keep-network/keep-core/pkg/net"
 	"github.com/keep-network/keep-core/pkg/operator"
+	"github.com/keep-network/keep-ecdsa/pkg/attribution"
+	"github.com/keep-network/keep-ecdsa/pkg/audit"
 	"github.com/keep-network/keep-ecdsa/pkg/chain"
 	"github.com/keep-network/keep-ecdsa/pkg/chain/bitcoin"
 	"github.com/keep-network/keep-ecdsa/pkg/client/event"
@@ -24,7 +25,9 @@ import (
 	"github.com/keep-network/keep-ecdsa/pkg/extensions/tbtc"
 	"github.com/keep-network/keep-ecdsa/pkg/extensions/tbtc/recovery"
 	"github.com/keep-network/keep-ecdsa/pkg/node"
+	"github.com/keep-network/keep-ecdsa/pkg/policy"
 	"github.com/keep-network/keep-ecdsa/pkg/registry"
+	"github.com/keep-network/keep-ecdsa/pkg/reputation"
 )
 
 var logger = log.Logger("keep-ecdsa")
@@ -53,26 +56,77 @@ func (h *Handle) TSSPreParamsPoolSize() int {
 
 // Initialize initializes the ECDSA client with rules related to events handling.
 // Expects a slice of sanctioned applications selected by the operator for which
-// operator will be registered as a member candidate.
+// operator will be registered as a member candidate. Keys are generated only
+// for keeps opened by the sanctioned applications. The tBTC application of the
+// host chain is always sanctioned. Keeps opened by all keep factories of the
+// host chain are handled.
+//
+// Clients operating on different chains in one process share the pool of TSS
+// pre-parameters. The network provider of a chain operated in the multi-chain
+// mode should scope broadcast channels to the chain so that they do not
+// collide with channels of the other chains. Each client should be given
+// a storage of signers of its chain.
+//
+// Failed key generation and signing attempts are recorded in the attribution
+// store, outcomes of protocols for peers in the reputation store and produced
+// signatures in the audit log, if they are not nil. The stores and the log may
+// be shared between clients of different chains.
+//
+// Archived signers are pruned periodically according to the prune policy if
+// it is not nil. The operator's ETH rewards held by keeps are withdrawn
+// periodically if the reward withdrawal threshold is configured.
 func Initialize(
 	ctx context.Context,
 	operatorPublicKey *operator.PublicKey,
 	hostChain chain.Handle,
+	sanctionedApplicationIDs []chain.ID,
 	networkProvider net.Provider,
-	persistence persistence.Handle,
+	tssParamsPool *node.TSSPreParamsPool,
+	keepsStorage registry.Storage,
+	prunePolicy *registry.PrunePolicy,
 	derivationIndexStorage *recovery.DerivationIndexStorage,
+	attributionStore *attribution.Store,
+	reputationStore *reputation.Store,
+	auditLog *audit.Log,
 	clientConfig *Config,
 	tbtcConfig *tbtc.Config,
 	tssConfig *tss.Config,
 ) *Handle {
-	keepsRegistry := registry.NewKeepsRegistry(
-		persistence,
+	keepsRegistry := registry.NewKeepsRegistryWithStorage(
+		keepsStorage,
 		hostChain.UnmarshalID,
 	)
 
-	tssNode := node.NewNode(hostChain, networkProvider, tssConfig)
+	tbtcApplicationHandle, err := hostChain.TBTCApplicationHandle()
+	if err != nil {
+		// Other sanctioned applications are still operated; only the tBTC
+		// application is not sanctioned and its extension, signing policy
+		// validator and liquidation recovery are disabled.
+		logger.Errorf(
+			"failed to look up on-chain tBTC application information for "+
+				"chain [%s]: [%v]; this client WILL NOT ATTEMPT TO OPERATE "+
+				"on the tBTC system",
+			hostChain.Name(),
+			err,
+		)
+	}
+
+	applications := newSanctionedApplications(
+		hostChain,
+		sanctionedApplicationIDs,
+		tbtcApplicationHandle,
+	)
 
-	tssNode.InitializeTSSPreParamsPool()
+	tssNode := node.NewNode(
+		hostChain,
+		networkProvider,
+		tssConfig,
+		tssParamsPool,
+		attributionStore,
+		reputationStore,
+		auditLog,
+		newSigningPolicy(hostChain, clientConfig, tbtcApplicationHandle),
+	)
 
 	eventDeduplicator := event.NewDeduplicator(
 		keepsRegistry,
@@ -82,6 +136,25 @@ func Initialize(
 	// Load current keeps' signers from storage and register for signing events.
 	keepsRegistry.LoadExistingKeeps()
 
+	if prunePolicy != nil {
+		go pruneArchivesPeriodically(ctx, keepsRegistry, prunePolicy)
+	}
+
+	if threshold := clientConfig.GetRewardWithdrawalThreshold(); threshold != nil {
+		var pruneLog *registry.PruneLog
+		if prunePolicy != nil {
+			pruneLog = prunePolicy.Log
+		}
+
+		go withdrawRewardsPeriodically(
+			ctx,
+			hostChain,
+			keepsRegistry,
+			pruneLog,
+			threshold,
+		)
+	}
+
 	confirmIsInactive := func(keep chain.BondedECDSAKeepHandle) bool {
 		currentBlock, err := hostChain.BlockCounter().CurrentBlock()
 		if err != nil {
@@ -109,16 +182,8 @@ func Initialize(
 
 	blockCounter := hostChain.BlockCounter()
 
-	tbtcApplicationHandle, err := hostChain.TBTCApplicationHandle()
-	if err != nil {
-		logger.Errorf(
-			"failed to look up on-chain tBTC application information for "+
-				"chain [%s]; this client WILL NOT ATTEMPT TO OPERATE "+
-				"on the tBTC system",
-			hostChain.Name(),
-		)
-	} else {
-		go checkStatusAndRegisterForApplication(ctx, blockCounter, tbtcApplicationHandle)
+	for _, application := range applications.handles() {
+		go checkStatusAndRegisterForApplication(ctx, blockCounter, application)
 	}
 
 	for _, keepID := range keepsRegistry.GetKeepsIDs() {
@@ -155,7 +220,7 @@ func Initialize(
 						"confirmed that keep [%s] is no longer active; archiving",
 						keep.ID(),
 					)
-					keepsRegistry.UnregisterKeep(keepID)
+					keepsRegistry.UnregisterKeep(keepID, registry.ArchiveReasonInactive)
 					return
 				}
 				logger.Warningf("keep [%s] is still active", keep.ID())
@@ -218,22 +283,26 @@ func Initialize(
 		}(keepID)
 	}
 
-	go checkAwaitingKeyGeneration(
-		ctx,
-		hostChain,
-		tbtcApplicationHandle,
-		networkProvider,
-		clientConfig,
-		tbtcConfig,
-		tssNode,
-		operatorPublicKey,
-		keepsRegistry,
-		derivationIndexStorage,
-		eventDeduplicator,
-	)
+	for _, keepFactory := range hostChain.KeepFactories() {
+		go checkAwaitingKeyGeneration(
+			ctx,
+			hostChain,
+			keepFactory,
+			applications,
+			tbtcApplicationHandle,
+			networkProvider,
+			clientConfig,
+			tbtcConfig,
+			tssNode,
+			operatorPublicKey,
+			keepsRegistry,
+			derivationIndexStorage,
+			eventDeduplicator,
+		)
+	}
 
 	// Watch for new keeps creation.
-	_ = hostChain.OnBondedECDSAKeepCreated(func(event *chain.BondedECDSAKeepCreatedEvent) {
+	onKeepCreated := func(event *chain.BondedECDSAKeepCreatedEvent) {
 		logger.Infof(
 			"new keep [%s] created with members: [%s] at block [%d]",
 			event.Keep.ID(),
@@ -243,6 +312,16 @@ func Initialize(
 
 		if event.ThisOperatorIsMember {
 			go func(event *chain.BondedECDSAKeepCreatedEvent) {
+				keep, err := hostChain.GetKeepWithID(event.Keep.ID())
+				if err != nil {
+					logger.Errorf(
+						"failed to resolve keep with address [%v] for created event: [%v]",
+						event.Keep.ID(),
+						err,
+					)
+					return
+				}
+
 				if shouldHandle := eventDeduplicator.NotifyKeyGenStarted(event.Keep.ID()); !shouldHandle {
 					logger.Infof(
 						"key generation request for keep [%s] already handled",
@@ -253,20 +332,11 @@ func Initialize(
 					// in case this event is a duplicate.
 					return
 				}
-				defer eventDeduplicator.NotifyKeyGenCompleted(event.Keep.ID())
-
-				keep, err := hostChain.GetKeepWithID(event.Keep.ID())
-				if err != nil {
-					logger.Errorf(
-						"failed to resolve keep with address [%v] for created event: [%v]",
-						keep.ID(),
-						err,
-					)
-				}
 
 				generateKeyForKeep(
 					ctx,
 					hostChain,
+					applications,
 					tbtcApplicationHandle,
 					networkProvider,
 					clientConfig,
@@ -277,6 +347,7 @@ func Initialize(
 					derivationIndexStorage,
 					eventDeduplicator,
 					keep,
+					event.Application,
 					event.MemberIDs,
 					event.HonestThreshold,
 				)
@@ -287,7 +358,11 @@ func Initialize(
 				event.Keep.ID(),
 			)
 		}
-	})
+	}
+
+	for _, keepFactory := range hostChain.KeepFactories() {
+		_ = keepFactory.OnBondedECDSAKeepCreated(onKeepCreated)
+	}
 
 	initializeExtensions(
 		ctx,
@@ -324,6 +399,8 @@ func initializeExtensions(
 func checkAwaitingKeyGeneration(
 	ctx context.Context,
 	hostChain chain.Handle,
+	keepFactory chain.BondedECDSAKeepFactory,
+	applications sanctionedApplications,
 	tbtcHandle chain.TBTCHandle,
 	networkProvider net.Provider,
 	clientConfig *Config,
@@ -334,7 +411,7 @@ func checkAwaitingKeyGeneration(
 	derivationIndexStorage *recovery.DerivationIndexStorage,
 	eventDeduplicator *event.Deduplicator,
 ) {
-	keepCount, err := hostChain.GetKeepCount()
+	keepCount, err := keepFactory.GetKeepCount()
 	if err != nil {
 		logger.Warningf("could not get keep count: [%v]", err)
 		return
@@ -354,7 +431,7 @@ func checkAwaitingKeyGeneration(
 			keepIndex.String(),
 		)
 
-		keep, err := hostChain.GetKeepAtIndex(keepIndex)
+		keep, err := keepFactory.GetKeepAtIndex(keepIndex)
 		if err != nil {
 			logger.Warningf(
 				"could not get keep at index [%v]: [%v]",
@@ -388,6 +465,8 @@ func checkAwaitingKeyGeneration(
 		err = checkAwaitingKeyGenerationForKeep(
 			ctx,
 			hostChain,
+			keepFactory,
+			applications,
 			tbtcHandle,
 			networkProvider,
 			clientConfig,
@@ -412,6 +491,8 @@ func checkAwaitingKeyGeneration(
 func checkAwaitingKeyGenerationForKeep(
 	ctx context.Context,
 	hostChain chain.Handle,
+	keepFactory chain.BondedECDSAKeepFactory,
+	applications sanctionedApplications,
 	tbtcHandle chain.TBTCHandle,
 	networkProvider net.Provider,
 	clientConfig *Config,
@@ -464,9 +545,25 @@ func checkAwaitingKeyGenerationForKeep(
 	}
 
 	if isThisOperatorMember {
+		application, err := keepFactory.GetKeepApplication(keep.ID())
+		if err != nil {
+			return err
+		}
+
+		// The keep created event for the same keep may be handled at the
+		// same time, if the keep has been opened during the client startup.
+		if shouldHandle := eventDeduplicator.NotifyKeyGenStarted(keep.ID()); !shouldHandle {
+			logger.Infof(
+				"key generation request for keep [%s] already handled",
+				keep.ID(),
+			)
+			return nil
+		}
+
 		go generateKeyForKeep(
 			ctx,
 			hostChain,
+			applications,
 			tbtcHandle,
 			networkProvider,
 			clientConfig,
@@ -477,6 +574,7 @@ func checkAwaitingKeyGenerationForKeep(
 			derivationIndexStorage,
 			eventDeduplicator,
 			keep,
+			application,
 			members,
 			honestThreshold,
 		)
@@ -485,9 +583,14 @@ func checkAwaitingKeyGenerationForKeep(
 	return nil
 }
 
+// generateKeyForKeep generates the signer of the keep and starts monitoring
+// the keep's events. Keeps opened by applications not sanctioned by the
+// operator are skipped. The key generation must have been started with the
+// event deduplicator; it is completed on every exit path.
 func generateKeyForKeep(
 	ctx context.Context,
 	hostChain chain.Handle,
+	applications sanctionedApplications,
 	tbtcHandle chain.TBTCHandle,
 	networkProvider net.Provider,
 	clientConfig *Config,
@@ -498,9 +601,24 @@ func generateKeyForKeep(
 	derivationIndexStorage *recovery.DerivationIndexStorage,
 	eventDeduplicator *event.Deduplicator,
 	keep chain.BondedECDSAKeepHandle,
+	application chain.ID,
 	members []chain.ID,
 	honestThreshold uint64,
 ) {
+	defer eventDeduplicator.NotifyKeyGenCompleted(keep.ID())
+
+	if !applications.isSanctioned(application) {
+		logger.Errorf(
+			"keep [%s] has been opened by application [%s] not sanctioned "+
+				"by the operator; skipping key generation; PLEASE INSPECT "+
+				"WHY THE OPERATOR HAS BEEN SELECTED TO KEEP [%s]",
+			keep.ID(),
+			application,
+			keep.ID(),
+		)
+		return
+	}
+
 	if len(members) < 2 {
 		// TODO: #408 Implement single signer support.
 		logger.Errorf(
@@ -725,13 +843,28 @@ func monitorSigningRequests(
 							ctx,
 							keep,
 							signer,
-							event.Digest,
+							event,
 						); err != nil {
+							if errors.Is(err, policy.ErrVetoed) {
+								logger.Warningf(
+									"refused to sign digest [%+x] for keep [%s]: [%v]",
+									event.Digest,
+									keep.ID(),
+									err,
+								)
+								return nil
+							}
+
 							logger.Errorf(
 								"signature calculation failed for keep [%s]: [%v]",
 								keep.ID(),
 								err,
 							)
+
+							// Retry if the signing policy could not validate the request yet.
+							if errors.Is(err, policy.ErrValidationFailed) {
+								return err
+							}
 						}
 
 						return err
@@ -862,13 +995,31 @@ func checkAwaitingSignature(
 					ctx,
 					keep,
 					signer,
-					latestDigest,
+					&chain.SignatureRequestedEvent{
+						Digest:      latestDigest,
+						BlockNumber: startBlock,
+					},
 				); err != nil {
+					if errors.Is(err, policy.ErrVetoed) {
+						logger.Warningf(
+							"refused to sign digest [%+x] for keep [%s]: [%v]",
+							latestDigest,
+							keep.ID(),
+							err,
+						)
+						return nil
+					}
+
 					logger.Errorf(
 						"signature calculation failed for keep [%s]: [%v]",
 						keep.ID(),
 						err,
 					)
+
+					// Retry if the signing policy could not validate the request yet.
+					if errors.Is(err, policy.ErrValidationFailed) {
+						return err
+					}
 				}
 
 				return err
@@ -937,7 +1088,7 @@ func monitorKeepClosedEvents(
 
 				// TODO: Rework how unregistering works in the context of
 				// completing/confirming btc recovery on the bitcoin chain.
-				keepsRegistry.UnregisterKeep(keep.ID())
+				keepsRegistry.UnregisterKeep(keep.ID(), registry.ArchiveReasonClosed)
 				keepClosed <- event
 			}(event)
 		},
@@ -989,6 +1140,15 @@ func monitorKeepTerminatedEvent(
 			)
 
 			go func(event *chain.KeepTerminatedEvent) {
+				if tbtcHandle == nil {
+					logger.Errorf(
+						"tBTC application is not available; liquidation "+
+							"recovery for keep [%s] is skipped",
+						keep.ID(),
+					)
+					return
+				}
+
 				err := tbtcConfig.Bitcoin.Validate()
 				if err != nil {
 					if (bitcoin.Config{}) == tbtcConfig.Bitcoin {
@@ -998,6 +1158,7 @@ func monitorKeepTerminatedEvent(
 					}
 					return
 				}
+				attemptCounter := 0
 				err = wrappers.DoWithDefaultRetry(
 					tbtcConfig.GetLiquidationRecoveryTimeout(),
 					func(ctx context.Context) error {
@@ -1037,8 +1198,10 @@ func monitorKeepTerminatedEvent(
 
 						bitcoinHandle := bitcoin.Connect(tbtcConfig.Bitcoin.ElectrsURLWithDefault())
 
+						attemptCounter++
 						if err := handleLiquidationRecovery(
 							ctx,
+							attemptCounter,
 							hostChain,
 							tbtcHandle,
 							bitcoinHandle,
@@ -1062,6 +1225,13 @@ func monitorKeepTerminatedEvent(
 								return nil
 							}
 
+							// If peer members already execute a later
+							// attempt, continue with their attempt.
+							var sessionBehindError *tss.SessionBehindError
+							if errors.As(err, &sessionBehindError) {
+								attemptCounter = sessionBehindError.Attempt - 1
+							}
+
 							logger.Errorf(
 								"failed to handle liquidation recovery for keep [%s]: [%v]",
 								keep.ID(),
@@ -1075,7 +1245,7 @@ func monitorKeepTerminatedEvent(
 							keep.ID(),
 						)
 
-						keepsRegistry.UnregisterKeep(keep.ID())
+						keepsRegistry.UnregisterKeep(keep.ID(), registry.ArchiveReasonTerminated)
 						keepTerminated <- event
 
 						return nil
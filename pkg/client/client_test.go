@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	chainLocal "github.com/keep-network/keep-ecdsa/pkg/chain/local"
+	"github.com/keep-network/keep-ecdsa/pkg/client/event"
+)
+
+func TestCheckAwaitingKeyGenerationForKeep(t *testing.T) {
+	var tests = map[string]struct {
+		keyGenStartedByEvent bool
+		// expectKeyGenCompleted is true if key generation is started by the
+		// check, which completes it once the keep of an unsanctioned
+		// application is skipped.
+		expectKeyGenCompleted bool
+	}{
+		"key generation started by keep created event": {
+			keyGenStartedByEvent:  true,
+			expectKeyGenCompleted: false,
+		},
+		"key generation not started": {
+			keyGenStartedByEvent:  false,
+			expectKeyGenCompleted: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancelCtx := context.WithCancel(context.Background())
+			defer cancelCtx()
+
+			localChain := chainLocal.Connect(ctx)
+
+			// The keep is opened by an application not sanctioned by the
+			// operator, so key generation started by the check returns
+			// right away.
+			keep := localChain.OpenKeepForApplication(
+				common.HexToAddress("0xa5FA806723A7c7c8523F33c39686f20b52612877"),
+				common.HexToAddress("0x39122253af729AA39FE886A105B6a580C0d54F80"),
+				common.HexToAddress("0x4e09cadc7037afa36603138d1c0b76fe2aa5039c"),
+				[]common.Address{localChain.OperatorAddress()},
+			)
+
+			_, keepsRegistry := newTestKeepsRegistry(localChain)
+			eventDeduplicator := event.NewDeduplicator(keepsRegistry, localChain)
+
+			// The keep created event handler starts key generation for
+			// a keep opened during the client startup.
+			if test.keyGenStartedByEvent &&
+				!eventDeduplicator.NotifyKeyGenStarted(keep.ID()) {
+				t.Fatal("key generation should be started")
+			}
+
+			err := checkAwaitingKeyGenerationForKeep(
+				ctx,
+				localChain,
+				localChain,
+				newSanctionedApplications(localChain, nil, nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				keepsRegistry,
+				nil,
+				eventDeduplicator,
+				keep,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Key generation started twice for the same keep would be
+			// completed by the duplicate while the first one is in progress.
+			time.Sleep(100 * time.Millisecond)
+
+			keyGenCompleted := eventDeduplicator.NotifyKeyGenStarted(keep.ID())
+			if keyGenCompleted != test.expectKeyGenCompleted {
+				t.Errorf(
+					"unexpected key generation completion\n"+
+						"expected: [%v]\nactual:   [%v]",
+					test.expectKeyGenCompleted,
+					keyGenCompleted,
+				)
+			}
+		})
+	}
+}
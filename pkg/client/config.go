@@ -1,9 +1,12 @@
 package client
 
 import (
+	"math/big"
 	"time"
 
+	"github.com/keep-network/keep-common/pkg/chain/ethereum"
 	configtime "github.com/keep-network/keep-ecdsa/config/time"
+	"github.com/keep-network/keep-ecdsa/pkg/policy"
 )
 
 const (
@@ -32,6 +35,17 @@ type Config struct {
 	// Timeout for key generation and signature calculation.
 	KeyGenerationTimeout configtime.Duration
 	SigningTimeout       configtime.Duration
+
+	// Determines what happens with signature requests vetoed by the signing
+	// policy: they are refused in the `enforce` mode and only logged in the
+	// `audit` mode. The audit mode is used if a value is not set.
+	SigningPolicy string
+
+	// Enables periodic withdrawal of the operator's ETH rewards held by keeps
+	// the operator has been a member of. Rewards lower than the threshold,
+	// and rewards not exceeding the estimated withdrawal fee, are left in
+	// keeps. Rewards are not withdrawn by the client if a value is not set.
+	RewardWithdrawalThreshold *ethereum.Wei
 }
 
 // GetAwaitingKeyGenerationLookback returns a look-back period to check if
@@ -67,3 +81,20 @@ func (c *Config) GetSigningTimeout() time.Duration {
 
 	return timeout
 }
+
+// GetSigningPolicyMode returns the signing policy mode. If a value is not set
+// it returns the audit mode.
+func (c *Config) GetSigningPolicyMode() (policy.Mode, error) {
+	return policy.ParseMode(c.SigningPolicy)
+}
+
+// GetRewardWithdrawalThreshold returns the minimum reward withdrawn by the
+// client from a keep. If a value is not set it returns nil, meaning rewards
+// are not withdrawn.
+func (c *Config) GetRewardWithdrawalThreshold() *big.Int {
+	if c.RewardWithdrawalThreshold == nil {
+		return nil
+	}
+
+	return c.RewardWithdrawalThreshold.Int
+}
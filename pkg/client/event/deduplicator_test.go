@@ -137,6 +137,44 @@ func TestDoNotSignIfCurrentlySigning(t *testing.T) {
 	}
 }
 
+func TestDoSignAnotherDigestIfCurrentlySigning(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	deduplicator, _, chain := newDeduplicator(ctx)
+
+	keep := chain.OpenKeep(keepAddress1, emptyAddress, []common.Address{})
+
+	var keepPublicKey [64]byte
+	rand.Read(keepPublicKey[:])
+
+	err := keep.SubmitKeepPublicKey(keepPublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	anotherDigest := sha256.Sum256([]byte("Much to learn you still have."))
+
+	for _, digest := range [][32]byte{digest, anotherDigest} {
+		err = chain.RequestSignature(keepAddress1, digest)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		canSign, err := deduplicator.NotifySigningStarted(
+			signStateConfirmTimeout,
+			keep,
+			digest,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !canSign {
+			t.Errorf("should be allowed to sign digest [%x]", digest)
+		}
+	}
+}
+
 func TestDoNotSignIfNotAwaitingASignature(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -56,7 +56,8 @@ func (uet *uniqueEventTrack) remove(keepID chain.ID) {
 // is not duplicated, e.g. when the client receives the same event multiple times.
 // When event is received, it should be noted in this struct. When the signature
 // calculation process completes (no matter if it succeeded or failed), it should
-// be removed from this struct.
+// be removed from this struct. Signatures are tracked per digest so signatures
+// over different digests can be calculated for the same keep concurrently.
 type requestedSignaturesTrack struct {
 	data  map[string]map[string]bool // <keep, <digest, bool>>
 	mutex sync.Mutex
@@ -6,8 +6,10 @@ import (
 
 	"github.com/keep-network/keep-core/pkg/net"
 	"github.com/keep-network/keep-core/pkg/operator"
+	"github.com/keep-network/keep-ecdsa/pkg/audit"
 	"github.com/keep-network/keep-ecdsa/pkg/chain"
 	"github.com/keep-network/keep-ecdsa/pkg/chain/bitcoin"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
 	"github.com/keep-network/keep-ecdsa/pkg/extensions/tbtc"
 	"github.com/keep-network/keep-ecdsa/pkg/extensions/tbtc/recovery"
@@ -23,6 +25,7 @@ const (
 // TODO: Should this function be moved to `node` package under tss.Node?
 func handleLiquidationRecovery(
 	ctx context.Context,
+	attempt int,
 	hostChain chain.Handle,
 	tbtcHandle chain.TBTCHandle,
 	bitcoinHandle bitcoin.Handle,
@@ -54,6 +57,8 @@ func handleLiquidationRecovery(
 		ctx,
 		operatorPublicKey,
 		keep.ID(),
+		tss.LiquidationRecoveryProtocol,
+		attempt,
 		members,
 	)
 	if err != nil {
@@ -115,6 +120,7 @@ func handleLiquidationRecovery(
 		beneficiaryAddress,
 		vbyteFee,
 		keep.ID().String(),
+		attempt,
 		memberID,
 		memberIDs,
 		uint(len(memberIDs)-1),
@@ -143,10 +149,20 @@ func handleLiquidationRecovery(
 		keep.ID(),
 		btcAddresses,
 		maxFeePerVByte,
+		func(sighash [32]byte, signature *ecdsa.Signature) {
+			tssNode.RecordSignature(
+				audit.SourceLiquidationRecovery,
+				keep.ID(),
+				signer,
+				&chain.SignatureRequestedEvent{Digest: sighash},
+				signature,
+			)
+		},
 	)
 
 	recoveryTransactionHex, err := recovery.BuildBitcoinTransaction(
 		ctx,
+		attempt,
 		networkProvider,
 		hostChain,
 		fundingInfo,
@@ -154,6 +170,15 @@ func handleLiquidationRecovery(
 		chainParams,
 		btcAddresses,
 		maxFeePerVByte,
+		func(sighash [32]byte, signature *ecdsa.Signature) {
+			tssNode.RecordSignature(
+				audit.SourceLiquidationRecovery,
+				keep.ID(),
+				signer,
+				&chain.SignatureRequestedEvent{Digest: sighash},
+				signature,
+			)
+		},
 	)
 	if err != nil {
 		return fmt.Errorf(
@@ -257,7 +257,7 @@ func TestHandleLiquidationRecovery(t *testing.T) {
 
 						networkProvider := networkProviders[memberID.String()]
 
-						tssNode := node.NewNode(localChain, networkProvider, &tss.Config{})
+						tssNode := node.NewNode(localChain, networkProvider, &tss.Config{}, nil, nil, nil, nil, nil)
 
 						signer, ok := signers[memberID.String()]
 						if !ok {
@@ -272,6 +272,7 @@ func TestHandleLiquidationRecovery(t *testing.T) {
 
 						if err := handleLiquidationRecovery(
 							ctx,
+							1,
 							localChain,
 							tbtcHandle,
 							bitcoinHandles[index],
@@ -604,12 +605,14 @@ func initializeSigners(
 				signer, err := tss.GenerateThresholdSigner(
 					ctx,
 					keepAddress.String(),
+					1,
 					memberID,
 					groupMemberIDs,
 					uint(len(groupMemberIDs)-1),
 					networkProvider,
 					pubKeyToAddressFn,
 					params.NewBox(&testData[index].LocalPreParams),
+					nil,
 				)
 				if err != nil {
 					errChan <- err
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/extensions/tbtc"
+	"github.com/keep-network/keep-ecdsa/pkg/policy"
+)
+
+// newSigningPolicy creates the signing policy validating signature requests
+// of keeps opened by applications the client operates on. If the configured
+// mode is invalid, the policy is enforced.
+func newSigningPolicy(
+	hostChain chain.Handle,
+	clientConfig *Config,
+	tbtcHandle chain.TBTCHandle,
+) *policy.Engine {
+	mode, err := clientConfig.GetSigningPolicyMode()
+	if err != nil {
+		logger.Errorf(
+			"invalid signing policy configuration for chain [%s]: [%v]; "+
+				"enforcing the policy",
+			hostChain.Name(),
+			err,
+		)
+		mode = policy.ModeEnforce
+	}
+
+	validators := []policy.Validator{}
+	if tbtcHandle != nil {
+		validators = append(
+			validators,
+			tbtc.NewRedemptionValidator(tbtcHandle, keepApplicationLookup(hostChain)),
+		)
+	}
+
+	logger.Infof(
+		"signing policy for chain [%s] in [%s] mode with [%d] validators",
+		hostChain.Name(),
+		mode,
+		len(validators),
+	)
+
+	return policy.NewEngine(mode, validators...)
+}
+
+// keepApplicationLookup returns a function looking up the application which
+// opened the keep with the given ID in all keep factories of the host chain.
+func keepApplicationLookup(
+	hostChain chain.Handle,
+) func(keepID chain.ID) (chain.ID, error) {
+	return func(keepID chain.ID) (chain.ID, error) {
+		errs := []string{}
+		for _, keepFactory := range hostChain.KeepFactories() {
+			application, err := keepFactory.GetKeepApplication(keepID)
+			if err == nil {
+				return application, nil
+			}
+			errs = append(errs, err.Error())
+		}
+
+		return nil, fmt.Errorf(
+			"no keep factory knows the application of keep [%s]: [%s]",
+			keepID,
+			strings.Join(errs, "; "),
+		)
+	}
+}
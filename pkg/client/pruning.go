@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+)
+
+// archivePruningInterval is the interval in which the client destroys
+// archived signers whose retention period has passed.
+const archivePruningInterval = 24 * time.Hour
+
+// pruneArchivesPeriodically prunes archived signers of the registry according
+// to the policy right away and then in the archive pruning interval until
+// the context is done.
+func pruneArchivesPeriodically(
+	ctx context.Context,
+	keepsRegistry *registry.Keeps,
+	policy *registry.PrunePolicy,
+) {
+	ticker := time.NewTicker(archivePruningInterval)
+	defer ticker.Stop()
+
+	for {
+		pruned, errs := keepsRegistry.PruneArchives(policy)
+
+		for _, archivedKeep := range pruned {
+			logger.Infof(
+				"destroyed archived signers of keep [%s] archived as [%s]",
+				archivedKeep.KeepID,
+				archivedKeep.Reason,
+			)
+		}
+
+		for _, err := range errs {
+			logger.Errorf("failed to prune archived signers: [%v]", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
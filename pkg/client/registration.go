@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/keep-network/keep-common/pkg/chain/ethlike"
@@ -120,6 +121,61 @@ func registerAsMemberCandidate(
 	registerAsMemberCandidateWhenEligible(parentCtx, blockCounter, application)
 }
 
+// reportMissingUnbondedValue logs how much unbonded value the operator is
+// missing to meet the minimum bond of the signers' pool of the given
+// application. Both values are read on each call, as the application may
+// change the minimum bond of its pool and the operator may deposit or lose
+// unbonded value. If the operator's available unbonded value meets the
+// minimum bond, the operator is not eligible for other reasons, e.g.
+// insufficient stake or missing authorizations, and a warning pointing to
+// them is logged instead.
+func reportMissingUnbondedValue(
+	application chain.BondedECDSAKeepApplicationHandle,
+) {
+	minimumBond, err := application.MinimumBond()
+	if err != nil {
+		logger.Warningf(
+			"failed to get minimum bond for application [%s]: [%v]",
+			application.ID(),
+			err,
+		)
+		return
+	}
+
+	availableUnbondedValue, err := application.AvailableUnbondedValue()
+	if err != nil {
+		logger.Warningf(
+			"failed to get available unbonded value for application [%s]: [%v]",
+			application.ID(),
+			err,
+		)
+		return
+	}
+
+	if availableUnbondedValue.Cmp(minimumBond) >= 0 {
+		logger.Warningf(
+			"operator is not eligible for application [%s] although its "+
+				"available unbonded value [%v] wei meets the minimum bond "+
+				"[%v] wei; please inspect operator's stake and authorizations",
+			application.ID(),
+			availableUnbondedValue,
+			minimumBond,
+		)
+		return
+	}
+
+	logger.Warningf(
+		"operator is not eligible for application [%s]; available unbonded "+
+			"value [%v] wei is [%v] wei short of the minimum bond [%v] wei; "+
+			"please deposit the missing value or authorize the application's "+
+			"signers' pool if the value is already deposited",
+		application.ID(),
+		availableUnbondedValue,
+		new(big.Int).Sub(minimumBond, availableUnbondedValue),
+		minimumBond,
+	)
+}
+
 // registerAsMemberCandidateWhenEligible for each new block checks the operator's
 // eligibility to be registered as a keep member candidate for the application.
 // As soon as the operator becomes eligible, function triggers the registration.
@@ -151,10 +207,7 @@ func registerAsMemberCandidateWhenEligible(
 			if !isEligible {
 				// if the operator is not yet eligible wait for the next
 				// block and execute the check again
-				logger.Warningf(
-					"operator is not eligible for application [%s]",
-					application.ID(),
-				)
+				reportMissingUnbondedValue(application)
 				time.Sleep(eligibilityRetryDelay) // TODO: #413 Replace with backoff.
 				continue
 			}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+	"github.com/keep-network/keep-ecdsa/pkg/rewards"
+)
+
+// rewardWithdrawalInterval is the interval in which the client withdraws
+// the operator's ETH rewards held by keeps.
+const rewardWithdrawalInterval = 24 * time.Hour
+
+// withdrawRewardsPeriodically withdraws the operator's ETH rewards held by
+// keeps of the registry, including archived keeps and keeps recorded in the
+// prune log if it is not nil, right away and then in the reward withdrawal
+// interval until the context is done. Rewards lower than the threshold or not
+// exceeding the estimated fee are not withdrawn.
+func withdrawRewardsPeriodically(
+	ctx context.Context,
+	hostChain chain.Handle,
+	keepsRegistry *registry.Keeps,
+	pruneLog *registry.PruneLog,
+	threshold *big.Int,
+) {
+	ticker := time.NewTicker(rewardWithdrawalInterval)
+	defer ticker.Stop()
+
+	for {
+		keepIDs, errs := rewards.KeepIDs(hostChain, keepsRegistry, pruneLog)
+		for _, err := range errs {
+			logger.Errorf("failed to look up keeps holding rewards: [%v]", err)
+		}
+
+		results, errs := rewards.Withdraw(hostChain, keepIDs, threshold, false)
+
+		for _, result := range results {
+			if result.Outcome != rewards.Withdrawn {
+				continue
+			}
+
+			logger.Infof(
+				"withdrawing reward [%v] from keep [%s] with estimated fee [%v]",
+				result.Balance,
+				result.KeepID,
+				result.Fee,
+			)
+		}
+
+		for _, err := range errs {
+			logger.Errorf("failed to withdraw reward: [%v]", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
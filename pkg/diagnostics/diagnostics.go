@@ -0,0 +1,55 @@
+// Package diagnostics registers diagnostics sources specific to the ECDSA
+// client, complementing sources provided by keep-core.
+package diagnostics
+
+import (
+	"encoding/json"
+
+	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-common/pkg/diagnostics"
+	"github.com/keep-network/keep-ecdsa/pkg/reputation"
+)
+
+var logger = log.Logger("keep-diagnostics")
+
+// RegisterPeerReputationSource registers the diagnostics source providing
+// information about reputation of peer operators the client executed
+// protocols with.
+func RegisterPeerReputationSource(
+	registry *diagnostics.Registry,
+	reputationStore *reputation.Store,
+) {
+	registry.RegisterSource("peer_reputation", func() string {
+		peers := reputationStore.Peers()
+
+		peersList := make([]map[string]interface{}, len(peers))
+		for i, peer := range peers {
+			peersList[i] = map[string]interface{}{
+				"operator":           peer.Operator,
+				"chain":              peer.Chain,
+				"participations":     peer.Participations,
+				"average_latency_ms": peer.AverageLatency().Milliseconds(),
+				"announce_timeouts":  peer.AnnounceTimeouts,
+				"readiness_timeouts": peer.ReadinessTimeouts,
+				"protocol_timeouts":  peer.ProtocolTimeouts,
+				"bad_messages":       peer.BadMessages,
+				"unreachable":        peer.Unreachable,
+				"failure_rate":       peer.FailureRate(),
+				"unreliable":         peer.IsUnreliable(),
+				"last_seen":          peer.LastSeen,
+				"last_failure":       peer.LastFailure,
+			}
+		}
+
+		bytes, err := json.Marshal(peersList)
+		if err != nil {
+			logger.Errorf(
+				"error on serializing peer reputation to JSON: [%v]",
+				err,
+			)
+			return ""
+		}
+
+		return string(bytes)
+	})
+}
@@ -4,33 +4,132 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// Names of protocol stages, as reported in protocol errors and to peer
+// observers.
+const (
+	AnnounceStage      = "announce"
+	ReadinessStage     = "readiness signaling"
+	KeyGenerationStage = "key generation"
+	SigningStage       = "signing"
 )
 
-type timeoutError struct {
-	timeout   time.Duration
-	stage     string
-	memberIDs []MemberID
+// ProtocolError is returned when the execution of a protocol stage failed. It
+// attributes the failure to group members. Culprits are members identified by
+// the protocol as misbehaving, e.g. sending messages with invalid proofs.
+// Missing members are members whose messages for the round have not been
+// received before the stage timed out.
+type ProtocolError struct {
+	// Stage is the protocol stage which failed, e.g. key generation.
+	Stage string
+	// Round is the protocol round the stage failed in. It is zero if the
+	// stage failed before the first round has started.
+	Round int
+	// Timeout is the timeout which has been exceeded by the stage. It is zero
+	// if the stage has not timed out.
+	Timeout time.Duration
+
+	Culprits       []MemberID
+	MissingMembers []MemberID
+
+	cause error
 }
 
-func (t timeoutError) Error() string {
-	if len(t.memberIDs) > 0 {
-		stringIDs := []string{}
+func (pe *ProtocolError) Error() string {
+	stage := fmt.Sprintf("stage [%s]", pe.Stage)
+	if pe.Round > 0 {
+		stage = fmt.Sprintf("%s in round [%d]", stage, pe.Round)
+	}
 
-		for _, memberID := range t.memberIDs {
-			stringIDs = append(stringIDs, memberID.String())
+	if pe.Timeout > 0 {
+		if len(pe.MissingMembers) > 0 {
+			return fmt.Sprintf(
+				"timeout [%s] exceeded on %s - still waiting for members: [%s]",
+				pe.Timeout,
+				stage,
+				joinMemberIDs(pe.MissingMembers),
+			)
 		}
 
-		return fmt.Sprintf(
-			"timeout [%s] exceeded on stage [%s] - still waiting for members: [%s]",
-			t.timeout,
-			t.stage,
-			strings.Join(stringIDs, ", "),
-		)
+		return fmt.Sprintf("timeout [%s] exceeded on %s", pe.Timeout, stage)
 	}
 
 	return fmt.Sprintf(
-		"timeout [%s] exceeded on stage [%s]",
-		t.timeout,
-		t.stage,
+		"%s failed because of members [%s]: [%v]",
+		stage,
+		joinMemberIDs(pe.Culprits),
+		pe.cause,
 	)
 }
+
+// Unwrap returns the underlying protocol error, if any.
+func (pe *ProtocolError) Unwrap() error {
+	return pe.cause
+}
+
+// newTimeoutError creates a protocol error for a stage which timed out
+// waiting for messages from the given parties.
+func newTimeoutError(
+	stage string,
+	round int,
+	timeout time.Duration,
+	waitingFor []*tss.PartyID,
+) *ProtocolError {
+	return &ProtocolError{
+		Stage:          stage,
+		Round:          round,
+		Timeout:        timeout,
+		MissingMembers: partiesToMemberIDs(waitingFor),
+	}
+}
+
+// newCulpritsError creates a protocol error for a stage which failed because
+// of culprits identified by the protocol.
+func newCulpritsError(stage string, err *tss.Error) *ProtocolError {
+	return &ProtocolError{
+		Stage:    stage,
+		Round:    err.Round(),
+		Culprits: partiesToMemberIDs(err.Culprits()),
+		cause:    err.Cause(),
+	}
+}
+
+// currentRound returns the number of the round the party is currently in.
+func currentRound(party tss.Party) int {
+	// Party does not expose the current round directly but errors wrapped by
+	// the party are annotated with it.
+	return party.WrapError(fmt.Errorf("current round")).Round()
+}
+
+func partiesToMemberIDs(partyIDs []*tss.PartyID) []MemberID {
+	memberIDs := []MemberID{}
+
+	for _, partyID := range partyIDs {
+		memberID, err := MemberIDFromString(partyID.GetId())
+		if err != nil {
+			logger.Errorf(
+				"cannot get member id from string [%v]: [%v]",
+				partyID.GetId(),
+				err,
+			)
+			continue
+		}
+
+		memberIDs = append(memberIDs, memberID)
+	}
+
+	return memberIDs
+}
+
+func joinMemberIDs(memberIDs []MemberID) string {
+	stringIDs := []string{}
+
+	for _, memberID := range memberIDs {
+		stringIDs = append(stringIDs, memberID.String())
+	}
+
+	return strings.Join(stringIDs, ", ")
+}
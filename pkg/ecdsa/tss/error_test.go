@@ -0,0 +1,109 @@
+package tss
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func TestProtocolErrorFromCulprits(t *testing.T) {
+	memberIDs, err := generateMemberKeys(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, partyIDs, err := generatePartiesIDs(memberIDs[0], memberIDs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cause := fmt.Errorf("de-commitment verify failed")
+
+	protocolError := newCulpritsError(
+		KeyGenerationStage,
+		tss.NewError(cause, "keygen", 2, partyIDs[0], partyIDs[2]),
+	)
+
+	if protocolError.Round != 2 {
+		t.Errorf(
+			"unexpected round\nexpected: [%v]\nactual:   [%v]",
+			2,
+			protocolError.Round,
+		)
+	}
+
+	expectedCulprits := []MemberID{memberIDs[2]}
+	if !reflect.DeepEqual(expectedCulprits, protocolError.Culprits) {
+		t.Errorf(
+			"unexpected culprits\nexpected: [%v]\nactual:   [%v]",
+			expectedCulprits,
+			protocolError.Culprits,
+		)
+	}
+
+	if !errors.Is(protocolError, cause) {
+		t.Errorf("protocol error does not wrap the cause")
+	}
+
+	expectedMessage := fmt.Sprintf(
+		"stage [key generation] in round [2] failed because of members [%s]: "+
+			"[de-commitment verify failed]",
+		memberIDs[2],
+	)
+	if expectedMessage != protocolError.Error() {
+		t.Errorf(
+			"unexpected message\nexpected: [%v]\nactual:   [%v]",
+			expectedMessage,
+			protocolError.Error(),
+		)
+	}
+}
+
+func TestProtocolErrorFromTimeout(t *testing.T) {
+	memberIDs, err := generateMemberKeys(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, partyIDs, err := generatePartiesIDs(memberIDs[0], memberIDs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wrappedError := fmt.Errorf(
+		"failed to sign: [%w]",
+		newTimeoutError(SigningStage, 3, time.Minute, partyIDs[1:]),
+	)
+
+	var protocolError *ProtocolError
+	if !errors.As(wrappedError, &protocolError) {
+		t.Fatalf("protocol error not found in [%v]", wrappedError)
+	}
+
+	expectedMissingMembers := memberIDs[1:]
+	if !reflect.DeepEqual(expectedMissingMembers, protocolError.MissingMembers) {
+		t.Errorf(
+			"unexpected missing members\nexpected: [%v]\nactual:   [%v]",
+			expectedMissingMembers,
+			protocolError.MissingMembers,
+		)
+	}
+
+	expectedMessage := fmt.Sprintf(
+		"timeout [1m0s] exceeded on stage [signing] in round [3] - "+
+			"still waiting for members: [%s, %s]",
+		memberIDs[1],
+		memberIDs[2],
+	)
+	if expectedMessage != protocolError.Error() {
+		t.Errorf(
+			"unexpected message\nexpected: [%v]\nactual:   [%v]",
+			expectedMessage,
+			protocolError.Error(),
+		)
+	}
+}
@@ -94,7 +94,8 @@ func (m *TSSProtocolMessage) GetSessionID() string {
 }
 
 type ReadyMessage struct {
-	SenderID []byte `protobuf:"bytes,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
+	SenderID  []byte `protobuf:"bytes,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
+	SessionID string `protobuf:"bytes,2,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
 }
 
 func (m *ReadyMessage) Reset()      { *m = ReadyMessage{} }
@@ -136,8 +137,16 @@ func (m *ReadyMessage) GetSenderID() []byte {
 	return nil
 }
 
+func (m *ReadyMessage) GetSessionID() string {
+	if m != nil {
+		return m.SessionID
+	}
+	return ""
+}
+
 type AnnounceMessage struct {
-	SenderID []byte `protobuf:"bytes,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
+	SenderID  []byte `protobuf:"bytes,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
+	SessionID string `protobuf:"bytes,2,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
 }
 
 func (m *AnnounceMessage) Reset()      { *m = AnnounceMessage{} }
@@ -179,10 +188,18 @@ func (m *AnnounceMessage) GetSenderID() []byte {
 	return nil
 }
 
+func (m *AnnounceMessage) GetSessionID() string {
+	if m != nil {
+		return m.SessionID
+	}
+	return ""
+}
+
 type LiquidationRecoveryAnnounceMessage struct {
 	SenderID           []byte `protobuf:"bytes,1,opt,name=senderID,proto3" json:"senderID,omitempty"`
 	BtcRecoveryAddress string `protobuf:"bytes,2,opt,name=btcRecoveryAddress,proto3" json:"btcRecoveryAddress,omitempty"`
 	MaxFeePerVByte     int32  `protobuf:"varint,3,opt,name=maxFeePerVByte,proto3" json:"maxFeePerVByte,omitempty"`
+	SessionID          string `protobuf:"bytes,4,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
 }
 
 func (m *LiquidationRecoveryAnnounceMessage) Reset()      { *m = LiquidationRecoveryAnnounceMessage{} }
@@ -238,6 +255,13 @@ func (m *LiquidationRecoveryAnnounceMessage) GetMaxFeePerVByte() int32 {
 	return 0
 }
 
+func (m *LiquidationRecoveryAnnounceMessage) GetSessionID() string {
+	if m != nil {
+		return m.SessionID
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TSSProtocolMessage)(nil), "tss.TSSProtocolMessage")
 	proto.RegisterType((*ReadyMessage)(nil), "tss.ReadyMessage")
@@ -248,27 +272,27 @@ func init() {
 func init() { proto.RegisterFile("pb/message.proto", fileDescriptor_8447775385e7eb85) }
 
 var fileDescriptor_8447775385e7eb85 = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x3f, 0x4b, 0x03, 0x41,
-	0x10, 0xc5, 0x6f, 0x12, 0xff, 0x24, 0x6b, 0x50, 0xd9, 0xea, 0x10, 0x19, 0x8e, 0x2b, 0x24, 0x08,
-	0xc6, 0xc2, 0xc6, 0xd6, 0x10, 0x84, 0x80, 0x42, 0xd8, 0x88, 0x85, 0xdd, 0xde, 0xed, 0x20, 0x07,
-	0xc9, 0xed, 0x79, 0xbb, 0x11, 0xaf, 0xb3, 0xb6, 0xb2, 0xf4, 0x23, 0xf8, 0x51, 0x2c, 0x53, 0xa6,
-	0x34, 0x9b, 0xc6, 0x32, 0x1f, 0x41, 0x3c, 0x31, 0x8a, 0x58, 0xc4, 0xf2, 0xfd, 0xde, 0x9b, 0x61,
-	0x78, 0xc3, 0xb6, 0xb3, 0xe8, 0x70, 0x48, 0xc6, 0xc8, 0x6b, 0x6a, 0x65, 0xb9, 0xb6, 0x9a, 0x57,
-	0xad, 0x31, 0xe1, 0x03, 0x30, 0x7e, 0xd1, 0xef, 0xf7, 0x3e, 0x48, 0xac, 0x07, 0xe7, 0x9f, 0x09,
-	0xbe, 0xc3, 0x6a, 0x86, 0x52, 0x45, 0x79, 0xb7, 0xe3, 0x43, 0x00, 0xcd, 0x86, 0x58, 0x68, 0xee,
-	0xb3, 0xf5, 0x4c, 0x16, 0x03, 0x2d, 0x95, 0x5f, 0x29, 0xad, 0x2f, 0xc9, 0x03, 0xb6, 0x91, 0x98,
-	0x76, 0xae, 0xa5, 0x8a, 0xa5, 0xb1, 0x7e, 0x35, 0x80, 0x66, 0x4d, 0xfc, 0x44, 0x7c, 0x97, 0xd5,
-	0x0d, 0x19, 0x93, 0xe8, 0xb4, 0xdb, 0xf1, 0x57, 0x02, 0x68, 0xd6, 0xc5, 0x37, 0x08, 0xf7, 0x59,
-	0x43, 0x90, 0x54, 0xc5, 0x12, 0x57, 0x84, 0x07, 0x6c, 0xeb, 0x24, 0x4d, 0xf5, 0x28, 0x8d, 0x69,
-	0x99, 0xf8, 0x13, 0xb0, 0xf0, 0x2c, 0xb9, 0x19, 0x25, 0x4a, 0xda, 0x44, 0xa7, 0x82, 0x62, 0x7d,
-	0x4b, 0x79, 0xf1, 0x8f, 0x15, 0xbc, 0xc5, 0x78, 0x64, 0xe3, 0xc5, 0xa4, 0x52, 0x39, 0x19, 0x53,
-	0x56, 0x50, 0x17, 0x7f, 0x38, 0x7c, 0x8f, 0x6d, 0x0e, 0xe5, 0xdd, 0x29, 0x51, 0x8f, 0xf2, 0xcb,
-	0x76, 0x61, 0xa9, 0x2c, 0x64, 0x55, 0xfc, 0xa2, 0xed, 0xe3, 0xf1, 0x14, 0xbd, 0xc9, 0x14, 0xbd,
-	0xf9, 0x14, 0xe1, 0xde, 0x21, 0x3c, 0x3b, 0x84, 0x17, 0x87, 0x30, 0x76, 0x08, 0xaf, 0x0e, 0xe1,
-	0xcd, 0xa1, 0x37, 0x77, 0x08, 0x8f, 0x33, 0xf4, 0xc6, 0x33, 0xf4, 0x26, 0x33, 0xf4, 0xae, 0x2a,
-	0x59, 0x14, 0xad, 0x95, 0x8f, 0x3c, 0x7a, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x77, 0x62, 0x02,
-	0xdc, 0x01, 0x00, 0x00,
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xbd, 0x4a, 0x03, 0x41,
+	0x14, 0x85, 0xf7, 0x26, 0xfe, 0x24, 0x63, 0x50, 0x99, 0x6a, 0x11, 0xb9, 0x2c, 0x29, 0x24, 0x55,
+	0x2c, 0x6c, 0x6c, 0x0d, 0x41, 0x0c, 0x2a, 0x84, 0x8d, 0x58, 0xd8, 0xcd, 0xee, 0x5c, 0x64, 0x21,
+	0xd9, 0x59, 0xf7, 0x6e, 0xc4, 0xed, 0xac, 0xad, 0x7c, 0x0c, 0x9f, 0xc0, 0x67, 0xb0, 0x4c, 0x99,
+	0xd2, 0x4c, 0x1a, 0xcb, 0x3c, 0x82, 0x18, 0x35, 0x6a, 0x10, 0x51, 0x2c, 0xcf, 0x77, 0x66, 0xce,
+	0x19, 0x0e, 0x23, 0xd6, 0x93, 0x60, 0xbb, 0x47, 0xcc, 0xea, 0x9c, 0xea, 0x49, 0x6a, 0x32, 0x23,
+	0x8b, 0x19, 0x73, 0xf5, 0x06, 0x84, 0x3c, 0xe9, 0x74, 0xda, 0x2f, 0x24, 0x34, 0xdd, 0xe3, 0xd7,
+	0x13, 0x72, 0x43, 0x94, 0x98, 0x62, 0x4d, 0x69, 0xab, 0xe9, 0x82, 0x07, 0xb5, 0x8a, 0x3f, 0xd3,
+	0xd2, 0x15, 0xcb, 0x89, 0xca, 0xbb, 0x46, 0x69, 0xb7, 0x30, 0xb5, 0xde, 0xa5, 0xf4, 0xc4, 0x4a,
+	0xc4, 0x8d, 0xd4, 0x28, 0x1d, 0x2a, 0xce, 0xdc, 0xa2, 0x07, 0xb5, 0x92, 0xff, 0x19, 0xc9, 0x4d,
+	0x51, 0x66, 0x62, 0x8e, 0x4c, 0xdc, 0x6a, 0xba, 0x0b, 0x1e, 0xd4, 0xca, 0xfe, 0x07, 0xa8, 0x1e,
+	0x88, 0x8a, 0x4f, 0x4a, 0xe7, 0xbf, 0x79, 0xc5, 0x97, 0xa4, 0xc2, 0x7c, 0xd2, 0xa1, 0x58, 0xdb,
+	0x8b, 0x63, 0xd3, 0x8f, 0x43, 0xfa, 0x7f, 0xd8, 0x3d, 0x88, 0xea, 0x51, 0x74, 0xd1, 0x8f, 0xb4,
+	0xca, 0x22, 0x13, 0xfb, 0x14, 0x9a, 0x4b, 0x4a, 0xf3, 0xbf, 0x14, 0xd4, 0x85, 0x0c, 0xb2, 0x70,
+	0x76, 0x53, 0xeb, 0x94, 0x98, 0xdf, 0x9a, 0xbe, 0x71, 0xe4, 0x96, 0x58, 0xed, 0xa9, 0xab, 0x7d,
+	0xa2, 0x36, 0xa5, 0xa7, 0x8d, 0x3c, 0xa3, 0xe9, 0x98, 0x8b, 0xfe, 0x1c, 0xfd, 0x79, 0xcf, 0xc6,
+	0xee, 0x60, 0x84, 0xce, 0x70, 0x84, 0xce, 0x64, 0x84, 0x70, 0x6d, 0x11, 0xee, 0x2c, 0xc2, 0x83,
+	0x45, 0x18, 0x58, 0x84, 0x47, 0x8b, 0xf0, 0x64, 0xd1, 0x99, 0x58, 0x84, 0xdb, 0x31, 0x3a, 0x83,
+	0x31, 0x3a, 0xc3, 0x31, 0x3a, 0x67, 0x85, 0x24, 0x08, 0x96, 0xa6, 0x5f, 0x64, 0xe7, 0x79, 0x00,
+	0x14, 0x1b, 0x17, 0x62, 0x36, 0x02, 0x00, 0x00,
 }
 
 func (this *TSSProtocolMessage) Equal(that interface{}) bool {
@@ -326,6 +350,9 @@ func (this *ReadyMessage) Equal(that interface{}) bool {
 	if !bytes.Equal(this.SenderID, that1.SenderID) {
 		return false
 	}
+	if this.SessionID != that1.SessionID {
+		return false
+	}
 	return true
 }
 func (this *AnnounceMessage) Equal(that interface{}) bool {
@@ -350,6 +377,9 @@ func (this *AnnounceMessage) Equal(that interface{}) bool {
 	if !bytes.Equal(this.SenderID, that1.SenderID) {
 		return false
 	}
+	if this.SessionID != that1.SessionID {
+		return false
+	}
 	return true
 }
 func (this *LiquidationRecoveryAnnounceMessage) Equal(that interface{}) bool {
@@ -380,6 +410,9 @@ func (this *LiquidationRecoveryAnnounceMessage) Equal(that interface{}) bool {
 	if this.MaxFeePerVByte != that1.MaxFeePerVByte {
 		return false
 	}
+	if this.SessionID != that1.SessionID {
+		return false
+	}
 	return true
 }
 func (this *TSSProtocolMessage) GoString() string {
@@ -399,9 +432,10 @@ func (this *ReadyMessage) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&pb.ReadyMessage{")
 	s = append(s, "SenderID: "+fmt.Sprintf("%#v", this.SenderID)+",\n")
+	s = append(s, "SessionID: "+fmt.Sprintf("%#v", this.SessionID)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -409,9 +443,10 @@ func (this *AnnounceMessage) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&pb.AnnounceMessage{")
 	s = append(s, "SenderID: "+fmt.Sprintf("%#v", this.SenderID)+",\n")
+	s = append(s, "SessionID: "+fmt.Sprintf("%#v", this.SessionID)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -419,11 +454,12 @@ func (this *LiquidationRecoveryAnnounceMessage) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&pb.LiquidationRecoveryAnnounceMessage{")
 	s = append(s, "SenderID: "+fmt.Sprintf("%#v", this.SenderID)+",\n")
 	s = append(s, "BtcRecoveryAddress: "+fmt.Sprintf("%#v", this.BtcRecoveryAddress)+",\n")
 	s = append(s, "MaxFeePerVByte: "+fmt.Sprintf("%#v", this.MaxFeePerVByte)+",\n")
+	s = append(s, "SessionID: "+fmt.Sprintf("%#v", this.SessionID)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -509,6 +545,13 @@ func (m *ReadyMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.SenderID) > 0 {
 		i -= len(m.SenderID)
 		copy(dAtA[i:], m.SenderID)
@@ -539,6 +582,13 @@ func (m *AnnounceMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.SenderID) > 0 {
 		i -= len(m.SenderID)
 		copy(dAtA[i:], m.SenderID)
@@ -569,6 +619,13 @@ func (m *LiquidationRecoveryAnnounceMessage) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
+	if len(m.SessionID) > 0 {
+		i -= len(m.SessionID)
+		copy(dAtA[i:], m.SessionID)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SessionID)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.MaxFeePerVByte != 0 {
 		i = encodeVarintMessage(dAtA, i, uint64(m.MaxFeePerVByte))
 		i--
@@ -636,6 +693,10 @@ func (m *ReadyMessage) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -649,6 +710,10 @@ func (m *AnnounceMessage) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -669,6 +734,10 @@ func (m *LiquidationRecoveryAnnounceMessage) Size() (n int) {
 	if m.MaxFeePerVByte != 0 {
 		n += 1 + sovMessage(uint64(m.MaxFeePerVByte))
 	}
+	l = len(m.SessionID)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -697,6 +766,7 @@ func (this *ReadyMessage) String() string {
 	}
 	s := strings.Join([]string{`&ReadyMessage{`,
 		`SenderID:` + fmt.Sprintf("%v", this.SenderID) + `,`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -707,6 +777,7 @@ func (this *AnnounceMessage) String() string {
 	}
 	s := strings.Join([]string{`&AnnounceMessage{`,
 		`SenderID:` + fmt.Sprintf("%v", this.SenderID) + `,`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -719,6 +790,7 @@ func (this *LiquidationRecoveryAnnounceMessage) String() string {
 		`SenderID:` + fmt.Sprintf("%v", this.SenderID) + `,`,
 		`BtcRecoveryAddress:` + fmt.Sprintf("%v", this.BtcRecoveryAddress) + `,`,
 		`MaxFeePerVByte:` + fmt.Sprintf("%v", this.MaxFeePerVByte) + `,`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -964,6 +1036,38 @@ func (m *ReadyMessage) Unmarshal(dAtA []byte) error {
 				m.SenderID = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -1048,6 +1152,38 @@ func (m *AnnounceMessage) Unmarshal(dAtA []byte) error {
 				m.SenderID = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -1183,6 +1319,38 @@ func (m *LiquidationRecoveryAnnounceMessage) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
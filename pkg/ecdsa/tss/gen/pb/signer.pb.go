@@ -143,6 +143,93 @@ func (m *ThresholdSigner_GroupInfo) GetDishonestThreshold() int32 {
 	return 0
 }
 
+// ThresholdSignerEnvelope wraps a marshalled ThresholdSigner with the version
+// of the format and the metadata of the signer. The format version field has
+// a different wire type than the first field of ThresholdSigner, so the bare
+// ThresholdSigner encodings of format version 1 never decode as an envelope.
+type ThresholdSignerEnvelope struct {
+	FormatVersion uint32 `protobuf:"varint,1,opt,name=formatVersion,proto3" json:"formatVersion,omitempty"`
+	Protocol      string `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Curve         string `protobuf:"bytes,3,opt,name=curve,proto3" json:"curve,omitempty"`
+	ChainName     string `protobuf:"bytes,4,opt,name=chainName,proto3" json:"chainName,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Signer        []byte `protobuf:"bytes,6,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *ThresholdSignerEnvelope) Reset()      { *m = ThresholdSignerEnvelope{} }
+func (*ThresholdSignerEnvelope) ProtoMessage() {}
+func (*ThresholdSignerEnvelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_362f9e86e7c5d639, []int{1}
+}
+func (m *ThresholdSignerEnvelope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThresholdSignerEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThresholdSignerEnvelope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ThresholdSignerEnvelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThresholdSignerEnvelope.Merge(m, src)
+}
+func (m *ThresholdSignerEnvelope) XXX_Size() int {
+	return m.Size()
+}
+func (m *ThresholdSignerEnvelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThresholdSignerEnvelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThresholdSignerEnvelope proto.InternalMessageInfo
+
+func (m *ThresholdSignerEnvelope) GetFormatVersion() uint32 {
+	if m != nil {
+		return m.FormatVersion
+	}
+	return 0
+}
+
+func (m *ThresholdSignerEnvelope) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *ThresholdSignerEnvelope) GetCurve() string {
+	if m != nil {
+		return m.Curve
+	}
+	return ""
+}
+
+func (m *ThresholdSignerEnvelope) GetChainName() string {
+	if m != nil {
+		return m.ChainName
+	}
+	return ""
+}
+
+func (m *ThresholdSignerEnvelope) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ThresholdSignerEnvelope) GetSigner() []byte {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
 type LocalPartySaveData struct {
 	LocalPreParams *LocalPartySaveData_LocalPreParams `protobuf:"bytes,1,opt,name=localPreParams,proto3" json:"localPreParams,omitempty"`
 	LocalSecrets   *LocalPartySaveData_LocalSecrets   `protobuf:"bytes,2,opt,name=localSecrets,proto3" json:"localSecrets,omitempty"`
@@ -158,7 +245,7 @@ type LocalPartySaveData struct {
 func (m *LocalPartySaveData) Reset()      { *m = LocalPartySaveData{} }
 func (*LocalPartySaveData) ProtoMessage() {}
 func (*LocalPartySaveData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_362f9e86e7c5d639, []int{1}
+	return fileDescriptor_362f9e86e7c5d639, []int{2}
 }
 func (m *LocalPartySaveData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,7 +351,7 @@ type LocalPartySaveData_LocalPreParams struct {
 func (m *LocalPartySaveData_LocalPreParams) Reset()      { *m = LocalPartySaveData_LocalPreParams{} }
 func (*LocalPartySaveData_LocalPreParams) ProtoMessage() {}
 func (*LocalPartySaveData_LocalPreParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_362f9e86e7c5d639, []int{1, 0}
+	return fileDescriptor_362f9e86e7c5d639, []int{2, 0}
 }
 func (m *LocalPartySaveData_LocalPreParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,7 +447,7 @@ func (m *LocalPartySaveData_LocalPreParams_PrivateKey) Reset() {
 }
 func (*LocalPartySaveData_LocalPreParams_PrivateKey) ProtoMessage() {}
 func (*LocalPartySaveData_LocalPreParams_PrivateKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_362f9e86e7c5d639, []int{1, 0, 0}
+	return fileDescriptor_362f9e86e7c5d639, []int{2, 0, 0}
 }
 func (m *LocalPartySaveData_LocalPreParams_PrivateKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -418,7 +505,7 @@ type LocalPartySaveData_LocalSecrets struct {
 func (m *LocalPartySaveData_LocalSecrets) Reset()      { *m = LocalPartySaveData_LocalSecrets{} }
 func (*LocalPartySaveData_LocalSecrets) ProtoMessage() {}
 func (*LocalPartySaveData_LocalSecrets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_362f9e86e7c5d639, []int{1, 1}
+	return fileDescriptor_362f9e86e7c5d639, []int{2, 1}
 }
 func (m *LocalPartySaveData_LocalSecrets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -469,7 +556,7 @@ type LocalPartySaveData_ECPoint struct {
 func (m *LocalPartySaveData_ECPoint) Reset()      { *m = LocalPartySaveData_ECPoint{} }
 func (*LocalPartySaveData_ECPoint) ProtoMessage() {}
 func (*LocalPartySaveData_ECPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_362f9e86e7c5d639, []int{1, 2}
+	return fileDescriptor_362f9e86e7c5d639, []int{2, 2}
 }
 func (m *LocalPartySaveData_ECPoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,6 +602,7 @@ func (m *LocalPartySaveData_ECPoint) GetY() []byte {
 func init() {
 	proto.RegisterType((*ThresholdSigner)(nil), "tss.ThresholdSigner")
 	proto.RegisterType((*ThresholdSigner_GroupInfo)(nil), "tss.ThresholdSigner.GroupInfo")
+	proto.RegisterType((*ThresholdSignerEnvelope)(nil), "tss.ThresholdSignerEnvelope")
 	proto.RegisterType((*LocalPartySaveData)(nil), "tss.LocalPartySaveData")
 	proto.RegisterType((*LocalPartySaveData_LocalPreParams)(nil), "tss.LocalPartySaveData.LocalPreParams")
 	proto.RegisterType((*LocalPartySaveData_LocalPreParams_PrivateKey)(nil), "tss.LocalPartySaveData.LocalPreParams.PrivateKey")
@@ -525,46 +613,52 @@ func init() {
 func init() { proto.RegisterFile("pb/signer.proto", fileDescriptor_362f9e86e7c5d639) }
 
 var fileDescriptor_362f9e86e7c5d639 = []byte{
-	// 618 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0x74, 0xfd, 0xf5, 0x5a, 0x75, 0xc8, 0x42, 0xc8, 0xaa, 0x90, 0x89, 0x26, 0x98,
-	0x7a, 0x0a, 0x5a, 0x11, 0xd2, 0x24, 0x38, 0xc1, 0x10, 0x4c, 0x1d, 0x53, 0x48, 0x77, 0x98, 0xb8,
-	0x39, 0xad, 0x59, 0xdc, 0xa5, 0x4d, 0x66, 0x67, 0x53, 0x7b, 0xe3, 0x3f, 0x80, 0x2b, 0xff, 0x01,
-	0xff, 0x00, 0x67, 0xae, 0x1c, 0x77, 0xdc, 0x91, 0x65, 0x17, 0x8e, 0xfb, 0x13, 0x50, 0x1c, 0xa7,
-	0xdb, 0xca, 0xcf, 0x9b, 0xbf, 0x9f, 0xbc, 0xf7, 0xfc, 0xfc, 0x7d, 0x76, 0x60, 0x35, 0xf6, 0x1f,
-	0x2a, 0x71, 0x30, 0xe5, 0xd2, 0x89, 0x65, 0x94, 0x44, 0xb8, 0x9c, 0x28, 0xb5, 0xf6, 0xc1, 0x82,
-	0xd5, 0xbd, 0x40, 0x72, 0x15, 0x44, 0xe1, 0x68, 0xa0, 0x3f, 0xe3, 0xa7, 0xd0, 0x38, 0x90, 0xd1,
-	0x71, 0xbc, 0x3d, 0x7d, 0x17, 0x11, 0x64, 0xa3, 0x6e, 0xb3, 0x47, 0x9d, 0x44, 0x29, 0x67, 0x29,
-	0xd0, 0x79, 0x59, 0x44, 0x79, 0x57, 0x09, 0x78, 0x0d, 0x5a, 0x49, 0x11, 0xd7, 0xe7, 0x73, 0x62,
-	0xd9, 0xa8, 0xdb, 0xf2, 0x6e, 0xb0, 0xce, 0x27, 0x04, 0x8d, 0x45, 0x32, 0x26, 0x50, 0xcb, 0xd3,
-	0xb7, 0xf4, 0x6e, 0x0d, 0xaf, 0x90, 0xb8, 0x03, 0xf5, 0x09, 0x9f, 0xf8, 0x5c, 0x6e, 0x6f, 0x99,
-	0x3a, 0x0b, 0x8d, 0xd7, 0xa1, 0xad, 0xc3, 0x5e, 0x1b, 0xa0, 0x48, 0xd9, 0x2e, 0x77, 0x5b, 0xde,
-	0x12, 0xc5, 0x0e, 0xe0, 0x91, 0x50, 0x41, 0x34, 0xe5, 0x2a, 0x59, 0x1c, 0x80, 0xac, 0xd8, 0xa8,
-	0x5b, 0xf1, 0x7e, 0xf3, 0x65, 0xed, 0x4b, 0x15, 0xf0, 0x4e, 0x34, 0x64, 0xa1, 0xcb, 0x64, 0x32,
-	0x1f, 0xb0, 0x13, 0xbe, 0xc5, 0x12, 0x86, 0x77, 0xa1, 0x1d, 0x6a, 0x2a, 0xb9, 0xcb, 0x24, 0x9b,
-	0x28, 0xe3, 0xcc, 0xba, 0x76, 0xe6, 0xd7, 0x04, 0x83, 0x8a, 0x68, 0x6f, 0x29, 0x1b, 0xbf, 0x82,
-	0x96, 0x26, 0x03, 0x3e, 0x94, 0x3c, 0x51, 0xfa, 0x78, 0xcd, 0xde, 0xfd, 0xbf, 0x56, 0x33, 0xb1,
-	0xde, 0x8d, 0x4c, 0xdc, 0x06, 0xeb, 0xb0, 0x38, 0xbc, 0x75, 0xa8, 0x32, 0x3b, 0xa7, 0x7b, 0x22,
-	0x1c, 0xf1, 0x31, 0x59, 0xd1, 0xb0, 0x90, 0xf8, 0x16, 0x94, 0x83, 0x8d, 0x31, 0xa9, 0x68, 0x9a,
-	0x2d, 0x35, 0xe9, 0x8d, 0x49, 0xd5, 0x90, 0xde, 0x18, 0x3f, 0x86, 0x8a, 0x2f, 0x0e, 0xf6, 0xc7,
-	0xa4, 0x66, 0x97, 0xbb, 0xcd, 0xde, 0xbd, 0x3f, 0x35, 0xf4, 0xe2, 0xb9, 0x1b, 0x89, 0x69, 0xe2,
-	0xe5, 0xd1, 0xd8, 0x86, 0x66, 0xcc, 0x44, 0x18, 0x0a, 0x2e, 0xdd, 0xbe, 0x22, 0x75, 0x5d, 0xf0,
-	0x3a, 0xc2, 0x4f, 0xa0, 0xce, 0x87, 0x23, 0xc5, 0xdc, 0x63, 0x9f, 0x34, 0xf4, 0x61, 0xff, 0x59,
-	0x7b, 0x91, 0xd0, 0xf9, 0x6a, 0x41, 0xfb, 0xa6, 0xa1, 0xf8, 0x0d, 0x40, 0x51, 0x7e, 0xd0, 0x37,
-	0xc3, 0xd8, 0xf8, 0xbf, 0x61, 0x38, 0xae, 0x14, 0x27, 0x2c, 0xe1, 0x7d, 0x3e, 0xf7, 0xae, 0x15,
-	0xc1, 0x77, 0xa0, 0x9a, 0x5b, 0x65, 0x2e, 0x9b, 0x51, 0xb9, 0x6f, 0x82, 0x94, 0x35, 0xcc, 0x96,
-	0xb9, 0x6f, 0x42, 0xdf, 0x22, 0xed, 0x9b, 0xc0, 0xb7, 0xa1, 0xc2, 0xc2, 0x38, 0x60, 0xa4, 0xa2,
-	0x59, 0x2e, 0x30, 0x86, 0x15, 0x9f, 0x27, 0x8c, 0x54, 0x35, 0xd4, 0x6b, 0xdc, 0x02, 0x14, 0x93,
-	0x9a, 0x06, 0x28, 0xce, 0xd4, 0x11, 0xa9, 0xe7, 0xea, 0xa8, 0xb3, 0x0f, 0x70, 0xd5, 0x1b, 0xbe,
-	0x0b, 0x8d, 0xf8, 0xd8, 0x0f, 0xc5, 0x30, 0x7b, 0x47, 0x48, 0xc7, 0x5c, 0x81, 0x6c, 0xce, 0x21,
-	0x9b, 0xf8, 0x23, 0xb6, 0x6b, 0xda, 0x2d, 0x64, 0xb6, 0x6b, 0x1c, 0x88, 0x5d, 0xd3, 0xb0, 0x5e,
-	0x77, 0x36, 0xa1, 0xb5, 0xb3, 0x74, 0x6b, 0x66, 0xc2, 0x14, 0xb5, 0x66, 0x22, 0xab, 0xa6, 0x02,
-	0x26, 0xf9, 0xe2, 0xa5, 0x15, 0xb2, 0xf3, 0x00, 0x6a, 0x66, 0x20, 0x59, 0xb3, 0x33, 0x93, 0x83,
-	0x66, 0x99, 0x2a, 0x9e, 0x37, 0x9a, 0x3f, 0xdb, 0x3c, 0x3d, 0xa7, 0xa5, 0xb3, 0x73, 0x5a, 0xba,
-	0x3c, 0xa7, 0xe8, 0x7d, 0x4a, 0xd1, 0xe7, 0x94, 0xa2, 0x6f, 0x29, 0x45, 0xa7, 0x29, 0x45, 0xdf,
-	0x53, 0x8a, 0x7e, 0xa4, 0xb4, 0x74, 0x99, 0x52, 0xf4, 0xf1, 0x82, 0x96, 0x4e, 0x2f, 0x68, 0xe9,
-	0xec, 0x82, 0x96, 0xde, 0x5a, 0xb1, 0xef, 0x57, 0xf5, 0xff, 0xe8, 0xd1, 0xcf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x2a, 0x91, 0x3c, 0xcc, 0xa2, 0x04, 0x00, 0x00,
+	// 711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xce, 0xe4, 0x3f, 0x27, 0x69, 0x7a, 0x35, 0xba, 0xba, 0x77, 0x14, 0x5d, 0xf9, 0x5a, 0x51,
+	0xa9, 0xb2, 0x0a, 0x6a, 0x10, 0x52, 0x25, 0xd8, 0x00, 0xad, 0xa0, 0x4a, 0x89, 0x82, 0x53, 0xa1,
+	0x8a, 0xdd, 0x38, 0x99, 0xd6, 0x93, 0x3a, 0xb6, 0x3b, 0xe3, 0x44, 0xc9, 0x8e, 0x37, 0x80, 0x2d,
+	0x6f, 0xc0, 0x0b, 0xb0, 0x66, 0x85, 0xc4, 0xb2, 0xcb, 0x2e, 0x69, 0xba, 0x61, 0xd9, 0x47, 0x40,
+	0x1e, 0x8f, 0x93, 0x26, 0xfc, 0xa8, 0xbb, 0xf9, 0xbe, 0xf3, 0xe3, 0x6f, 0xce, 0xf9, 0x3c, 0xb0,
+	0x19, 0xd8, 0xf7, 0x25, 0x3f, 0xf5, 0x98, 0x68, 0x06, 0xc2, 0x0f, 0x7d, 0x9c, 0x09, 0xa5, 0xac,
+	0xbf, 0x4b, 0xc3, 0xe6, 0x91, 0x23, 0x98, 0x74, 0x7c, 0x77, 0xd0, 0x53, 0x61, 0xfc, 0x18, 0x4a,
+	0xa7, 0xc2, 0x1f, 0x07, 0x07, 0xde, 0x89, 0x4f, 0x90, 0x89, 0x1a, 0xe5, 0x96, 0xd1, 0x0c, 0xa5,
+	0x6c, 0xae, 0x25, 0x36, 0x9f, 0x27, 0x59, 0xd6, 0xb2, 0x00, 0xd7, 0xa1, 0x12, 0x26, 0x79, 0x6d,
+	0x36, 0x23, 0x69, 0x13, 0x35, 0x2a, 0xd6, 0x0a, 0x57, 0xfb, 0x80, 0xa0, 0xb4, 0x28, 0xc6, 0x04,
+	0x0a, 0x71, 0xf9, 0x9e, 0xfa, 0x5a, 0xc9, 0x4a, 0x20, 0xae, 0x41, 0x71, 0xc4, 0x46, 0x36, 0x13,
+	0x07, 0x7b, 0xba, 0xcf, 0x02, 0xe3, 0x6d, 0xa8, 0xaa, 0xb4, 0x97, 0x9a, 0x90, 0x24, 0x63, 0x66,
+	0x1a, 0x15, 0x6b, 0x8d, 0xc5, 0x4d, 0xc0, 0x03, 0x2e, 0x1d, 0xdf, 0x63, 0x32, 0x5c, 0x5c, 0x80,
+	0x64, 0x4d, 0xd4, 0xc8, 0x59, 0xbf, 0x88, 0xd4, 0xbf, 0x20, 0xf8, 0x77, 0xed, 0xa2, 0xfb, 0xde,
+	0x84, 0xb9, 0x7e, 0xc0, 0xf0, 0x16, 0x6c, 0x9c, 0xf8, 0x62, 0x44, 0xc3, 0xd7, 0x4c, 0x48, 0xee,
+	0x7b, 0x4a, 0xef, 0x86, 0xb5, 0x4a, 0x46, 0xaa, 0xd5, 0x84, 0xfb, 0xbe, 0xab, 0x54, 0x97, 0xac,
+	0x05, 0xc6, 0x7f, 0x43, 0xae, 0x3f, 0x16, 0x13, 0x46, 0x32, 0x2a, 0x10, 0x03, 0xfc, 0x1f, 0x94,
+	0xfa, 0x0e, 0xe5, 0x5e, 0x87, 0x8e, 0x98, 0x92, 0x56, 0xb2, 0x96, 0x84, 0x8a, 0x0a, 0x46, 0x43,
+	0x36, 0x78, 0x12, 0x92, 0x9c, 0x89, 0x1a, 0x19, 0x6b, 0x49, 0xe0, 0x7f, 0x20, 0x1f, 0xaf, 0x95,
+	0xe4, 0xd5, 0x84, 0x34, 0xaa, 0x7f, 0xca, 0x03, 0x3e, 0xf4, 0xfb, 0xd4, 0xed, 0x52, 0x11, 0xce,
+	0x7a, 0x74, 0xc2, 0xf6, 0x68, 0x48, 0x71, 0x07, 0xaa, 0xae, 0x62, 0x05, 0xeb, 0x52, 0x41, 0x47,
+	0x52, 0x6f, 0x78, 0x5b, 0x6d, 0xf8, 0xe7, 0x82, 0xe6, 0xe1, 0x4a, 0xb6, 0xb5, 0x56, 0x8d, 0x5f,
+	0x40, 0x45, 0x31, 0x3d, 0xd6, 0x17, 0x2c, 0x94, 0xea, 0xc2, 0xe5, 0xd6, 0xd6, 0x1f, 0xbb, 0xe9,
+	0x5c, 0x6b, 0xa5, 0x12, 0x57, 0x21, 0x7d, 0x96, 0x2c, 0x31, 0x7d, 0x26, 0x23, 0x5b, 0x78, 0x47,
+	0xdc, 0x1d, 0xb0, 0x21, 0xc9, 0x2a, 0x32, 0x81, 0xf8, 0x2f, 0xc8, 0x38, 0x3b, 0x43, 0x92, 0x53,
+	0x6c, 0x74, 0x54, 0x4c, 0x6b, 0x48, 0xf2, 0x9a, 0x69, 0x0d, 0xf1, 0x43, 0xc8, 0xd9, 0xfc, 0xf4,
+	0x78, 0x48, 0x0a, 0x66, 0xa6, 0x51, 0x6e, 0xfd, 0xff, 0x3b, 0x41, 0xfb, 0xcf, 0xba, 0x3e, 0xf7,
+	0x42, 0x2b, 0xce, 0xc6, 0x26, 0x94, 0x03, 0xca, 0x5d, 0x97, 0x33, 0xd1, 0x6d, 0x4b, 0x52, 0x54,
+	0x0d, 0x6f, 0x53, 0xf8, 0x11, 0x14, 0x59, 0x7f, 0x20, 0x69, 0x77, 0x6c, 0x93, 0x92, 0x89, 0xee,
+	0xd2, 0x7b, 0x51, 0x50, 0xfb, 0x9c, 0x86, 0xea, 0xea, 0x40, 0xf1, 0x2b, 0x80, 0xa4, 0x7d, 0xaf,
+	0xad, 0x97, 0xb1, 0x73, 0xb7, 0x65, 0x34, 0xbb, 0x82, 0x4f, 0x68, 0xc8, 0xda, 0x6c, 0x66, 0xdd,
+	0x6a, 0x12, 0x59, 0x22, 0x1e, 0x95, 0xfe, 0x69, 0x34, 0x8a, 0xe7, 0xc6, 0x95, 0xf5, 0xd4, 0xdc,
+	0x78, 0x3c, 0x37, 0x4e, 0xb2, 0x9a, 0x69, 0xf1, 0xc8, 0xa0, 0xd4, 0x0d, 0x1c, 0xaa, 0x8c, 0x56,
+	0xb1, 0x62, 0x80, 0x31, 0x64, 0x6d, 0x16, 0x52, 0x6d, 0x31, 0x75, 0xc6, 0x15, 0x40, 0x01, 0x29,
+	0x28, 0x02, 0x05, 0x11, 0x3a, 0x27, 0xc5, 0x18, 0x9d, 0xd7, 0x8e, 0x01, 0x96, 0xda, 0x22, 0x03,
+	0x07, 0x63, 0xdb, 0xe5, 0xfd, 0xe8, 0x3d, 0x40, 0x2a, 0x67, 0x49, 0x44, 0x7b, 0x76, 0xe9, 0xc8,
+	0x1e, 0xd0, 0x8e, 0x96, 0x9b, 0xc0, 0xe8, 0xab, 0x81, 0xc3, 0x3b, 0x5a, 0xb0, 0x3a, 0xd7, 0x76,
+	0xa1, 0x72, 0xb8, 0xe6, 0x9a, 0x29, 0xd7, 0x4d, 0xd3, 0x53, 0x1e, 0x75, 0x93, 0x0e, 0x15, 0x6c,
+	0xf1, 0x62, 0x24, 0xb0, 0x76, 0x0f, 0x0a, 0x7a, 0x21, 0x91, 0xd8, 0xa9, 0xae, 0x41, 0xd3, 0x08,
+	0x25, 0xcf, 0x14, 0x9a, 0x3d, 0xdd, 0xbd, 0xb8, 0x32, 0x52, 0x97, 0x57, 0x46, 0xea, 0xe6, 0xca,
+	0x40, 0x6f, 0xe7, 0x06, 0xfa, 0x38, 0x37, 0xd0, 0xd7, 0xb9, 0x81, 0x2e, 0xe6, 0x06, 0xfa, 0x36,
+	0x37, 0xd0, 0xf7, 0xb9, 0x91, 0xba, 0x99, 0x1b, 0xe8, 0xfd, 0xb5, 0x91, 0xba, 0xb8, 0x36, 0x52,
+	0x97, 0xd7, 0x46, 0xea, 0x4d, 0x3a, 0xb0, 0xed, 0xbc, 0xfa, 0xcb, 0x1f, 0xfc, 0x18, 0x00, 0x1f,
+	0xee, 0xea, 0x36, 0x6a, 0x05, 0x00, 0x00,
 }
 
 func (this *ThresholdSigner) Equal(that interface{}) bool {
@@ -632,6 +726,45 @@ func (this *ThresholdSigner_GroupInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ThresholdSignerEnvelope) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ThresholdSignerEnvelope)
+	if !ok {
+		that2, ok := that.(ThresholdSignerEnvelope)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FormatVersion != that1.FormatVersion {
+		return false
+	}
+	if this.Protocol != that1.Protocol {
+		return false
+	}
+	if this.Curve != that1.Curve {
+		return false
+	}
+	if this.ChainName != that1.ChainName {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if !bytes.Equal(this.Signer, that1.Signer) {
+		return false
+	}
+	return true
+}
 func (this *LocalPartySaveData) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -865,6 +998,21 @@ func (this *ThresholdSigner_GroupInfo) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ThresholdSignerEnvelope) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&pb.ThresholdSignerEnvelope{")
+	s = append(s, "FormatVersion: "+fmt.Sprintf("%#v", this.FormatVersion)+",\n")
+	s = append(s, "Protocol: "+fmt.Sprintf("%#v", this.Protocol)+",\n")
+	s = append(s, "Curve: "+fmt.Sprintf("%#v", this.Curve)+",\n")
+	s = append(s, "ChainName: "+fmt.Sprintf("%#v", this.ChainName)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "Signer: "+fmt.Sprintf("%#v", this.Signer)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *LocalPartySaveData) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1045,6 +1193,67 @@ func (m *ThresholdSigner_GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *ThresholdSignerEnvelope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThresholdSignerEnvelope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThresholdSignerEnvelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintSigner(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintSigner(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ChainName) > 0 {
+		i -= len(m.ChainName)
+		copy(dAtA[i:], m.ChainName)
+		i = encodeVarintSigner(dAtA, i, uint64(len(m.ChainName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Curve) > 0 {
+		i -= len(m.Curve)
+		copy(dAtA[i:], m.Curve)
+		i = encodeVarintSigner(dAtA, i, uint64(len(m.Curve)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Protocol) > 0 {
+		i -= len(m.Protocol)
+		copy(dAtA[i:], m.Protocol)
+		i = encodeVarintSigner(dAtA, i, uint64(len(m.Protocol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FormatVersion != 0 {
+		i = encodeVarintSigner(dAtA, i, uint64(m.FormatVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LocalPartySaveData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1419,6 +1628,37 @@ func (m *ThresholdSigner_GroupInfo) Size() (n int) {
 	return n
 }
 
+func (m *ThresholdSignerEnvelope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FormatVersion != 0 {
+		n += 1 + sovSigner(uint64(m.FormatVersion))
+	}
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	l = len(m.Curve)
+	if l > 0 {
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	l = len(m.ChainName)
+	if l > 0 {
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovSigner(uint64(m.CreatedAt))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovSigner(uint64(l))
+	}
+	return n
+}
+
 func (m *LocalPartySaveData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1602,6 +1842,21 @@ func (this *ThresholdSigner_GroupInfo) String() string {
 	}, "")
 	return s
 }
+func (this *ThresholdSignerEnvelope) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ThresholdSignerEnvelope{`,
+		`FormatVersion:` + fmt.Sprintf("%v", this.FormatVersion) + `,`,
+		`Protocol:` + fmt.Sprintf("%v", this.Protocol) + `,`,
+		`Curve:` + fmt.Sprintf("%v", this.Curve) + `,`,
+		`ChainName:` + fmt.Sprintf("%v", this.ChainName) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`Signer:` + fmt.Sprintf("%v", this.Signer) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *LocalPartySaveData) String() string {
 	if this == nil {
 		return "nil"
@@ -1971,6 +2226,224 @@ func (m *ThresholdSigner_GroupInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ThresholdSignerEnvelope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdSignerEnvelope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdSignerEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FormatVersion", wireType)
+			}
+			m.FormatVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FormatVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curve = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *LocalPartySaveData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -39,7 +39,7 @@ func initializeKeyGeneration(
 	tssPreParams *keygen.LocalPreParams,
 	network *networkBridge,
 ) (*member, error) {
-	keyGenParty, endChan, err := initializeKeyGenerationParty(
+	keyGenParty, endChan, errChan, err := initializeKeyGenerationParty(
 		ctx,
 		group,
 		tssPreParams,
@@ -52,8 +52,10 @@ func initializeKeyGeneration(
 
 	return &member{
 		groupInfo:     group,
+		protocol:      DefaultProtocol,
 		keygenParty:   keyGenParty,
 		keygenEndChan: endChan,
+		keygenErrChan: errChan,
 		networkBridge: network,
 	}, nil
 }
@@ -63,6 +65,9 @@ func initializeKeyGeneration(
 type member struct {
 	*groupInfo
 
+	// Name of the threshold protocol executed.
+	protocol string
+
 	// Network bridge used for messages transport.
 	networkBridge *networkBridge
 	// Party for TSS protocol execution.
@@ -70,6 +75,8 @@ type member struct {
 	// Channel where a result of the key generation protocol execution will be
 	// written to.
 	keygenEndChan <-chan keygen.LocalPartySaveData
+	// Channel where protocol errors identifying culprits will be written to.
+	keygenErrChan <-chan *tss.Error
 }
 
 // generateKey executes the protocol to generate a signing key. This function
@@ -90,29 +97,22 @@ func (s *member) generateKey(ctx context.Context) (*ThresholdSigner, error) {
 			signer := &ThresholdSigner{
 				groupInfo:    s.groupInfo,
 				thresholdKey: ThresholdKey(keygenData),
+				protocol:     s.protocol,
+				// Marshalled signers record the creation time with
+				// a precision of seconds.
+				createdAt: time.Unix(time.Now().Unix(), 0),
 			}
 
 			return signer, nil
+		case err := <-s.keygenErrChan:
+			return nil, newCulpritsError(KeyGenerationStage, err)
 		case <-ctx.Done():
-			memberIDs := []MemberID{}
-
-			if s.keygenParty.WaitingFor() != nil {
-				for _, partyID := range s.keygenParty.WaitingFor() {
-					memberID, err := MemberIDFromString(partyID.GetId())
-					if err != nil {
-						logger.Errorf(
-							"cannot get member id from string [%v]: [%v]",
-							partyID.GetId(),
-							err,
-						)
-						continue
-					}
-
-					memberIDs = append(memberIDs, memberID)
-				}
-			}
-
-			return nil, timeoutError{KeyGenerationProtocolTimeout, "key generation", memberIDs}
+			return nil, newTimeoutError(
+				KeyGenerationStage,
+				currentRound(s.keygenParty),
+				KeyGenerationProtocolTimeout,
+				s.networkBridge.laggingParties(s.keygenParty.WaitingFor()),
+			)
 		}
 	}
 }
@@ -157,17 +157,22 @@ func initializeKeyGenerationParty(
 ) (
 	tss.Party,
 	<-chan keygen.LocalPartySaveData,
+	<-chan *tss.Error,
 	error,
 ) {
 	tssMessageChan := make(chan tss.Message, len(groupInfo.groupMemberIDs))
-	endChan := make(chan keygen.LocalPartySaveData)
+	// The result is written to the end channel while the party is locked.
+	// The channel is buffered so that the party does not block forever if
+	// the protocol times out right when the result is ready.
+	endChan := make(chan keygen.LocalPartySaveData, 1)
+	errChan := make(chan *tss.Error, 1)
 
 	currentPartyID, groupPartiesIDs, err := generatePartiesIDs(
 		groupInfo.memberID,
 		groupInfo.groupMemberIDs,
 	)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to generate parties IDs: [%v]", err)
+		return nil, nil, nil, fmt.Errorf("failed to generate parties IDs: [%v]", err)
 	}
 
 	params := tss.NewParameters(
@@ -183,11 +188,12 @@ func initializeKeyGenerationParty(
 	if err := bridge.connect(
 		ctx,
 		tssMessageChan,
+		errChan,
 		party,
 		params.Parties().IDs(),
 	); err != nil {
-		return nil, nil, fmt.Errorf("failed to connect bridge network: [%v]", err)
+		return nil, nil, nil, fmt.Errorf("failed to connect bridge network: [%v]", err)
 	}
 
-	return party, endChan, nil
+	return party, endChan, errChan, nil
 }
@@ -3,6 +3,7 @@ package tss
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/binance-chain/tss-lib/crypto"
 	"github.com/binance-chain/tss-lib/crypto/paillier"
@@ -11,7 +12,8 @@ import (
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss/gen/pb"
 )
 
-// Marshal converts ThresholdSigner to byte array.
+// Marshal converts ThresholdSigner to byte array. The signer is wrapped in
+// an envelope recording the current format version and signer's metadata.
 func (s *ThresholdSigner) Marshal() ([]byte, error) {
 	// Threshold key
 	keygenData, err := s.thresholdKey.Marshal()
@@ -32,18 +34,53 @@ func (s *ThresholdSigner) Marshal() ([]byte, error) {
 		DishonestThreshold: int32(s.dishonestThreshold),
 	}
 
-	return (&pb.ThresholdSigner{
+	signerBytes, err := (&pb.ThresholdSigner{
 		GroupInfo:    group,
 		ThresholdKey: keygenData,
 	}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	var createdAt int64
+	if !s.createdAt.IsZero() {
+		createdAt = s.createdAt.Unix()
+	}
+
+	return (&pb.ThresholdSignerEnvelope{
+		FormatVersion: SignerFormatVersion,
+		Protocol:      s.protocol,
+		Curve:         curveName,
+		ChainName:     s.chainName,
+		CreatedAt:     createdAt,
+		Signer:        signerBytes,
+	}).Marshal()
 }
 
-// Unmarshal converts a byte array back to ThresholdSigner.
+// Unmarshal converts a byte array back to ThresholdSigner. Signers marshalled
+// with an older format version are migrated to the current format version.
 func (s *ThresholdSigner) Unmarshal(bytes []byte) error {
+	bytes, err := migrateSigner(bytes)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal signer: [%v]", err)
+	}
+
+	envelope := pb.ThresholdSignerEnvelope{}
+	if err := envelope.Unmarshal(bytes); err != nil {
+		return fmt.Errorf("failed to unmarshal signer: [%v]", err)
+	}
+
+	if envelope.GetCurve() != curveName {
+		return fmt.Errorf(
+			"failed to unmarshal signer: unsupported curve [%s]",
+			envelope.GetCurve(),
+		)
+	}
+
 	pbSigner := pb.ThresholdSigner{
 		GroupInfo: &pb.ThresholdSigner_GroupInfo{},
 	}
-	if err := pbSigner.Unmarshal(bytes); err != nil {
+	if err := pbSigner.Unmarshal(envelope.GetSigner()); err != nil {
 		return fmt.Errorf("failed to unmarshal signer: [%v]", err)
 	}
 
@@ -68,6 +105,14 @@ func (s *ThresholdSigner) Unmarshal(bytes []byte) error {
 		dishonestThreshold: int(pbGroupInfo.GetDishonestThreshold()),
 	}
 
+	// Metadata
+	s.protocol = envelope.GetProtocol()
+	s.chainName = envelope.GetChainName()
+	s.createdAt = time.Time{}
+	if envelope.GetCreatedAt() != 0 {
+		s.createdAt = time.Unix(envelope.GetCreatedAt(), 0)
+	}
+
 	return nil
 }
 
@@ -238,7 +283,8 @@ func (m *ProtocolMessage) Unmarshal(bytes []byte) error {
 // Marshal converts this message to a byte array suitable for network communication.
 func (m *ReadyMessage) Marshal() ([]byte, error) {
 	return (&pb.ReadyMessage{
-		SenderID: m.SenderID,
+		SenderID:  m.SenderID,
+		SessionID: m.SessionID,
 	}).Marshal()
 }
 
@@ -250,6 +296,7 @@ func (m *ReadyMessage) Unmarshal(bytes []byte) error {
 	}
 
 	m.SenderID = pbMsg.SenderID
+	m.SessionID = pbMsg.SessionID
 
 	return nil
 }
@@ -257,7 +304,8 @@ func (m *ReadyMessage) Unmarshal(bytes []byte) error {
 // Marshal converts this message to a byte array suitable for network communication.
 func (m *AnnounceMessage) Marshal() ([]byte, error) {
 	return (&pb.AnnounceMessage{
-		SenderID: m.SenderID,
+		SenderID:  m.SenderID,
+		SessionID: m.SessionID,
 	}).Marshal()
 }
 
@@ -269,6 +317,7 @@ func (m *AnnounceMessage) Unmarshal(bytes []byte) error {
 	}
 
 	m.SenderID = pbMsg.SenderID
+	m.SessionID = pbMsg.SessionID
 
 	return nil
 }
@@ -279,6 +328,7 @@ func (m *LiquidationRecoveryAnnounceMessage) Marshal() ([]byte, error) {
 		SenderID:           m.SenderID,
 		BtcRecoveryAddress: m.BtcRecoveryAddress,
 		MaxFeePerVByte:     m.MaxFeePerVByte,
+		SessionID:          m.SessionID,
 	}).Marshal()
 }
 
@@ -292,6 +342,7 @@ func (m *LiquidationRecoveryAnnounceMessage) Unmarshal(bytes []byte) error {
 	m.SenderID = pbMsg.SenderID
 	m.BtcRecoveryAddress = pbMsg.BtcRecoveryAddress
 	m.MaxFeePerVByte = pbMsg.MaxFeePerVByte
+	m.SessionID = pbMsg.SessionID
 
 	return nil
 }
@@ -1,13 +1,17 @@
 package tss
 
 import (
+	"bytes"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	fuzz "github.com/google/gofuzz"
 
 	"github.com/keep-network/keep-ecdsa/internal/testdata"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss/gen/pb"
 	"github.com/keep-network/keep-ecdsa/pkg/utils/pbutils"
 )
 
@@ -35,6 +39,9 @@ func TestSignerMarshalling(t *testing.T) {
 			dishonestThreshold: dishonestThreshold,
 		},
 		thresholdKey: ThresholdKey(testData[signerIndex]),
+		protocol:     GG19,
+		chainName:    "ethereum",
+		createdAt:    time.Unix(1633046400, 0),
 	}
 
 	unmarshaled := &ThresholdSigner{}
@@ -51,6 +58,131 @@ func TestSignerMarshalling(t *testing.T) {
 	}
 }
 
+func TestSignerUnmarshallingGoldenFiles(t *testing.T) {
+	testData, err := testdata.LoadKeygenTestFixtures(1)
+	if err != nil {
+		t.Fatalf("failed to load test data: [%v]", err)
+	}
+
+	groupMemberIDs := []MemberID{
+		MemberID([]byte("member-0")),
+		MemberID([]byte("member-1")),
+		MemberID([]byte("member-2")),
+	}
+
+	expectedGroupInfo := &groupInfo{
+		groupID:            "golden-group",
+		memberID:           groupMemberIDs[0],
+		groupMemberIDs:     groupMemberIDs,
+		dishonestThreshold: 2,
+	}
+
+	var tests = map[string]struct {
+		formatVersion     uint32
+		expectedChainName string
+		expectedCreatedAt time.Time
+	}{
+		"legacy format": {
+			formatVersion: 1,
+		},
+		"format version 2": {
+			formatVersion:     2,
+			expectedChainName: "ethereum",
+			expectedCreatedAt: time.Unix(1633046400, 0),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			goldenFile, err := testdata.LoadSignerGoldenFile(test.formatVersion)
+			if err != nil {
+				t.Fatalf("failed to load golden file: [%v]", err)
+			}
+
+			formatVersion, err := EncodedSignerFormatVersion(goldenFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if formatVersion != test.formatVersion {
+				t.Errorf(
+					"unexpected format version\nexpected: [%v]\nactual:   [%v]\n",
+					test.formatVersion,
+					formatVersion,
+				)
+			}
+
+			expectedSigner := &ThresholdSigner{
+				groupInfo:    expectedGroupInfo,
+				thresholdKey: ThresholdKey(testData[0]),
+				protocol:     GG19,
+				chainName:    test.expectedChainName,
+				createdAt:    test.expectedCreatedAt,
+			}
+
+			signer := &ThresholdSigner{}
+			if err := signer.Unmarshal(goldenFile); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(expectedSigner, signer) {
+				t.Fatalf(
+					"unexpected content of unmarshaled signer\nexpected: [%+v]\nactual:   [%+v]\n",
+					expectedSigner,
+					signer,
+				)
+			}
+
+			remarshaled, err := signer.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			formatVersion, err = EncodedSignerFormatVersion(remarshaled)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if formatVersion != SignerFormatVersion {
+				t.Errorf(
+					"unexpected format version of remarshaled signer\n"+
+						"expected: [%v]\nactual:   [%v]\n",
+					SignerFormatVersion,
+					formatVersion,
+				)
+			}
+
+			// The encoding of the current format version must not change.
+			if test.formatVersion == SignerFormatVersion &&
+				!bytes.Equal(goldenFile, remarshaled) {
+				t.Errorf("remarshaled signer does not match the golden file")
+			}
+		})
+	}
+}
+
+func TestSignerUnmarshallingNewerFormatVersion(t *testing.T) {
+	encoded, err := (&pb.ThresholdSignerEnvelope{
+		FormatVersion: SignerFormatVersion + 1,
+	}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signer := &ThresholdSigner{}
+	err = signer.Unmarshal(encoded)
+	if err == nil {
+		t.Fatal("expected error for newer format version")
+	}
+
+	expectedError := "signer format version [3] is newer than the " +
+		"supported version [2]"
+	if !strings.Contains(err.Error(), expectedError) {
+		t.Errorf(
+			"unexpected error\nexpected: [%v]\nactual:   [%v]\n",
+			expectedError,
+			err,
+		)
+	}
+}
+
 func TestThresholdKeyMarshalling(t *testing.T) {
 	testData, err := testdata.LoadKeygenTestFixtures(1)
 	if err != nil {
@@ -112,7 +244,8 @@ func TestFuzzTSSProtocolMessageUnmarshaler(t *testing.T) {
 
 func TestReadyMessageMarshalling(t *testing.T) {
 	msg := &ReadyMessage{
-		SenderID: MemberID([]byte("member-1")),
+		SenderID:  MemberID([]byte("member-1")),
+		SessionID: "session-1",
 	}
 
 	unmarshaled := &ReadyMessage{}
@@ -146,7 +279,8 @@ func TestFuzzReadyMessageUnmarshaler(t *testing.T) {
 
 func TestAnnounceMessageMarshalling(t *testing.T) {
 	msg := &AnnounceMessage{
-		SenderID: MemberID([]byte("member-1")),
+		SenderID:  MemberID([]byte("member-1")),
+		SessionID: "session-1",
 	}
 
 	unmarshaled := &AnnounceMessage{}
@@ -194,6 +328,7 @@ func TestLiquidationRecoveryAnnounceMessageMarshalling(t *testing.T) {
 		SenderID:           MemberID([]byte("member-1")),
 		BtcRecoveryAddress: "bcrt1qgvlmm6pe4epm7j3mjwkvdf2ymymu8tes04t6cr",
 		MaxFeePerVByte:     300,
+		SessionID:          "session-1",
 	}
 
 	unmarshaled := &LiquidationRecoveryAnnounceMessage{}
@@ -21,7 +21,8 @@ func (m *ProtocolMessage) Type() string {
 // ReadyMessage is a network message used to notify peer members about readiness
 // to start protocol execution.
 type ReadyMessage struct {
-	SenderID MemberID
+	SenderID  MemberID
+	SessionID string
 }
 
 // Type returns a string type of the `ReadyMessage`.
@@ -31,7 +32,8 @@ func (m *ReadyMessage) Type() string {
 
 // AnnounceMessage is a network message used to announce peer's presence.
 type AnnounceMessage struct {
-	SenderID MemberID
+	SenderID  MemberID
+	SessionID string
 }
 
 // Type returns a string type of the `AnnounceMessage`.
@@ -45,6 +47,7 @@ type LiquidationRecoveryAnnounceMessage struct {
 	SenderID           MemberID
 	BtcRecoveryAddress string
 	MaxFeePerVByte     int32
+	SessionID          string
 }
 
 // Type returns a string type of the `LiquidationRecoveryAnnounceMessage` so
@@ -0,0 +1,114 @@
+package tss
+
+import (
+	"fmt"
+
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss/gen/pb"
+)
+
+const (
+	// legacySignerFormatVersion is the format version of signers marshalled
+	// as a bare ThresholdSigner protobuf, before the format version has been
+	// recorded.
+	legacySignerFormatVersion uint32 = 1
+
+	// SignerFormatVersion is the format version of signers marshalled by
+	// this client. Signers marshalled with older format versions are migrated
+	// to this version when unmarshalled.
+	SignerFormatVersion uint32 = 2
+)
+
+// curveName is the name of the elliptic curve of threshold keys recorded in
+// marshalled signers.
+const curveName = "secp256k1"
+
+// signerMigration converts a signer encoded with one format version to
+// the encoding of the next format version.
+type signerMigration func(bytes []byte) ([]byte, error)
+
+// signerMigrations holds migrations of encoded signers registered by the
+// format version they migrate from. Each format version older than the current
+// one must have a migration registered.
+var signerMigrations = map[uint32]signerMigration{
+	legacySignerFormatVersion: migrateSignerFromLegacyFormat,
+}
+
+// EncodedSignerFormatVersion returns the format version of the given signer
+// encoding.
+func EncodedSignerFormatVersion(bytes []byte) (uint32, error) {
+	envelope := pb.ThresholdSignerEnvelope{}
+	if err := envelope.Unmarshal(bytes); err != nil ||
+		envelope.GetFormatVersion() == 0 {
+		// Bare ThresholdSigner encodings never decode as an envelope as their
+		// first field has a different wire type than the format version.
+		legacySigner := pb.ThresholdSigner{}
+		if err := legacySigner.Unmarshal(bytes); err != nil {
+			return 0, fmt.Errorf("unknown signer encoding: [%v]", err)
+		}
+
+		return legacySignerFormatVersion, nil
+	}
+
+	if envelope.GetFormatVersion() > SignerFormatVersion {
+		return 0, fmt.Errorf(
+			"signer format version [%d] is newer than the supported "+
+				"version [%d]",
+			envelope.GetFormatVersion(),
+			SignerFormatVersion,
+		)
+	}
+
+	return envelope.GetFormatVersion(), nil
+}
+
+// migrateSigner converts the signer encoding to the current format version,
+// applying migrations registered for all format versions in between.
+func migrateSigner(bytes []byte) ([]byte, error) {
+	version, err := EncodedSignerFormatVersion(bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	for ; version < SignerFormatVersion; version++ {
+		migration, ok := signerMigrations[version]
+		if !ok {
+			return nil, fmt.Errorf(
+				"no migration registered for signer format version [%d]",
+				version,
+			)
+		}
+
+		bytes, err = migration(bytes)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to migrate signer from format version [%d]: [%v]",
+				version,
+				err,
+			)
+		}
+	}
+
+	return bytes, nil
+}
+
+// migrateSignerFromLegacyFormat wraps a bare ThresholdSigner in an envelope.
+// Legacy encodings do not record the chain name and the creation time, so
+// they are left empty. Legacy signers were generated with the default protocol.
+func migrateSignerFromLegacyFormat(bytes []byte) ([]byte, error) {
+	pbSigner := pb.ThresholdSigner{}
+	if err := pbSigner.Unmarshal(bytes); err != nil {
+		return nil, err
+	}
+
+	signerBytes, err := pbSigner.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return (&pb.ThresholdSignerEnvelope{
+		FormatVersion: legacySignerFormatVersion + 1,
+		Protocol:      DefaultProtocol,
+		Curve:         curveName,
+		Signer:        signerBytes,
+	}).Marshal()
+}
@@ -1,6 +1,7 @@
 package tss
 
 import (
+	"bytes"
 	"context"
 	cecdsa "crypto/ecdsa"
 	"fmt"
@@ -18,10 +19,16 @@ const (
 
 // networkBridge translates TSS library network interface to unicast and
 // broadcast channels provided by our net abstraction.
+//
+// Channels are shared by all protocol sessions executed concurrently for
+// the group, e.g. when several digests are signed at the same time. Each
+// session has its own bridge which multiplexes the shared channels by picking
+// only messages of its session as they are received.
 type networkBridge struct {
 	networkProvider net.Provider
 
 	groupInfo *groupInfo
+	session   *session
 
 	channelsMutex    *sync.Mutex
 	broadcastChannel net.BroadcastChannel
@@ -29,37 +36,56 @@ type networkBridge struct {
 
 	tssMessageHandlersMutex *sync.Mutex
 	tssMessageHandlers      []tssMessageHandler
+
+	receivedMessagesMutex *sync.Mutex
+	receivedMessages      map[string]int
 }
 
 type tssMessageHandler func(netMsg *ProtocolMessage) error
 
-// newNetworkBridge initializes a new network bridge for the given network provider.
+// newNetworkBridge initializes a new network bridge for the given network
+// provider. Protocol messages are exchanged within the given session, messages
+// of other sessions are dropped.
 func newNetworkBridge(
 	groupInfo *groupInfo,
+	session *session,
 	networkProvider net.Provider,
 ) (*networkBridge, error) {
 	networkBridge := &networkBridge{
 		networkProvider: networkProvider,
 		groupInfo:       groupInfo,
+		session:         session,
 
 		channelsMutex:   &sync.Mutex{},
 		unicastChannels: make(map[net.TransportIdentifier]net.UnicastChannel),
 
 		tssMessageHandlersMutex: &sync.Mutex{},
 		tssMessageHandlers:      []tssMessageHandler{},
+
+		receivedMessagesMutex: &sync.Mutex{},
+		receivedMessages:      make(map[string]int),
 	}
 
 	return networkBridge, nil
 }
 
+// connect connects the party with other group members. Protocol errors which
+// identify culprits are written to the given error channel as they make it
+// impossible to complete the protocol.
 func (b *networkBridge) connect(
 	ctx context.Context,
 	tssOutChan <-chan tss.Message,
+	tssErrChan chan<- *tss.Error,
 	party tss.Party,
 	sortedPartyIDs tss.SortedPartyIDs,
 ) error {
 	netInChan := make(chan *ProtocolMessage, len(b.groupInfo.groupMemberIDs))
 
+	// The handler has to be registered before channels are initialized.
+	// Otherwise, messages received in the meantime would not be handled and,
+	// as broadcast retransmissions are filtered out, would never be handled.
+	b.registerProtocolMessageHandler(party, sortedPartyIDs, tssErrChan)
+
 	if err := b.initializeChannels(ctx, netInChan); err != nil {
 		return fmt.Errorf("failed to initialize channels: [%v]", err)
 	}
@@ -77,8 +103,6 @@ func (b *networkBridge) connect(
 		}
 	}()
 
-	b.registerProtocolMessageHandler(party, sortedPartyIDs)
-
 	return nil
 }
 
@@ -86,10 +110,31 @@ func (b *networkBridge) initializeChannels(
 	ctx context.Context,
 	netInChan chan *ProtocolMessage,
 ) error {
-	handleFn := func(msg net.Message) {
-		switch protocolMessage := msg.Payload().(type) {
-		case *ProtocolMessage:
-			netInChan <- protocolMessage
+	// Messages claiming to come from another member are rejected so that
+	// a member cannot make protocol failures attributed to someone else.
+	handleFnFor := func(
+		isSentBy func(msg net.Message, senderID MemberID) bool,
+	) func(msg net.Message) {
+		return func(msg net.Message) {
+			switch protocolMessage := msg.Payload().(type) {
+			case *ProtocolMessage:
+				// Messages of other sessions are handled by bridges of
+				// those sessions.
+				if !b.session.accepts(protocolMessage.SessionID) {
+					return
+				}
+
+				if !isSentBy(msg, protocolMessage.SenderID) {
+					logger.Warningf(
+						"rejecting protocol message; sender [%x] does not "+
+							"match the transport sender",
+						protocolMessage.SenderID,
+					)
+					return
+				}
+
+				netInChan <- protocolMessage
+			}
 		}
 	}
 
@@ -99,7 +144,7 @@ func (b *networkBridge) initializeChannels(
 		return fmt.Errorf("failed to get broadcast channel: [%v]", err)
 	}
 
-	broadcastChannel.Recv(ctx, handleFn)
+	broadcastChannel.Recv(ctx, handleFnFor(isBroadcastSentBy))
 
 	// Initialize unicast channels.
 	for _, peerMemberID := range b.groupInfo.groupMemberIDs {
@@ -121,7 +166,14 @@ func (b *networkBridge) initializeChannels(
 			return fmt.Errorf("failed to get unicast channel: [%v]", err)
 		}
 
-		unicastChannel.Recv(ctx, handleFn)
+		// Unicast channel is established with a single peer so all messages
+		// received over it have to be sent by that peer.
+		unicastPeerMemberID := peerMemberID
+		unicastChannel.Recv(ctx, handleFnFor(
+			func(msg net.Message, senderID MemberID) bool {
+				return senderID.Equal(unicastPeerMemberID)
+			},
+		))
 	}
 
 	return nil
@@ -259,7 +311,7 @@ func (b *networkBridge) sendTSSMessage(
 		SenderID:    routing.From.GetKey(),
 		Payload:     bytes,
 		IsBroadcast: routing.IsBroadcast,
-		SessionID:   b.groupInfo.groupID,
+		SessionID:   b.session.messageID(),
 	}
 
 	if routing.To == nil {
@@ -340,24 +392,39 @@ func (b *networkBridge) sendTo(
 func (b *networkBridge) registerProtocolMessageHandler(
 	party tss.Party,
 	sortedPartyIDs tss.SortedPartyIDs,
+	tssErrChan chan<- *tss.Error,
 ) {
 	handler := func(protocolMessage *ProtocolMessage) error {
-		if protocolMessage.SessionID != b.groupInfo.groupID {
-			return nil
-		}
-
 		senderPartyID := sortedPartyIDs.FindByKey(protocolMessage.SenderID.bigInt())
 
+		if senderPartyID == nil {
+			return fmt.Errorf(
+				"sender [%v] is not a member of the group",
+				protocolMessage.SenderID,
+			)
+		}
+
 		if senderPartyID == party.PartyID() {
 			return nil
 		}
 
+		b.countReceivedMessage(senderPartyID)
+
 		_, err := party.UpdateFromBytes(
 			protocolMessage.Payload,
 			senderPartyID,
 			protocolMessage.IsBroadcast,
 		)
 		if err != nil {
+			if len(err.Culprits()) > 0 {
+				select {
+				case tssErrChan <- err:
+				default:
+					// The protocol is failing already because of another
+					// error.
+				}
+			}
+
 			return fmt.Errorf("failed to update party: [%v]", party.WrapError(err))
 		}
 
@@ -370,6 +437,48 @@ func (b *networkBridge) registerProtocolMessageHandler(
 	b.tssMessageHandlers = append(b.tssMessageHandlers, handler)
 }
 
+func (b *networkBridge) countReceivedMessage(senderPartyID *tss.PartyID) {
+	b.receivedMessagesMutex.Lock()
+	defer b.receivedMessagesMutex.Unlock()
+
+	b.receivedMessages[senderPartyID.GetId()]++
+}
+
+// laggingParties narrows down parties the party is waiting for. The party
+// stops checking messages of the current round at the first missing one, so
+// it reports all subsequent parties, including itself, as pending even if
+// their messages have been received. The first pending peer is missing for
+// sure, subsequent peers only if they sent fewer messages than the most
+// advanced peer. A peer missing a message of the current round may still be
+// as advanced as others if it continued with the next round. If nothing has
+// been received from peers, all of them are returned.
+func (b *networkBridge) laggingParties(waitingFor []*tss.PartyID) []*tss.PartyID {
+	b.receivedMessagesMutex.Lock()
+	defer b.receivedMessagesMutex.Unlock()
+
+	mostReceived := 0
+	for _, count := range b.receivedMessages {
+		if count > mostReceived {
+			mostReceived = count
+		}
+	}
+
+	lagging := []*tss.PartyID{}
+	for _, partyID := range waitingFor {
+		if partyID.GetId() == b.groupInfo.memberID.String() {
+			continue
+		}
+
+		if len(lagging) == 0 ||
+			mostReceived == 0 ||
+			b.receivedMessages[partyID.GetId()] < mostReceived {
+			lagging = append(lagging, partyID)
+		}
+	}
+
+	return lagging
+}
+
 func (b *networkBridge) handleTSSProtocolMessage(protocolMessage *ProtocolMessage) {
 	b.tssMessageHandlersMutex.Lock()
 	defer b.tssMessageHandlersMutex.Unlock()
@@ -380,3 +489,10 @@ func (b *networkBridge) handleTSSProtocolMessage(protocolMessage *ProtocolMessag
 		}
 	}
 }
+
+// isBroadcastSentBy checks if the broadcast message has been sent by the given
+// member. Member ID and the sender public key of the message are both
+// uncompressed public keys of the member.
+func isBroadcastSentBy(msg net.Message, memberID MemberID) bool {
+	return bytes.Equal(msg.SenderPublicKey(), memberID)
+}
@@ -0,0 +1,243 @@
+package tss
+
+import (
+	"context"
+	cecdsa "crypto/ecdsa"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/binance-chain/tss-lib/tss"
+	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-core/pkg/net/key"
+)
+
+func TestLaggingParties(t *testing.T) {
+	memberIDs, err := generateMemberKeys(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, partyIDs, err := generatePartiesIDs(memberIDs[0], memberIDs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = map[string]struct {
+		receivedMessages map[int]int
+		waitingFor       []int
+		expectedLagging  []int
+	}{
+		"peers behind the most advanced one": {
+			receivedMessages: map[int]int{1: 2, 2: 1, 3: 1},
+			waitingFor:       []int{0, 2, 3},
+			expectedLagging:  []int{2, 3},
+		},
+		"peers reported after the first missing one": {
+			receivedMessages: map[int]int{2: 1, 3: 1},
+			waitingFor:       []int{1, 2, 3},
+			expectedLagging:  []int{1},
+		},
+		"peers equally advanced": {
+			receivedMessages: map[int]int{1: 1, 2: 1, 3: 1},
+			waitingFor:       []int{0, 1, 3},
+			expectedLagging:  []int{1},
+		},
+		"first missing peer as advanced as others": {
+			receivedMessages: map[int]int{1: 2, 2: 2, 3: 1},
+			waitingFor:       []int{1, 2, 3},
+			expectedLagging:  []int{1, 3},
+		},
+		"nothing received from peers": {
+			receivedMessages: map[int]int{},
+			waitingFor:       []int{1, 2, 3},
+			expectedLagging:  []int{1, 2, 3},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			bridge, err := newNetworkBridge(
+				&groupInfo{
+					memberID:       memberIDs[0],
+					groupMemberIDs: memberIDs,
+				},
+				nil,
+				nil,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for index, count := range test.receivedMessages {
+				for i := 0; i < count; i++ {
+					bridge.countReceivedMessage(partyIDs[index])
+				}
+			}
+
+			waitingFor := []*tss.PartyID{}
+			for _, index := range test.waitingFor {
+				waitingFor = append(waitingFor, partyIDs[index])
+			}
+
+			expectedLagging := []*tss.PartyID{}
+			for _, index := range test.expectedLagging {
+				expectedLagging = append(expectedLagging, partyIDs[index])
+			}
+
+			lagging := bridge.laggingParties(waitingFor)
+
+			if !reflect.DeepEqual(expectedLagging, lagging) {
+				t.Errorf(
+					"unexpected lagging parties\nexpected: [%v]\nactual:   [%v]",
+					expectedLagging,
+					lagging,
+				)
+			}
+		})
+	}
+}
+
+func TestNetworkBridgeSessionMultiplexing(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	memberIDs, err := generateMemberKeys(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	networkProviders := make([]net.Provider, len(memberIDs))
+	for i, memberID := range memberIDs {
+		memberPublicKey, err := memberID.PublicKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		networkPublicKey := key.NetworkPublic(*memberPublicKey)
+		networkProviders[i] = newTestNetProvider(&networkPublicKey)
+	}
+
+	groupID := "0xmultiplexing-test"
+	sessions := []*session{
+		newSession(groupID, SigningProtocol, []byte{1}, 1),
+		newSession(groupID, SigningProtocol, []byte{2}, 1),
+	}
+
+	newBridge := func(memberIndex int, session *session) *networkBridge {
+		bridge, err := newNetworkBridge(
+			&groupInfo{
+				groupID:        groupID,
+				memberID:       memberIDs[memberIndex],
+				groupMemberIDs: memberIDs,
+			},
+			session,
+			networkProviders[memberIndex],
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return bridge
+	}
+
+	// Both sessions of the first member receive messages over the same
+	// channels.
+	inChans := make([]chan *ProtocolMessage, len(sessions))
+	for i, session := range sessions {
+		inChans[i] = make(chan *ProtocolMessage, 10)
+
+		if err := newBridge(0, session).initializeChannels(
+			ctx,
+			inChans[i],
+		); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The second member sends a broadcast and a unicast message in each
+	// session.
+	receiverTransportID, err := networkProviders[1].CreateTransportIdentifier(
+		*mustPublicKey(t, memberIDs[0]),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, session := range sessions {
+		bridge := newBridge(1, session)
+
+		if err := bridge.broadcast(ctx, &ProtocolMessage{
+			SenderID:    memberIDs[1],
+			Payload:     []byte(session.ID() + "/broadcast"),
+			IsBroadcast: true,
+			SessionID:   session.ID(),
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := bridge.sendTo(receiverTransportID, &ProtocolMessage{
+			SenderID:  memberIDs[1],
+			Payload:   []byte(session.ID() + "/unicast"),
+			SessionID: session.ID(),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Give the channels time to deliver all messages, including the ones
+	// which should be dropped.
+	<-ctx.Done()
+
+	for i, session := range sessions {
+		receivedPayloads := map[string]bool{}
+
+	drain:
+		for {
+			select {
+			case message := <-inChans[i]:
+				if message.SessionID != session.ID() {
+					t.Errorf(
+						"session [%s] received message of session [%s]",
+						session.ID(),
+						message.SessionID,
+					)
+				}
+				receivedPayloads[string(message.Payload)] = true
+			default:
+				break drain
+			}
+		}
+
+		payloads := []string{}
+		for payload := range receivedPayloads {
+			payloads = append(payloads, payload)
+		}
+		sort.Strings(payloads)
+
+		expectedPayloads := []string{
+			session.ID() + "/broadcast",
+			session.ID() + "/unicast",
+		}
+
+		if !reflect.DeepEqual(expectedPayloads, payloads) {
+			t.Errorf(
+				"unexpected messages of session [%s]\n"+
+					"expected: [%v]\nactual:   [%v]",
+				session.ID(),
+				expectedPayloads,
+				payloads,
+			)
+		}
+	}
+}
+
+func mustPublicKey(t *testing.T, memberID MemberID) *cecdsa.PublicKey {
+	publicKey, err := memberID.PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return publicKey
+}
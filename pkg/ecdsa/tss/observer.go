@@ -0,0 +1,31 @@
+package tss
+
+import (
+	"time"
+)
+
+// PeerObserver is notified about responses of peer members observed during
+// the protocol execution. It allows to track how reliably and how fast peer
+// members take part in protocols. Failures of peer members are not reported
+// to the observer; they are returned as protocol errors instead.
+type PeerObserver interface {
+	// PeerResponded is called once per stage execution when the first
+	// message of the stage has been received from the peer member, with
+	// the time elapsed since the member started the stage.
+	PeerResponded(stage string, peer MemberID, latency time.Duration)
+}
+
+// notifyPeerResponded notifies the observer, if any, about the response of
+// the peer member.
+func notifyPeerResponded(
+	observer PeerObserver,
+	stage string,
+	peer MemberID,
+	stageStart time.Time,
+) {
+	if observer == nil {
+		return
+	}
+
+	observer.PeerResponded(stage, peer, time.Since(stageStart))
+}
@@ -0,0 +1,53 @@
+package tss
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/net"
+)
+
+// ProbeMember verifies if a unicast channel with the member can be
+// established. If the member is not connected yet, it is dialed using
+// addresses known to the network provider. The attempt is retried the given
+// number of times, waiting the given time between attempts.
+//
+// The network provider caches unicast channels per peer, so the channel
+// established by the probe is not closed; it is the channel protocols
+// executed later with the member use.
+func ProbeMember(
+	networkProvider net.Provider,
+	memberID MemberID,
+	retryCount int,
+	retryWaitTime time.Duration,
+) error {
+	publicKey, err := memberID.PublicKey()
+	if err != nil {
+		return fmt.Errorf("failed to get member public key: [%v]", err)
+	}
+
+	transportID, err := networkProvider.CreateTransportIdentifier(*publicKey)
+	if err != nil {
+		return fmt.Errorf("failed to get transport identifier: [%v]", err)
+	}
+
+	for i := 0; i < retryCount+1; i++ {
+		if i > 0 {
+			time.Sleep(retryWaitTime)
+		}
+
+		_, err = networkProvider.UnicastChannelWith(transportID)
+		if err == nil {
+			return nil
+		}
+
+		logger.Warningf(
+			"failed to get unicast channel with member [%v] "+
+				"because of: [%v]",
+			memberID,
+			err,
+		)
+	}
+
+	return fmt.Errorf("failed to get unicast channel: [%v]", err)
+}
@@ -0,0 +1,21 @@
+package tss
+
+// Names of threshold ECDSA protocols. The name of the protocol used to
+// generate a key is recorded in the marshalled signer, so the signer keeps
+// using the same protocol for signing.
+const (
+	// GG19 is the protocol of Gennaro and Goldfeder implemented by tss-lib.
+	// It requires safe-prime pre-parameters for each key generation.
+	GG19 = "gg19"
+)
+
+// DefaultProtocol is the protocol used to generate keys of new keeps. It is
+// also assumed for signers marshalled before the protocol has been recorded.
+const DefaultProtocol = GG19
+
+// isSupportedProtocol determines whether the client can sign with keys
+// generated with the protocol of the given name. An empty name denotes
+// the default protocol.
+func isSupportedProtocol(name string) bool {
+	return name == "" || name == DefaultProtocol
+}
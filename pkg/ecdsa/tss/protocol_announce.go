@@ -14,20 +14,53 @@ import (
 
 const protocolAnnounceTimeout = 2 * time.Minute
 
-// AnnounceProtocol announces a client to the other clients in the keep network
+// AnnounceTimeoutError is returned by the announce protocol when not all keep
+// members announced their presence before the timeout.
+type AnnounceTimeoutError struct {
+	Timeout          time.Duration
+	MissingOperators []chain.ID
+}
+
+func (ate *AnnounceTimeoutError) Error() string {
+	missingOperators := []string{}
+	for _, operatorID := range ate.MissingOperators {
+		missingOperators = append(missingOperators, operatorID.String())
+	}
+
+	return fmt.Sprintf(
+		"waiting for announcements timed out after: [%v]; "+
+			"missing operators: [%s]",
+		ate.Timeout,
+		strings.Join(missingOperators, ", "),
+	)
+}
+
+// AnnounceProtocol announces a client to the other clients in the keep network.
+// The announcement is scoped to the given attempt of the given protocol
+// executed for the keep, announcements of other sessions are dropped. If a
+// keep member announces its presence for a later attempt of the protocol,
+// the function returns a SessionBehindError. The observer, if given, is
+// notified about the first announcement received from each peer member.
 func AnnounceProtocol(
 	parentCtx context.Context,
 	publicKey *operator.PublicKey,
 	keepID chain.ID,
+	protocol string,
+	attempt int,
 	keepMemberIDs []chain.ID,
 	broadcastChannel net.BroadcastChannel,
 	publicKeyToOperatorIDFunc func(*cecdsa.PublicKey) chain.ID,
+	observer PeerObserver,
 ) (
 	[]MemberID,
 	error,
 ) {
 	logger.Infof("announcing presence")
 
+	stageStart := time.Now()
+	ownMemberID := MemberIDFromPublicKey(publicKey)
+	session := newSession(keepID.String(), protocol, nil, attempt)
+
 	ctx, cancel := context.WithTimeout(parentCtx, protocolAnnounceTimeout)
 	defer cancel()
 
@@ -50,14 +83,36 @@ func AnnounceProtocol(
 		return ok
 	}
 
+	laterAttempt := 0
+
+	// Received announcements are read only once the goroutine collecting
+	// them has exited.
+	receiveDone := make(chan struct{})
 	go func() {
+		defer close(receiveDone)
+
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case msg := <-announceInChan:
+				// Announcements picked after the context is done are ignored.
+				if ctx.Err() != nil {
+					return
+				}
+
 				// Since broadcast channel has an address filter, we can
 				// assume each message come from a valid group member.
+				relation, attempt := session.relationTo(msg.SessionID)
+				if relation == laterSession {
+					laterAttempt = attempt
+					cancel()
+					continue
+				}
+				if relation != currentSession && relation != legacySession {
+					continue
+				}
+
 				publicKey, err := msg.SenderID.PublicKey()
 				if err != nil {
 					logger.Errorf(
@@ -70,6 +125,19 @@ func AnnounceProtocol(
 				}
 
 				operatorID := publicKeyToOperatorIDFunc(publicKey)
+				if hasAnnounced(operatorID) {
+					continue
+				}
+
+				if !msg.SenderID.Equal(ownMemberID) {
+					notifyPeerResponded(
+						observer,
+						AnnounceStage,
+						msg.SenderID,
+						stageStart,
+					)
+				}
+
 				logger.Infof(
 					"member [%s] from keep [%s] announced its presence",
 					operatorID,
@@ -88,7 +156,8 @@ func AnnounceProtocol(
 		sendMessage := func() {
 			if err := broadcastChannel.Send(ctx,
 				&AnnounceMessage{
-					SenderID: MemberIDFromPublicKey(publicKey),
+					SenderID:  ownMemberID,
+					SessionID: session.ID(),
 				},
 			); err != nil {
 				logger.Errorf("failed to send announcement: [%v]", err)
@@ -109,11 +178,14 @@ func AnnounceProtocol(
 	}()
 
 	<-ctx.Done()
+	<-receiveDone
 
 	switch ctx.Err() {
 	case context.DeadlineExceeded:
+		missingOperators := []chain.ID{}
 		for _, member := range keepMemberIDs {
 			if !hasAnnounced(member) {
+				missingOperators = append(missingOperators, member)
 				logger.Errorf(
 					"member [%s] has not announced its presence for keep [%s]; "+
 						"check if keep client for that operator is active and "+
@@ -123,11 +195,15 @@ func AnnounceProtocol(
 				)
 			}
 		}
-		return nil, fmt.Errorf(
-			"waiting for announcements timed out after: [%v]",
-			protocolAnnounceTimeout,
-		)
+		return nil, &AnnounceTimeoutError{
+			Timeout:          protocolAnnounceTimeout,
+			MissingOperators: missingOperators,
+		}
 	case context.Canceled:
+		if laterAttempt > 0 {
+			return nil, &SessionBehindError{Attempt: laterAttempt}
+		}
+
 		logger.Infof("announce protocol completed successfully")
 
 		memberIDs := make([]MemberID, 0)
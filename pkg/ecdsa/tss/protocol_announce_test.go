@@ -67,6 +67,11 @@ func TestAnnounceProtocol(t *testing.T) {
 	mutex := &sync.RWMutex{}
 	result := make(map[string][]MemberID)
 
+	observers := make(map[string]*testPeerObserver)
+	for _, memberID := range groupMembers {
+		observers[memberID.String()] = newTestPeerObserver()
+	}
+
 	for _, memberID := range groupMembers {
 		go func(memberID MemberID) {
 			memberPublicKey, err := memberID.PublicKey()
@@ -94,9 +99,12 @@ func TestAnnounceProtocol(t *testing.T) {
 				ctx,
 				memberPublicKey,
 				keep.ID(),
+				KeyGenerationProtocol,
+				1,
 				keepMembers,
 				broadcastChannel,
 				localChain.PublicKeyToOperatorID,
+				observers[memberID.String()],
 			)
 			if err != nil {
 				errChan <- err
@@ -146,6 +154,13 @@ func TestAnnounceProtocol(t *testing.T) {
 			} else {
 				t.Errorf("missing result for member [%v]", memberID)
 			}
+
+			observers[memberID.String()].assertResponded(
+				t,
+				AnnounceStage,
+				memberID,
+				groupMembers,
+			)
 		}
 	case err := <-errChan:
 		t.Fatal(err)
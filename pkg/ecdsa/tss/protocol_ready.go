@@ -20,15 +20,26 @@ const protocolReadyTimeout = 2 * time.Minute
 // until they receive messages from all peer members. Function exits without an
 // error if messages were received from all peer members. If the timeout is
 // reached before receiving messages from all peer members the function returns
-// an error.
+// an error. The observer, if given, is notified about the first message
+// received from each peer member.
+//
+// Messages of other sessions are dropped. If a peer member signals readiness
+// for a later attempt of the protocol, the function returns
+// a SessionBehindError. If a peer member of a previous client version signals
+// readiness, the session is switched to the keep identifier that member
+// expects in protocol messages.
 func readyProtocol(
 	parentCtx context.Context,
 	group *groupInfo,
+	session *session,
 	broadcastChannel net.BroadcastChannel,
 	publicKeyToAddressFn func(cecdsa.PublicKey) []byte,
+	observer PeerObserver,
 ) error {
 	logger.Infof("signalling readiness")
 
+	stageStart := time.Now()
+
 	ctx, cancel := context.WithTimeout(parentCtx, protocolReadyTimeout)
 	defer cancel()
 
@@ -42,13 +53,36 @@ func readyProtocol(
 	broadcastChannel.Recv(ctx, handleReadyMessage)
 
 	readyMembers := make(map[string]bool) // member address -> ready?
+	laterAttempt := 0
+	legacyMembers := false
+
+	// The receiving goroutine is the only one accessing the collected
+	// messages until it exits; they are read only after it has exited.
+	receiveDone := make(chan struct{})
 	go func() {
+		defer close(receiveDone)
 
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case msg := <-readyInChan:
+				// Select picks a random ready case, so a message can be picked
+				// after the context is done. It is not handled anymore.
+				if ctx.Err() != nil {
+					return
+				}
+
+				relation, attempt := session.relationTo(msg.SessionID)
+				if relation == laterSession && group.isMember(msg.SenderID) {
+					laterAttempt = attempt
+					cancel()
+					continue
+				}
+				if relation != currentSession && relation != legacySession {
+					continue
+				}
+
 				for _, memberID := range group.groupMemberIDs {
 					if msg.SenderID.Equal(memberID) {
 						memberAddress, err := memberIDToAddress(
@@ -64,8 +98,31 @@ func readyProtocol(
 							)
 							break
 						}
+						if readyMembers[memberAddress] {
+							break
+						}
 						readyMembers[memberAddress] = true
 
+						if relation == legacySession {
+							logger.Warningf(
+								"member [%s] from keep [%s] runs a previous "+
+									"client version; protocol messages are "+
+									"sent with the keep identifier",
+								memberAddress,
+								group.groupID,
+							)
+							legacyMembers = true
+						}
+
+						if !memberID.Equal(group.memberID) {
+							notifyPeerResponded(
+								observer,
+								ReadinessStage,
+								memberID,
+								stageStart,
+							)
+						}
+
 						logger.Infof(
 							"member [%s] from keep [%s] announced its readiness",
 							memberAddress,
@@ -86,7 +143,10 @@ func readyProtocol(
 	go func() {
 		sendMessage := func() {
 			if err := broadcastChannel.Send(ctx,
-				&ReadyMessage{SenderID: group.memberID},
+				&ReadyMessage{
+					SenderID:  group.memberID,
+					SessionID: session.ID(),
+				},
 			); err != nil {
 				logger.Errorf("failed to send readiness notification: [%v]", err)
 			}
@@ -106,9 +166,15 @@ func readyProtocol(
 	}()
 
 	<-ctx.Done()
+	<-receiveDone
+
+	if legacyMembers {
+		session.useLegacyID()
+	}
 
 	switch ctx.Err() {
 	case context.DeadlineExceeded:
+		missingMembers := []MemberID{}
 		for _, memberID := range group.groupMemberIDs {
 			memberAddress, err := memberIDToAddress(memberID, publicKeyToAddressFn)
 			if err != nil {
@@ -121,6 +187,7 @@ func readyProtocol(
 				continue
 			}
 			if !readyMembers[memberAddress] {
+				missingMembers = append(missingMembers, memberID)
 				logger.Errorf(
 					"member [%s] has not announced its readiness for keep [%s]; "+
 						"check if keep client for that operator is active and "+
@@ -130,10 +197,16 @@ func readyProtocol(
 				)
 			}
 		}
-		return fmt.Errorf(
-			"waiting for readiness timed out after: [%v]", protocolReadyTimeout,
-		)
+		return &ProtocolError{
+			Stage:          ReadinessStage,
+			Timeout:        protocolReadyTimeout,
+			MissingMembers: missingMembers,
+		}
 	case context.Canceled:
+		if laterAttempt > 0 {
+			return &SessionBehindError{Attempt: laterAttempt}
+		}
+
 		logger.Infof("successfully signalled readiness")
 
 		return nil
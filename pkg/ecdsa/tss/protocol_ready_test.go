@@ -41,6 +41,11 @@ func TestReadyProtocol(t *testing.T) {
 	mutex := &sync.RWMutex{}
 	readyCount := 0
 
+	observers := make(map[string]*testPeerObserver)
+	for _, memberID := range groupMembers {
+		observers[memberID.String()] = newTestPeerObserver()
+	}
+
 	for _, memberID := range groupMembers {
 		go func(memberID MemberID) {
 			groupInfo := &groupInfo{
@@ -73,8 +78,10 @@ func TestReadyProtocol(t *testing.T) {
 			if err := readyProtocol(
 				ctx,
 				groupInfo,
+				newSession("test-group-1", KeyGenerationProtocol, nil, 1),
 				broadcastChannel,
 				pubKeyToAddressFn,
+				observers[memberID.String()],
 			); err != nil {
 				errChan <- err
 				return
@@ -100,8 +107,74 @@ func TestReadyProtocol(t *testing.T) {
 				readyCount,
 			)
 		}
+
+		for _, memberID := range groupMembers {
+			observers[memberID.String()].assertResponded(
+				t,
+				ReadinessStage,
+				memberID,
+				groupMembers,
+			)
+		}
 	case err := <-errChan:
 		t.Fatal(err)
 	}
 
 }
+
+type testPeerObserver struct {
+	mutex     sync.Mutex
+	responded map[string]map[string]int // stage -> member ID -> count
+}
+
+func newTestPeerObserver() *testPeerObserver {
+	return &testPeerObserver{
+		responded: make(map[string]map[string]int),
+	}
+}
+
+func (tpo *testPeerObserver) PeerResponded(
+	stage string,
+	peer MemberID,
+	latency time.Duration,
+) {
+	tpo.mutex.Lock()
+	defer tpo.mutex.Unlock()
+
+	if _, ok := tpo.responded[stage]; !ok {
+		tpo.responded[stage] = make(map[string]int)
+	}
+	tpo.responded[stage][peer.String()]++
+}
+
+// assertResponded checks if the observer of the member has been notified
+// exactly once about each peer member of the group.
+func (tpo *testPeerObserver) assertResponded(
+	t *testing.T,
+	stage string,
+	memberID MemberID,
+	groupMembers []MemberID,
+) {
+	tpo.mutex.Lock()
+	defer tpo.mutex.Unlock()
+
+	for _, peer := range groupMembers {
+		expectedCount := 1
+		if peer.Equal(memberID) {
+			expectedCount = 0
+		}
+
+		count := tpo.responded[stage][peer.String()]
+		if count != expectedCount {
+			t.Errorf(
+				"unexpected number of [%s] responses of peer [%s] "+
+					"observed by member [%s]\nexpected: [%d]\nactual:   [%d]",
+				stage,
+				peer,
+				memberID,
+				expectedCount,
+				count,
+			)
+		}
+	}
+}
@@ -33,12 +33,16 @@ type recoveryInfo struct {
 
 // BroadcastRecoveryAddress broadcasts and receives the BTC recovery addresses
 // of each client so that each client can retrieve the underlying bitcoin in
-// the case that a keep is terminated.
+// the case that a keep is terminated. Attempt number distinguishes retries of
+// the liquidation recovery for the keep, messages of other attempts are
+// dropped. If a peer member broadcasts its address for a later attempt,
+// the function returns a SessionBehindError.
 func BroadcastRecoveryAddress(
 	parentCtx context.Context,
 	btcRecoveryAddress string,
 	maxFeePerVByte int32,
 	groupID string,
+	attempt int,
 	memberID MemberID,
 	groupMemberIDs []MemberID,
 	dishonestThreshold uint,
@@ -55,7 +59,9 @@ func BroadcastRecoveryAddress(
 		dishonestThreshold: int(dishonestThreshold),
 	}
 
-	netBridge, _ := newNetworkBridge(group, networkProvider)
+	session := newSession(groupID, LiquidationRecoveryProtocol, nil, attempt)
+
+	netBridge, _ := newNetworkBridge(group, session, networkProvider)
 	broadcastChannel, _ := netBridge.getBroadcastChannel()
 	ctx, cancel := context.WithTimeout(parentCtx, protocolReadyTimeout)
 	defer cancel()
@@ -70,13 +76,33 @@ func BroadcastRecoveryAddress(
 	broadcastChannel.Recv(ctx, handleLiquidationRecoveryAnnounceMessage)
 
 	memberRecoveryInfo := make(map[string]recoveryInfo)
+	laterAttempt := 0
 
+	// Recovery info is read only once the goroutine collecting it exited.
+	receiveDone := make(chan struct{})
 	go func() {
+		defer close(receiveDone)
+
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case msg := <-msgInChan:
+				// Do not handle messages picked after the context is done.
+				if ctx.Err() != nil {
+					return
+				}
+
+				relation, attempt := session.relationTo(msg.SessionID)
+				if relation == laterSession && group.isMember(msg.SenderID) {
+					laterAttempt = attempt
+					cancel()
+					continue
+				}
+				if relation != currentSession && relation != legacySession {
+					continue
+				}
+
 				for _, memberID := range group.groupMemberIDs {
 					if msg.SenderID.Equal(memberID) {
 						memberAddress, err := memberIDToAddress(
@@ -121,6 +147,7 @@ func BroadcastRecoveryAddress(
 					SenderID:           group.memberID,
 					BtcRecoveryAddress: btcRecoveryAddress,
 					MaxFeePerVByte:     maxFeePerVByte,
+					SessionID:          session.ID(),
 				},
 			); err != nil {
 				logger.Errorf("failed to send btc recovery address: [%v]", err)
@@ -141,6 +168,7 @@ func BroadcastRecoveryAddress(
 	}()
 
 	<-ctx.Done()
+	<-receiveDone
 
 	switch ctx.Err() {
 	case context.DeadlineExceeded:
@@ -169,6 +197,10 @@ func BroadcastRecoveryAddress(
 			"waiting for btc recovery addresses timed out after: [%v]", protocolReadyTimeout,
 		)
 	case context.Canceled:
+		if laterAttempt > 0 {
+			return nil, 0, &SessionBehindError{Attempt: laterAttempt}
+		}
+
 		logger.Infof("successfully gathered all btc addresses")
 
 		retrievalAddresses := make([]string, 0, len(memberRecoveryInfo))
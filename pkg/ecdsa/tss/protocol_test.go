@@ -0,0 +1,39 @@
+package tss
+
+import (
+	"testing"
+)
+
+func TestIsSupportedProtocol(t *testing.T) {
+	var tests = map[string]struct {
+		name              string
+		expectedSupported bool
+	}{
+		"default protocol": {
+			name:              "",
+			expectedSupported: true,
+		},
+		"gg19": {
+			name:              GG19,
+			expectedSupported: true,
+		},
+		"unknown protocol": {
+			name:              "gg18",
+			expectedSupported: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			supported := isSupportedProtocol(test.name)
+
+			if supported != test.expectedSupported {
+				t.Errorf(
+					"unexpected result\nexpected: [%v]\nactual:   [%v]",
+					test.expectedSupported,
+					supported,
+				)
+			}
+		})
+	}
+}
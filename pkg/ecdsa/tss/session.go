@@ -0,0 +1,149 @@
+package tss
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Names of protocols used to derive session identifiers.
+const (
+	KeyGenerationProtocol       = "keygen"
+	SigningProtocol             = "signing"
+	LiquidationRecoveryProtocol = "recovery"
+)
+
+// session identifies a single execution of a protocol for a keep. Channels of
+// a keep are shared by all protocols executed for the keep, so all messages
+// carry the identifier of the session they belong to and messages of other
+// sessions are dropped. This way, messages retransmitted in a previous attempt
+// or sent when signing another digest are never delivered to the current
+// protocol execution.
+//
+// The session identifier replaced the keep identifier carried by protocol
+// messages of previous client versions. To let members of a keep be upgraded
+// one by one, messages carrying no session identifier or the keep identifier
+// are treated as messages of the current session. Once a member of a previous
+// version signals readiness, protocol messages of the session are sent with
+// the keep identifier those members expect. Until all members are upgraded,
+// such sessions cannot tell apart messages of other protocol executions for
+// the keep, just as previous versions could not.
+type session struct {
+	keepID   string
+	protocol string
+	digest   []byte
+	attempt  int
+
+	legacy bool
+}
+
+func newSession(
+	keepID string,
+	protocol string,
+	digest []byte,
+	attempt int,
+) *session {
+	return &session{
+		keepID:   keepID,
+		protocol: protocol,
+		digest:   digest,
+		attempt:  attempt,
+	}
+}
+
+// ID returns the session identifier carried by all messages of the session.
+func (s *session) ID() string {
+	return fmt.Sprintf("%s:%d", s.executionID(), s.attempt)
+}
+
+// messageID returns the session identifier carried by protocol messages sent
+// in the session. It is the keep identifier if members of previous client
+// versions take part in the session.
+func (s *session) messageID() string {
+	if s.legacy {
+		return s.keepID
+	}
+
+	return s.ID()
+}
+
+// useLegacyID makes protocol messages sent in the session carry the keep
+// identifier expected by members of previous client versions. It has to be
+// called before the protocol messages are sent.
+func (s *session) useLegacyID() {
+	s.legacy = true
+}
+
+// executionID identifies the protocol execution regardless of the attempt.
+func (s *session) executionID() string {
+	return fmt.Sprintf(
+		"%s:%s:%s",
+		strings.ToLower(s.keepID),
+		s.protocol,
+		hex.EncodeToString(s.digest),
+	)
+}
+
+// sessionRelation determines how a session identifier of a received message
+// relates to the current session.
+type sessionRelation int
+
+const (
+	// foreignSession is a session of another protocol execution or a previous
+	// attempt of the current one.
+	foreignSession sessionRelation = iota
+	// currentSession is the current session.
+	currentSession
+	// laterSession is a later attempt of the current protocol execution.
+	laterSession
+	// legacySession is the current session identified the way previous client
+	// versions did, with no session identifier or with the keep identifier.
+	legacySession
+)
+
+// relationTo determines how the given session identifier relates to the
+// session. For a later attempt of the protocol execution, the attempt number
+// is returned as well.
+func (s *session) relationTo(sessionID string) (sessionRelation, int) {
+	if sessionID == s.ID() {
+		return currentSession, s.attempt
+	}
+
+	if sessionID == "" || sessionID == s.keepID {
+		return legacySession, s.attempt
+	}
+
+	separatorIndex := strings.LastIndex(sessionID, ":")
+	if separatorIndex < 0 || sessionID[:separatorIndex] != s.executionID() {
+		return foreignSession, 0
+	}
+
+	attempt, err := strconv.Atoi(sessionID[separatorIndex+1:])
+	if err != nil || attempt <= s.attempt {
+		return foreignSession, 0
+	}
+
+	return laterSession, attempt
+}
+
+// accepts determines if a message carrying the given session identifier
+// belongs to the session.
+func (s *session) accepts(sessionID string) bool {
+	relation, _ := s.relationTo(sessionID)
+	return relation == currentSession || relation == legacySession
+}
+
+// SessionBehindError is returned when a peer member has been observed
+// executing a later attempt of the protocol. The member should abandon the
+// current attempt and continue with the attempt of the peer so that members
+// whose attempts drifted apart, e.g. because one of them has been restarted,
+// can meet again.
+type SessionBehindError struct {
+	// Attempt is the attempt the peer member executes.
+	Attempt int
+}
+
+func (sbe *SessionBehindError) Error() string {
+	return fmt.Sprintf("peer member executes later attempt [%d]", sbe.Attempt)
+}
@@ -0,0 +1,346 @@
+package tss
+
+import (
+	"context"
+	cecdsa "crypto/ecdsa"
+	"crypto/elliptic"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-core/pkg/net/key"
+)
+
+func TestSessionID_Unique(t *testing.T) {
+	sessions := map[string]*session{
+		"base":           newSession("0xAB", SigningProtocol, []byte{1}, 1),
+		"another keep":   newSession("0xAC", SigningProtocol, []byte{1}, 1),
+		"another digest": newSession("0xAB", SigningProtocol, []byte{2}, 1),
+		"another try":    newSession("0xAB", SigningProtocol, []byte{1}, 2),
+		"key generation": newSession("0xAB", KeyGenerationProtocol, nil, 1),
+		"recovery":       newSession("0xAB", LiquidationRecoveryProtocol, nil, 1),
+	}
+
+	ids := make(map[string]string)
+	for name, session := range sessions {
+		if other, ok := ids[session.ID()]; ok {
+			t.Errorf(
+				"sessions [%s] and [%s] have the same ID [%s]",
+				name,
+				other,
+				session.ID(),
+			)
+		}
+		ids[session.ID()] = name
+	}
+}
+
+func TestSessionID_KeepIDCaseInsensitive(t *testing.T) {
+	lower := newSession("0xab", KeyGenerationProtocol, nil, 1)
+	upper := newSession("0xAB", KeyGenerationProtocol, nil, 1)
+
+	if lower.ID() != upper.ID() {
+		t.Errorf(
+			"unexpected session ID\nexpected: [%s]\nactual:   [%s]",
+			lower.ID(),
+			upper.ID(),
+		)
+	}
+}
+
+func TestSessionRelationTo(t *testing.T) {
+	current := newSession("0xAB", SigningProtocol, []byte{1}, 2)
+
+	var tests = map[string]struct {
+		sessionID        string
+		expectedRelation sessionRelation
+		expectedAttempt  int
+	}{
+		"current session": {
+			sessionID:        current.ID(),
+			expectedRelation: currentSession,
+			expectedAttempt:  2,
+		},
+		"later attempt": {
+			sessionID: newSession(
+				"0xAB", SigningProtocol, []byte{1}, 5,
+			).ID(),
+			expectedRelation: laterSession,
+			expectedAttempt:  5,
+		},
+		"earlier attempt": {
+			sessionID: newSession(
+				"0xAB", SigningProtocol, []byte{1}, 1,
+			).ID(),
+			expectedRelation: foreignSession,
+		},
+		"another digest": {
+			sessionID: newSession(
+				"0xAB", SigningProtocol, []byte{2}, 5,
+			).ID(),
+			expectedRelation: foreignSession,
+		},
+		"another protocol": {
+			sessionID: newSession(
+				"0xAB", KeyGenerationProtocol, nil, 5,
+			).ID(),
+			expectedRelation: foreignSession,
+		},
+		"another keep": {
+			sessionID: newSession(
+				"0xAC", SigningProtocol, []byte{1}, 5,
+			).ID(),
+			expectedRelation: foreignSession,
+		},
+		"malformed attempt": {
+			sessionID:        current.executionID() + ":x",
+			expectedRelation: foreignSession,
+		},
+		"another keep identifier": {
+			sessionID:        "0xAC",
+			expectedRelation: foreignSession,
+		},
+		"keep identifier": {
+			sessionID:        "0xAB",
+			expectedRelation: legacySession,
+			expectedAttempt:  2,
+		},
+		"empty": {
+			sessionID:        "",
+			expectedRelation: legacySession,
+			expectedAttempt:  2,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			relation, attempt := current.relationTo(test.sessionID)
+
+			if relation != test.expectedRelation {
+				t.Errorf(
+					"unexpected relation\nexpected: [%v]\nactual:   [%v]",
+					test.expectedRelation,
+					relation,
+				)
+			}
+
+			if attempt != test.expectedAttempt {
+				t.Errorf(
+					"unexpected attempt\nexpected: [%v]\nactual:   [%v]",
+					test.expectedAttempt,
+					attempt,
+				)
+			}
+		})
+	}
+}
+
+func TestReadyProtocol_DropsForeignSessions(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	group, peerChannel, outsiderChannel, start := setupSessionReadyTest(t, ctx)
+
+	errChan := start(newSession(group.groupID, KeyGenerationProtocol, nil, 2))
+
+	peerMessages := []*ReadyMessage{
+		// Earlier attempt of the same execution.
+		{
+			SenderID: group.groupMemberIDs[1],
+			SessionID: newSession(
+				group.groupID, KeyGenerationProtocol, nil, 1,
+			).ID(),
+		},
+		// Another protocol of the same keep.
+		{
+			SenderID: group.groupMemberIDs[1],
+			SessionID: newSession(
+				group.groupID, LiquidationRecoveryProtocol, nil, 2,
+			).ID(),
+		},
+	}
+	for _, message := range peerMessages {
+		if err := peerChannel.Send(ctx, message); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Later attempt signalled by a member outside of the group.
+	outsiderMessage := &ReadyMessage{
+		SenderID: outsiderMemberID(t),
+		SessionID: newSession(
+			group.groupID, KeyGenerationProtocol, nil, 3,
+		).ID(),
+	}
+	if err := outsiderChannel.Send(ctx, outsiderMessage); err != nil {
+		t.Fatal(err)
+	}
+
+	err := <-errChan
+
+	var protocolError *ProtocolError
+	if !errors.As(err, &protocolError) {
+		t.Fatalf("unexpected error: [%v]", err)
+	}
+
+	if len(protocolError.MissingMembers) != 1 ||
+		!protocolError.MissingMembers[0].Equal(group.groupMemberIDs[1]) {
+		t.Errorf(
+			"unexpected missing members\nexpected: [%v]\nactual:   [%v]",
+			group.groupMemberIDs[1:],
+			protocolError.MissingMembers,
+		)
+	}
+}
+
+func TestReadyProtocol_LaterSession(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	group, peerChannel, _, start := setupSessionReadyTest(t, ctx)
+
+	errChan := start(newSession(group.groupID, KeyGenerationProtocol, nil, 2))
+
+	peerMessage := &ReadyMessage{
+		SenderID: group.groupMemberIDs[1],
+		SessionID: newSession(
+			group.groupID, KeyGenerationProtocol, nil, 4,
+		).ID(),
+	}
+	if err := peerChannel.Send(ctx, peerMessage); err != nil {
+		t.Fatal(err)
+	}
+
+	err := <-errChan
+
+	var sessionBehindError *SessionBehindError
+	if !errors.As(err, &sessionBehindError) {
+		t.Fatalf("unexpected error: [%v]", err)
+	}
+
+	if sessionBehindError.Attempt != 4 {
+		t.Errorf(
+			"unexpected attempt\nexpected: [%v]\nactual:   [%v]",
+			4,
+			sessionBehindError.Attempt,
+		)
+	}
+}
+
+func TestReadyProtocol_LegacyMember(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	group, peerChannel, _, start := setupSessionReadyTest(t, ctx)
+
+	session := newSession(group.groupID, KeyGenerationProtocol, nil, 2)
+	errChan := start(session)
+
+	// Previous client versions send ready messages without a session.
+	peerMessage := &ReadyMessage{
+		SenderID: group.groupMemberIDs[1],
+	}
+	if err := peerChannel.Send(ctx, peerMessage); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := <-errChan; err != nil {
+		t.Fatalf("unexpected error: [%v]", err)
+	}
+
+	if session.messageID() != group.groupID {
+		t.Errorf(
+			"unexpected message session ID\nexpected: [%s]\nactual:   [%s]",
+			group.groupID,
+			session.messageID(),
+		)
+	}
+}
+
+// setupSessionReadyTest creates a two-members group and broadcast channels
+// of the peer member and a member outside of the group. The returned function
+// starts the ready protocol for the first member in the given session and
+// returns a channel receiving the protocol result. Messages can be sent to
+// the member once the function returns.
+func setupSessionReadyTest(
+	t *testing.T,
+	ctx context.Context,
+) (
+	*groupInfo,
+	net.BroadcastChannel,
+	net.BroadcastChannel,
+	func(*session) <-chan error,
+) {
+	groupMembers, err := generateMemberKeys(2)
+	if err != nil {
+		t.Fatalf("failed to generate members keys: [%v]", err)
+	}
+
+	group := &groupInfo{
+		groupID:        "0xsession-test",
+		memberID:       groupMembers[0],
+		groupMemberIDs: groupMembers,
+	}
+
+	broadcastChannelFor := func(memberID MemberID) net.BroadcastChannel {
+		memberPublicKey, err := memberID.PublicKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		memberNetworkKey := key.NetworkPublic(*memberPublicKey)
+		networkProvider := newTestNetProvider(&memberNetworkKey)
+
+		broadcastChannel, err := networkProvider.BroadcastChannelFor(
+			group.groupID,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		broadcastChannel.SetUnmarshaler(func() net.TaggedUnmarshaler {
+			return &ReadyMessage{}
+		})
+
+		return broadcastChannel
+	}
+
+	memberChannel := broadcastChannelFor(groupMembers[0])
+	peerChannel := broadcastChannelFor(groupMembers[1])
+	outsiderChannel := broadcastChannelFor(outsiderMemberID(t))
+
+	pubKeyToAddressFn := func(publicKey cecdsa.PublicKey) []byte {
+		return elliptic.Marshal(publicKey.Curve, publicKey.X, publicKey.Y)
+	}
+
+	start := func(session *session) <-chan error {
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- readyProtocol(
+				ctx,
+				group,
+				session,
+				memberChannel,
+				pubKeyToAddressFn,
+				nil,
+			)
+		}()
+
+		// Give the protocol time to register the message handler.
+		time.Sleep(100 * time.Millisecond)
+
+		return errChan
+	}
+
+	return group, peerChannel, outsiderChannel, start
+}
+
+func outsiderMemberID(t *testing.T) MemberID {
+	memberIDs, err := generateMemberKeys(1)
+	if err != nil {
+		t.Fatalf("failed to generate member key: [%v]", err)
+	}
+
+	return memberIDs[0]
+}
@@ -2,6 +2,7 @@ package tss
 
 import (
 	cecdsa "crypto/ecdsa"
+	"time"
 
 	"github.com/binance-chain/tss-lib/ecdsa/keygen"
 	tssLib "github.com/binance-chain/tss-lib/tss"
@@ -14,6 +15,18 @@ type ThresholdSigner struct {
 	// thresholdKey contains a signer's key generated for a threshold signing
 	// scheme. This data should be persisted to a local storage.
 	thresholdKey ThresholdKey
+
+	// protocol is the name of the threshold protocol the key has been
+	// generated with. The same protocol is used to calculate signatures.
+	protocol string
+
+	// chainName is the name of the host chain of the keep the signer belongs
+	// to. It is empty for signers migrated from the legacy format.
+	chainName string
+
+	// createdAt is the time the signer has been generated. It is zero for
+	// signers migrated from the legacy format.
+	createdAt time.Time
 }
 
 // ThresholdKey contains data of signer's threshold key.
@@ -29,6 +42,34 @@ func (s *ThresholdSigner) GroupID() string {
 	return s.groupID
 }
 
+// GroupMemberIDs returns unique identifiers of all members of the signing
+// group, including the signer.
+func (s *ThresholdSigner) GroupMemberIDs() []MemberID {
+	return s.groupMemberIDs
+}
+
+// Protocol returns the name of the threshold protocol used by the signer.
+func (s *ThresholdSigner) Protocol() string {
+	return s.protocol
+}
+
+// ChainName returns the name of the host chain of the keep the signer belongs
+// to.
+func (s *ThresholdSigner) ChainName() string {
+	return s.chainName
+}
+
+// SetChainName records the name of the host chain of the keep the signer
+// belongs to.
+func (s *ThresholdSigner) SetChainName(chainName string) {
+	s.chainName = chainName
+}
+
+// CreatedAt returns the time the signer has been generated.
+func (s *ThresholdSigner) CreatedAt() time.Time {
+	return s.createdAt
+}
+
 // PublicKey returns signer's ECDSA public key which is also the signing group's
 // public key.
 func (s *ThresholdSigner) PublicKey() *cecdsa.PublicKey {
@@ -22,7 +22,7 @@ func (s *ThresholdSigner) initializeSigning(
 ) (*signingSigner, error) {
 	digestInt := new(big.Int).SetBytes(digest)
 
-	party, endChan, err := s.initializeSigningParty(
+	party, endChan, errChan, err := s.initializeSigningParty(
 		ctx,
 		digestInt,
 		netBridge,
@@ -36,6 +36,7 @@ func (s *ThresholdSigner) initializeSigning(
 		networkBridge:  netBridge,
 		signingParty:   party,
 		signingEndChan: endChan,
+		signingErrChan: errChan,
 	}, nil
 }
 
@@ -50,6 +51,8 @@ type signingSigner struct {
 	signingParty tssLib.Party
 	// Channel where a result of the signing protocol execution will be written to.
 	signingEndChan <-chan common.SignatureData
+	// Channel where protocol errors identifying culprits will be written to.
+	signingErrChan <-chan *tssLib.Error
 }
 
 // sign executes the protocol to calculate a signature. This function needs to be
@@ -74,26 +77,15 @@ func (s *signingSigner) sign(ctx context.Context) (*ecdsa.Signature, error) {
 			ecdsaSignature := convertSignatureTSStoECDSA(signature)
 
 			return &ecdsaSignature, nil
+		case err := <-s.signingErrChan:
+			return nil, newCulpritsError(SigningStage, err)
 		case <-ctx.Done():
-			memberIDs := []MemberID{}
-
-			if s.signingParty.WaitingFor() != nil {
-				for _, partyID := range s.signingParty.WaitingFor() {
-					memberID, err := MemberIDFromString(partyID.GetId())
-					if err != nil {
-						logger.Errorf(
-							"cannot get member id from string [%v]: [%v]",
-							partyID.GetId(),
-							err,
-						)
-						continue
-					}
-
-					memberIDs = append(memberIDs, memberID)
-				}
-			}
-
-			return nil, timeoutError{SigningProtocolTimeout, "signing", memberIDs}
+			return nil, newTimeoutError(
+				SigningStage,
+				currentRound(s.signingParty),
+				SigningProtocolTimeout,
+				s.networkBridge.laggingParties(s.signingParty.WaitingFor()),
+			)
 		}
 	}
 }
@@ -105,17 +97,26 @@ func (s *ThresholdSigner) initializeSigningParty(
 ) (
 	tssLib.Party,
 	<-chan common.SignatureData,
+	<-chan *tssLib.Error,
 	error,
 ) {
+	if !isSupportedProtocol(s.protocol) {
+		return nil, nil, nil, fmt.Errorf("unsupported protocol [%s]", s.protocol)
+	}
+
 	tssMessageChan := make(chan tss.Message, len(s.groupMemberIDs))
-	endChan := make(chan common.SignatureData)
+	// The result is written to the end channel while the party is locked.
+	// The channel is buffered so that the party does not block forever if
+	// the protocol times out right when the result is ready.
+	endChan := make(chan common.SignatureData, 1)
+	errChan := make(chan *tssLib.Error, 1)
 
 	currentPartyID, groupPartiesIDs, err := generatePartiesIDs(
 		s.memberID,
 		s.groupMemberIDs,
 	)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to generate parties IDs: [%v]", err)
+		return nil, nil, nil, fmt.Errorf("failed to generate parties IDs: [%v]", err)
 	}
 
 	params := tss.NewParameters(
@@ -137,13 +138,14 @@ func (s *ThresholdSigner) initializeSigningParty(
 	if err := netBridge.connect(
 		ctx,
 		tssMessageChan,
+		errChan,
 		party,
 		params.Parties().IDs(),
 	); err != nil {
-		return nil, nil, fmt.Errorf("failed to connect bridge network: [%v]", err)
+		return nil, nil, nil, fmt.Errorf("failed to connect bridge network: [%v]", err)
 	}
 
-	return party, endChan, nil
+	return party, endChan, errChan, nil
 }
 
 func convertSignatureTSStoECDSA(tssSignature common.SignatureData) ecdsa.Signature {
@@ -31,26 +31,33 @@ var logger = log.Logger("keep-tss")
 //
 // It expects unique identifiers of the current member as well as identifiers of
 // all members of the signing group. Group ID should be unique for each concurrent
-// execution.
+// execution. Attempt number distinguishes retries of the key generation for
+// the group, messages of other attempts are dropped.
 //
 // Dishonest threshold `t` defines a maximum number of signers controlled by the
 // adversary such that the adversary still cannot produce a signature. Any subset
 // of `t + 1` players can jointly sign, but any smaller subset cannot.
 //
-// TSS protocol requires pre-parameters such as safe primes to be generated for
-// execution. The parameters should be generated prior to running this function.
-// If not provided they will be generated.
+// Key is generated with the default threshold protocol, the signer uses
+// the same protocol to calculate signatures. TSS protocol requires
+// pre-parameters such as safe primes to be generated for execution.
+// The parameters should be generated prior to running this function. If not
+// provided they will be generated.
+//
+// The observer, if given, is notified about responses of peer members.
 //
 // As a result a signer will be returned or an error, if key generation failed.
 func GenerateThresholdSigner(
 	parentCtx context.Context,
 	groupID string,
+	attempt int,
 	memberID MemberID,
 	groupMemberIDs []MemberID,
 	dishonestThreshold uint,
 	networkProvider net.Provider,
 	pubKeyToAddressFn func(cecdsa.PublicKey) []byte,
 	paramsBox *params.Box,
+	observer PeerObserver,
 ) (*ThresholdSigner, error) {
 	if len(groupMemberIDs) < 2 {
 		return nil, fmt.Errorf(
@@ -74,7 +81,9 @@ func GenerateThresholdSigner(
 		dishonestThreshold: int(dishonestThreshold),
 	}
 
-	netBridge, err := newNetworkBridge(group, networkProvider)
+	session := newSession(groupID, KeyGenerationProtocol, nil, attempt)
+
+	netBridge, err := newNetworkBridge(group, session, networkProvider)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize network bridge: [%v]", err)
 	}
@@ -106,10 +115,12 @@ func GenerateThresholdSigner(
 	if err := readyProtocol(
 		ctx,
 		group,
+		session,
 		broadcastChannel,
 		pubKeyToAddressFn,
+		observer,
 	); err != nil {
-		return nil, fmt.Errorf("readiness signaling protocol failed: [%v]", err)
+		return nil, fmt.Errorf("readiness signaling protocol failed: [%w]", err)
 	}
 
 	// We are begining the communication with other members using pre-parameters
@@ -121,7 +132,7 @@ func GenerateThresholdSigner(
 
 	signer, err := keyGenSigner.generateKey(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate key: [%v]", err)
+		return nil, fmt.Errorf("failed to generate key: [%w]", err)
 	}
 	logger.Infof("[party:%s]: completed key generation", keyGenSigner.keygenParty.PartyID())
 
@@ -130,14 +141,21 @@ func GenerateThresholdSigner(
 
 // CalculateSignature executes a threshold multi-party signature calculation
 // protocol for the given digest. As a result the calculated ECDSA signature will
-// be returned or an error, if the signature generation failed.
+// be returned or an error, if the signature generation failed. Attempt number
+// distinguishes retries of the signing for the digest, messages of other
+// attempts are dropped. The observer, if given, is notified about responses
+// of peer members.
 func (s *ThresholdSigner) CalculateSignature(
 	parentCtx context.Context,
 	digest []byte,
+	attempt int,
 	networkProvider net.Provider,
 	pubKeyToAddressFn func(cecdsa.PublicKey) []byte,
+	observer PeerObserver,
 ) (*ecdsa.Signature, error) {
-	netBridge, err := newNetworkBridge(s.groupInfo, networkProvider)
+	session := newSession(s.groupID, SigningProtocol, digest, attempt)
+
+	netBridge, err := newNetworkBridge(s.groupInfo, session, networkProvider)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize network bridge: [%v]", err)
 	}
@@ -158,15 +176,17 @@ func (s *ThresholdSigner) CalculateSignature(
 	if err := readyProtocol(
 		ctx,
 		s.groupInfo,
+		session,
 		broadcastChannel,
 		pubKeyToAddressFn,
+		observer,
 	); err != nil {
-		return nil, fmt.Errorf("readiness signaling protocol failed: [%v]", err)
+		return nil, fmt.Errorf("readiness signaling protocol failed: [%w]", err)
 	}
 
 	signature, err := signingSigner.sign(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to sign: [%v]", err)
+		return nil, fmt.Errorf("failed to sign: [%w]", err)
 	}
 
 	return signature, err
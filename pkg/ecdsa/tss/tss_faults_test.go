@@ -0,0 +1,450 @@
+package tss
+
+import (
+	"context"
+	cecdsa "crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/pkg/net/key"
+	"github.com/keep-network/keep-ecdsa/internal/netfault"
+	"github.com/keep-network/keep-ecdsa/internal/testdata"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss/params"
+)
+
+const (
+	faultsGroupSize = 3
+	faultsSeed      = 7
+
+	// faultsProtocolTimeout is the time each protocol execution in the network
+	// faults tests is given. Executions expected to fail end with this timeout
+	// so it is kept as short as possible, leaving a margin for successful
+	// executions on loaded machines.
+	faultsProtocolTimeout = 45 * time.Second
+)
+
+var (
+	protocolMessageType = (&ProtocolMessage{}).Type()
+	readyMessageType    = (&ReadyMessage{}).Type()
+)
+
+// networkFaultsTest describes network faults injected into messages received
+// by each group member and the expected outcome of the protocol execution.
+type networkFaultsTest struct {
+	// rules returns fault injection rules for the given network public keys
+	// of group members.
+	rules func(peers []*key.NetworkPublic) []netfault.Rule
+	// expectedError is a fragment of the error message returned by each member
+	// if the protocol execution should fail under the faults.
+	expectedError string
+	// expectedAttribution are indexes of members the failure should be
+	// attributed to by the first member.
+	expectedAttribution []int
+}
+
+func TestGenerateThresholdSignerWithNetworkFaults(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping network faults test in short mode")
+	}
+
+	var tests = map[string]networkFaultsTest{
+		"delayed messages": {
+			rules: func(peers []*key.NetworkPublic) []netfault.Rule {
+				return []netfault.Rule{{Delay: 200 * time.Millisecond}}
+			},
+		},
+		"reordered protocol messages": {
+			rules: func(peers []*key.NetworkPublic) []netfault.Rule {
+				return []netfault.Rule{{
+					MessageTypes:    []string{protocolMessageType},
+					ReorderRate:     0.5,
+					MaxReorderDelay: time.Second,
+				}}
+			},
+		},
+		"duplicated messages": {
+			rules: func(peers []*key.NetworkPublic) []netfault.Rule {
+				return []netfault.Rule{{DuplicateRate: 0.5}}
+			},
+		},
+		"lost ready messages from peer": {
+			rules: func(peers []*key.NetworkPublic) []netfault.Rule {
+				return []netfault.Rule{{
+					MessageTypes: []string{readyMessageType},
+					Senders:      peers[1:2],
+					DropRate:     1,
+				}}
+			},
+			expectedError:       "readiness signaling protocol failed",
+			expectedAttribution: []int{1},
+		},
+		"lost protocol messages from peer": {
+			rules: func(peers []*key.NetworkPublic) []netfault.Rule {
+				return []netfault.Rule{{
+					MessageTypes: []string{protocolMessageType},
+					Senders:      peers[1:2],
+					DropRate:     1,
+				}}
+			},
+			expectedError:       "failed to generate key",
+			expectedAttribution: []int{1},
+		},
+		"corrupted protocol messages from peer": {
+			rules: func(peers []*key.NetworkPublic) []netfault.Rule {
+				return []netfault.Rule{{
+					MessageTypes: []string{protocolMessageType},
+					Senders:      peers[1:2],
+					CorruptRate:  1,
+				}}
+			},
+			expectedError:       "failed to generate key",
+			expectedAttribution: []int{1},
+		},
+	}
+
+	groupMemberIDs, err := generateMemberKeys(faultsGroupSize)
+	if err != nil {
+		t.Fatalf("failed to generate members keys: [%v]", err)
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			networkProviders := newFaultyNetProviders(t, groupMemberIDs, test)
+
+			signers, errs := generateSigners(t, groupMemberIDs, networkProviders)
+
+			assertNetworkFaultsOutcome(t, test, groupMemberIDs, errs)
+
+			// Signers are not generated for members which failed.
+			if test.expectedError == "" && !t.Failed() {
+				assertSamePublicKey(t, signers)
+			}
+		})
+	}
+}
+
+func TestCalculateSignatureWithNetworkFaults(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping network faults test in short mode")
+	}
+
+	var tests = map[string]networkFaultsTest{
+		"delayed messages": {
+			rules: func(peers []*key.NetworkPublic) []netfault.Rule {
+				return []netfault.Rule{{Delay: 200 * time.Millisecond}}
+			},
+		},
+		"reordered protocol messages": {
+			rules: func(peers []*key.NetworkPublic) []netfault.Rule {
+				return []netfault.Rule{{
+					MessageTypes:    []string{protocolMessageType},
+					ReorderRate:     0.5,
+					MaxReorderDelay: time.Second,
+				}}
+			},
+		},
+		"duplicated messages": {
+			rules: func(peers []*key.NetworkPublic) []netfault.Rule {
+				return []netfault.Rule{{DuplicateRate: 0.5}}
+			},
+		},
+		"lost protocol messages from peer": {
+			rules: func(peers []*key.NetworkPublic) []netfault.Rule {
+				return []netfault.Rule{{
+					MessageTypes: []string{protocolMessageType},
+					Senders:      peers[2:3],
+					DropRate:     1,
+				}}
+			},
+			expectedError:       "failed to sign",
+			expectedAttribution: []int{2},
+		},
+		"corrupted protocol messages from peer": {
+			rules: func(peers []*key.NetworkPublic) []netfault.Rule {
+				return []netfault.Rule{{
+					MessageTypes: []string{protocolMessageType},
+					Senders:      peers[2:3],
+					CorruptRate:  1,
+				}}
+			},
+			expectedError:       "failed to sign",
+			expectedAttribution: []int{2},
+		},
+	}
+
+	groupMemberIDs, err := generateMemberKeys(faultsGroupSize)
+	if err != nil {
+		t.Fatalf("failed to generate members keys: [%v]", err)
+	}
+
+	signers, errs := generateSigners(
+		t,
+		groupMemberIDs,
+		newFaultyNetProviders(t, groupMemberIDs, networkFaultsTest{}),
+	)
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("failed to generate signer [%v]: [%v]", i, err)
+		}
+	}
+
+	publicKey := signers[0].PublicKey()
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			networkProviders := newFaultyNetProviders(t, groupMemberIDs, test)
+
+			digest := sha256.Sum256([]byte(testName))
+
+			signatures, errs := calculateSignatures(
+				t,
+				signers,
+				digest[:],
+				networkProviders,
+			)
+
+			assertNetworkFaultsOutcome(t, test, groupMemberIDs, errs)
+
+			// Signatures are not calculated by members which failed.
+			if test.expectedError == "" && !t.Failed() {
+				for i, signature := range signatures {
+					if !cecdsa.Verify(
+						publicKey,
+						digest[:],
+						signature.R,
+						signature.S,
+					) {
+						t.Errorf(
+							"invalid signature of member [%v]: [%+v]",
+							i,
+							signature,
+						)
+					}
+				}
+			}
+		})
+	}
+}
+
+// newFaultyNetProviders creates a network provider for each group member
+// injecting faults into received messages according to the test rules.
+func newFaultyNetProviders(
+	t *testing.T,
+	groupMemberIDs []MemberID,
+	test networkFaultsTest,
+) []*netfault.Provider {
+	peers := make([]*key.NetworkPublic, len(groupMemberIDs))
+	for i, memberID := range groupMemberIDs {
+		memberPublicKey, err := memberID.PublicKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		networkPublicKey := key.NetworkPublic(*memberPublicKey)
+		peers[i] = &networkPublicKey
+	}
+
+	var rules []netfault.Rule
+	if test.rules != nil {
+		rules = test.rules(peers)
+	}
+
+	networkProviders := make([]*netfault.Provider, len(groupMemberIDs))
+	for i := range groupMemberIDs {
+		networkProviders[i] = netfault.Connect(
+			newTestNetProvider(peers[i]),
+			&netfault.Policy{
+				Seed:  faultsSeed + int64(i),
+				Rules: rules,
+			},
+		)
+	}
+
+	return networkProviders
+}
+
+// generateSigners executes key generation for all group members and returns
+// generated signers and errors in the order of members.
+func generateSigners(
+	t *testing.T,
+	groupMemberIDs []MemberID,
+	networkProviders []*netfault.Provider,
+) ([]*ThresholdSigner, []error) {
+	testData, err := testdata.LoadKeygenTestFixtures(len(groupMemberIDs))
+	if err != nil {
+		t.Fatalf("failed to load test data: [%v]", err)
+	}
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		faultsProtocolTimeout,
+	)
+	defer cancel()
+
+	groupID := fmt.Sprintf("tss-faults-test-%d", rand.Int())
+
+	signers := make([]*ThresholdSigner, len(groupMemberIDs))
+	errs := make([]error, len(groupMemberIDs))
+
+	var wg sync.WaitGroup
+	wg.Add(len(groupMemberIDs))
+
+	for i, memberID := range groupMemberIDs {
+		go func(index int, memberID MemberID) {
+			defer wg.Done()
+
+			preParams := testData[index].LocalPreParams
+
+			signers[index], errs[index] = GenerateThresholdSigner(
+				ctx,
+				groupID,
+				1,
+				memberID,
+				groupMemberIDs,
+				uint(len(groupMemberIDs)-1),
+				networkProviders[index],
+				faultsPubKeyToAddress,
+				params.NewBox(&preParams),
+				nil,
+			)
+		}(i, memberID)
+	}
+
+	wg.Wait()
+
+	return signers, errs
+}
+
+// calculateSignatures executes signing of the digest by all signers and
+// returns calculated signatures and errors in the order of signers.
+func calculateSignatures(
+	t *testing.T,
+	signers []*ThresholdSigner,
+	digest []byte,
+	networkProviders []*netfault.Provider,
+) ([]*ecdsa.Signature, []error) {
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		faultsProtocolTimeout,
+	)
+	defer cancel()
+
+	signatures := make([]*ecdsa.Signature, len(signers))
+	errs := make([]error, len(signers))
+
+	var wg sync.WaitGroup
+	wg.Add(len(signers))
+
+	for i, signer := range signers {
+		go func(index int, signer *ThresholdSigner) {
+			defer wg.Done()
+
+			signatures[index], errs[index] = signer.CalculateSignature(
+				ctx,
+				digest,
+				1,
+				networkProviders[index],
+				faultsPubKeyToAddress,
+				nil,
+			)
+		}(i, signer)
+	}
+
+	wg.Wait()
+
+	return signatures, errs
+}
+
+func assertNetworkFaultsOutcome(
+	t *testing.T,
+	test networkFaultsTest,
+	groupMemberIDs []MemberID,
+	errs []error,
+) {
+	for i, err := range errs {
+		if test.expectedError == "" {
+			if err != nil {
+				t.Errorf("unexpected error of member [%v]: [%v]", i, err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Errorf("expected error of member [%v]", i)
+			continue
+		}
+
+		// Faults are never injected into messages of the first member so it
+		// receives faulty messages and fails with the expected error. Other
+		// members may fail in any protocol stage waiting for their peers.
+		if i != 0 {
+			continue
+		}
+
+		if !strings.Contains(err.Error(), test.expectedError) {
+			t.Errorf(
+				"unexpected error of member [%v]\nexpected: [%v]\nactual:   [%v]",
+				i,
+				test.expectedError,
+				err,
+			)
+		}
+
+		var protocolError *ProtocolError
+		if !errors.As(err, &protocolError) {
+			t.Errorf("expected protocol error of member [%v]: [%v]", i, err)
+			continue
+		}
+
+		expectedAttribution := []MemberID{}
+		for _, index := range test.expectedAttribution {
+			expectedAttribution = append(expectedAttribution, groupMemberIDs[index])
+		}
+
+		attribution := append(
+			append([]MemberID{}, protocolError.Culprits...),
+			protocolError.MissingMembers...,
+		)
+
+		if !reflect.DeepEqual(expectedAttribution, attribution) {
+			t.Errorf(
+				"unexpected failure attribution of member [%v]\n"+
+					"expected: [%v]\nactual:   [%v]",
+				i,
+				joinMemberIDs(expectedAttribution),
+				joinMemberIDs(attribution),
+			)
+		}
+	}
+}
+
+func assertSamePublicKey(t *testing.T, signers []*ThresholdSigner) {
+	firstPublicKey := signers[0].PublicKey()
+
+	for i, signer := range signers {
+		publicKey := signer.PublicKey()
+		if publicKey.X.Cmp(firstPublicKey.X) != 0 ||
+			publicKey.Y.Cmp(firstPublicKey.Y) != 0 {
+			t.Errorf(
+				"public key of member [%v] doesn't match expected\n"+
+					"expected: [%v]\nactual:   [%v]",
+				i,
+				firstPublicKey,
+				publicKey,
+			)
+		}
+	}
+}
+
+func faultsPubKeyToAddress(publicKey cecdsa.PublicKey) []byte {
+	return elliptic.Marshal(publicKey.Curve, publicKey.X, publicKey.Y)
+}
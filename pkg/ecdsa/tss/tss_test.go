@@ -94,12 +94,14 @@ func TestGenerateKeyAndSign(t *testing.T) {
 				signer, err := GenerateThresholdSigner(
 					ctx,
 					groupID,
+					1,
 					memberID,
 					groupMemberIDs,
 					dishonestThreshold,
 					network,
 					pubKeyToAddressFn,
 					params.NewBox(&preParams),
+					nil,
 				)
 				if err != nil {
 					errChan <- fmt.Errorf("failed to generate signer: [%v]", err)
@@ -174,8 +176,10 @@ func TestGenerateKeyAndSign(t *testing.T) {
 				signature, err := signer.CalculateSignature(
 					ctx,
 					digest[:],
+					1,
 					networkProvider,
 					pubKeyToAddressFn,
+					nil,
 				)
 				if err != nil {
 					errChan <- fmt.Errorf("failed to sign: [%v]", err)
@@ -229,6 +233,80 @@ func TestGenerateKeyAndSign(t *testing.T) {
 	verifyEthereumSignature(t, digest[:], firstSignature, firstPublicKey)
 }
 
+func TestCalculateSignatureConcurrentDigests(t *testing.T) {
+	groupMemberIDs, err := generateMemberKeys(faultsGroupSize)
+	if err != nil {
+		t.Fatalf("failed to generate members keys: [%v]", err)
+	}
+
+	networkProviders := newFaultyNetProviders(
+		t,
+		groupMemberIDs,
+		networkFaultsTest{},
+	)
+
+	signers, errs := generateSigners(t, groupMemberIDs, networkProviders)
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("failed to generate signer [%v]: [%v]", i, err)
+		}
+	}
+
+	publicKey := signers[0].PublicKey()
+
+	// Both digests are signed by the same signers at the same time, sharing
+	// channels of the group.
+	digests := [][32]byte{
+		sha256.Sum256([]byte("redemption")),
+		sha256.Sum256([]byte("redemption fee bump")),
+	}
+
+	signatures := make([][]*ecdsa.Signature, len(digests))
+	signingErrs := make([][]error, len(digests))
+
+	var wg sync.WaitGroup
+	wg.Add(len(digests))
+
+	for i := range digests {
+		go func(index int) {
+			defer wg.Done()
+
+			signatures[index], signingErrs[index] = calculateSignatures(
+				t,
+				signers,
+				digests[index][:],
+				networkProviders,
+			)
+		}(i)
+	}
+
+	wg.Wait()
+
+	for i, digest := range digests {
+		for j, err := range signingErrs[i] {
+			if err != nil {
+				t.Fatalf(
+					"failed to sign digest [%v] by member [%v]: [%v]",
+					i,
+					j,
+					err,
+				)
+			}
+		}
+
+		for j, signature := range signatures[i] {
+			if !cecdsa.Verify(publicKey, digest[:], signature.R, signature.S) {
+				t.Errorf(
+					"invalid signature of digest [%v] by member [%v]: [%+v]",
+					i,
+					j,
+					signature,
+				)
+			}
+		}
+	}
+}
+
 func generateMemberKeys(groupSize int) ([]MemberID, error) {
 	memberIDs := []MemberID{}
 
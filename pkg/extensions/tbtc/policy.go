@@ -0,0 +1,258 @@
+package tbtc
+
+import (
+	"bytes"
+	cecdsa "crypto/ecdsa"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/policy"
+)
+
+// outpointLength is the length of a serialized bitcoin outpoint: the 32-byte
+// transaction hash followed by the 4-byte output index.
+const outpointLength = 36
+
+// redemptionValidator is a signing policy validator of keeps backing tBTC
+// deposits. The only digests the deposit requests keeps to sign are sighashes
+// of redemption transactions, so a digest is signed only if it matches the
+// sighash recomputed from a redemption request of the deposit. The validator
+// abstains from requests of keeps not opened by the tBTC application or not
+// owned by a deposit.
+type redemptionValidator struct {
+	handle          chain.TBTCHandle
+	keepApplication func(keepID chain.ID) (chain.ID, error)
+}
+
+// NewRedemptionValidator creates a signing policy validator for keeps backing
+// deposits of the tBTC application. The given function returns the ID of the
+// application which opened the keep with the given ID.
+func NewRedemptionValidator(
+	handle chain.TBTCHandle,
+	keepApplication func(keepID chain.ID) (chain.ID, error),
+) policy.Validator {
+	return &redemptionValidator{handle, keepApplication}
+}
+
+func (rv *redemptionValidator) Name() string {
+	return "tbtc-redemption"
+}
+
+func (rv *redemptionValidator) Validate(
+	request *policy.Request,
+) (*policy.Decision, error) {
+	application, err := rv.keepApplication(request.Keep.ID())
+	if err != nil {
+		return nil, fmt.Errorf("could not get keep application: [%v]", err)
+	}
+
+	if application.String() != rv.handle.ID().String() {
+		return &policy.Decision{
+			Verdict: policy.Abstain,
+			Reason: fmt.Sprintf(
+				"keep has been opened by application [%s] other than tBTC",
+				application,
+			),
+		}, nil
+	}
+
+	depositAddress, err := request.Keep.GetOwner()
+	if err != nil {
+		return nil, fmt.Errorf("could not get keep owner: [%v]", err)
+	}
+
+	depositKeep, err := rv.handle.Keep(depositAddress.String())
+	if err != nil {
+		return &policy.Decision{
+			Verdict: policy.Abstain,
+			Reason: fmt.Sprintf(
+				"keep owner [%s] is not a deposit: [%v]",
+				depositAddress,
+				err,
+			),
+		}, nil
+	}
+
+	if depositKeep.ID().String() != request.Keep.ID().String() {
+		return &policy.Decision{
+			Verdict: policy.Veto,
+			Reason: fmt.Sprintf(
+				"keep is owned by deposit [%s] backed by another keep [%s]",
+				depositAddress,
+				depositKeep.ID(),
+			),
+		}, nil
+	}
+
+	redemptionRequestedEvents, err := rv.handle.PastDepositRedemptionRequestedEvents(
+		request.Event.BlockNumber,
+		depositAddress.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not get redemption requests of deposit [%s]: [%v]",
+			depositAddress,
+			err,
+		)
+	}
+
+	// Events are sorted by the block number, so iterate from the end to find
+	// the most recent request of the digest.
+	for i := len(redemptionRequestedEvents) - 1; i >= 0; i-- {
+		event := redemptionRequestedEvents[i]
+		if event.Digest != request.Event.Digest {
+			continue
+		}
+
+		sighash, err := redemptionSighash(request.PublicKey, event)
+		if err != nil {
+			return &policy.Decision{
+				Verdict: policy.Veto,
+				Reason: fmt.Sprintf(
+					"could not compute sighash of redemption requested "+
+						"at block [%d]: [%v]",
+					event.BlockNumber,
+					err,
+				),
+			}, nil
+		}
+
+		if sighash != request.Event.Digest {
+			return &policy.Decision{
+				Verdict: policy.Veto,
+				Reason: fmt.Sprintf(
+					"digest does not match sighash [%x] of redemption "+
+						"requested at block [%d]",
+					sighash,
+					event.BlockNumber,
+				),
+			}, nil
+		}
+
+		return &policy.Decision{
+			Verdict: policy.Approve,
+			Reason: fmt.Sprintf(
+				"digest matches sighash of redemption of deposit [%s] "+
+					"requested at block [%d]",
+				depositAddress,
+				event.BlockNumber,
+			),
+		}, nil
+	}
+
+	return &policy.Decision{
+		Verdict: policy.Veto,
+		Reason: fmt.Sprintf(
+			"no redemption of deposit [%s] has been requested for the digest",
+			depositAddress,
+		),
+	}, nil
+}
+
+// redemptionSighash recomputes the BIP143 sighash of the redemption
+// transaction described by the redemption request. The transaction spends
+// the deposit outpoint locked to the p2wpkh of the keep public key and pays
+// the deposit value decreased by the requested fee to the redeemer output
+// script. Like in the deposit contract, the transaction has version 1, zero
+// sequence and lock time, and is signed with SIGHASH_ALL.
+//
+// [BIP143]: https://github.com/bitcoin/bips/blob/master/bip-0143.mediawiki
+func redemptionSighash(
+	publicKey *cecdsa.PublicKey,
+	event *chain.DepositRedemptionRequestedEvent,
+) ([32]byte, error) {
+	var sighash [32]byte
+
+	if len(event.Outpoint) != outpointLength {
+		return sighash, fmt.Errorf(
+			"invalid outpoint length [%d]",
+			len(event.Outpoint),
+		)
+	}
+
+	previousTransactionHash, err := chainhash.NewHash(event.Outpoint[:32])
+	if err != nil {
+		return sighash, fmt.Errorf("invalid outpoint: [%v]", err)
+	}
+	previousOutputIndex := binary.LittleEndian.Uint32(event.Outpoint[32:])
+
+	// The redeemer output script is prefixed with its length.
+	outputScript, err := wire.ReadVarBytes(
+		bytes.NewReader(event.RedeemerOutputScript),
+		0,
+		uint32(len(event.RedeemerOutputScript)),
+		"redeemerOutputScript",
+	)
+	if err != nil {
+		return sighash, fmt.Errorf("invalid redeemer output script: [%v]", err)
+	}
+	if wire.VarIntSerializeSize(uint64(len(outputScript)))+len(outputScript) !=
+		len(event.RedeemerOutputScript) {
+		return sighash, fmt.Errorf(
+			"redeemer output script length does not match its prefix",
+		)
+	}
+
+	if event.UtxoValue == nil || event.RequestedFee == nil {
+		return sighash, fmt.Errorf("missing deposit value or requested fee")
+	}
+	outputValue := new(big.Int).Sub(event.UtxoValue, event.RequestedFee)
+	if event.UtxoValue.Cmp(big.NewInt(math.MaxInt64)) > 0 ||
+		outputValue.Sign() < 0 {
+		return sighash, fmt.Errorf(
+			"invalid deposit value [%v] or requested fee [%v]",
+			event.UtxoValue,
+			event.RequestedFee,
+		)
+	}
+
+	transaction := wire.NewMsgTx(1)
+	transaction.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: *wire.NewOutPoint(
+			previousTransactionHash,
+			previousOutputIndex,
+		),
+		Sequence: 0,
+	})
+	transaction.AddTxOut(wire.NewTxOut(outputValue.Int64(), outputScript))
+	transaction.LockTime = 0
+
+	// The scriptCode for a p2wpkh output is the equivalent of the p2pkh
+	// scriptPubKey. The network does not matter for the script.
+	publicKeyHash, err := btcutil.NewAddressPubKeyHash(
+		btcutil.Hash160((*btcec.PublicKey)(publicKey).SerializeCompressed()),
+		&chaincfg.MainNetParams,
+	)
+	if err != nil {
+		return sighash, err
+	}
+	scriptCode, err := txscript.PayToAddrScript(publicKeyHash)
+	if err != nil {
+		return sighash, err
+	}
+
+	sighashBytes, err := txscript.CalcWitnessSigHash(
+		scriptCode,
+		txscript.NewTxSigHashes(transaction),
+		txscript.SigHashAll,
+		transaction,
+		0,
+		event.UtxoValue.Int64(),
+	)
+	if err != nil {
+		return sighash, err
+	}
+
+	copy(sighash[:], sighashBytes)
+	return sighash, nil
+}
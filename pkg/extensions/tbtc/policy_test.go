@@ -0,0 +1,291 @@
+package tbtc
+
+import (
+	"bytes"
+	"context"
+	cecdsa "crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/local"
+	"github.com/keep-network/keep-ecdsa/pkg/policy"
+)
+
+// redemptionRequestsHandle is a tBTC handle of the local chain returning
+// the given redemption requests.
+type redemptionRequestsHandle struct {
+	chain.TBTCHandle
+	events []*chain.DepositRedemptionRequestedEvent
+}
+
+func (rrh *redemptionRequestsHandle) PastDepositRedemptionRequestedEvents(
+	startBlock uint64,
+	depositAddress string,
+) ([]*chain.DepositRedemptionRequestedEvent, error) {
+	return rrh.events, nil
+}
+
+func hash256(data ...[]byte) []byte {
+	first := sha256.Sum256(bytes.Join(data, nil))
+	second := sha256.Sum256(first[:])
+	return second[:]
+}
+
+func uint64ToLittleEndian(value uint64) []byte {
+	bytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bytes, value)
+	return bytes
+}
+
+// newTestRedemptionRequest returns a redemption request with the digest
+// computed the way the deposit contract computes it.
+func newTestRedemptionRequest(
+	publicKey *cecdsa.PublicKey,
+) *chain.DepositRedemptionRequestedEvent {
+	outpoint := append(bytes.Repeat([]byte{0xaa}, 32), 1, 0, 0, 0)
+	redeemerOutputScript := append(
+		[]byte{0x16, 0x00, 0x14},
+		bytes.Repeat([]byte{0xbb}, 20)...,
+	)
+	utxoValue := uint64(10000000)
+	requestedFee := uint64(15000)
+
+	publicKeyHash := btcutil.Hash160(
+		(*btcec.PublicKey)(publicKey).SerializeCompressed(),
+	)
+
+	var digest [32]byte
+	copy(digest[:], hash256(
+		[]byte{0x01, 0x00, 0x00, 0x00},
+		hash256(outpoint),
+		hash256([]byte{0x00, 0x00, 0x00, 0x00}),
+		outpoint,
+		[]byte{0x19, 0x76, 0xa9, 0x14},
+		publicKeyHash,
+		[]byte{0x88, 0xac},
+		uint64ToLittleEndian(utxoValue),
+		[]byte{0x00, 0x00, 0x00, 0x00},
+		hash256(
+			uint64ToLittleEndian(utxoValue-requestedFee),
+			redeemerOutputScript,
+		),
+		[]byte{0x00, 0x00, 0x00, 0x00},
+		[]byte{0x01, 0x00, 0x00, 0x00},
+	))
+
+	return &chain.DepositRedemptionRequestedEvent{
+		DepositAddress:       depositAddress,
+		Digest:               digest,
+		UtxoValue:            new(big.Int).SetUint64(utxoValue),
+		RedeemerOutputScript: redeemerOutputScript,
+		RequestedFee:         new(big.Int).SetUint64(requestedFee),
+		Outpoint:             outpoint,
+		BlockNumber:          10,
+	}
+}
+
+func TestRedemptionSighash(t *testing.T) {
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey := (*cecdsa.PublicKey)(privateKey.PubKey())
+
+	event := newTestRedemptionRequest(publicKey)
+
+	sighash, err := redemptionSighash(publicKey, event)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sighash != event.Digest {
+		t.Errorf(
+			"unexpected sighash\nexpected: [%x]\nactual:   [%x]",
+			event.Digest,
+			sighash,
+		)
+	}
+
+	event.RedeemerOutputScript = event.RedeemerOutputScript[1:]
+	if _, err := redemptionSighash(publicKey, event); err == nil {
+		t.Errorf("expected error for output script without length prefix")
+	}
+}
+
+func TestRedemptionValidator(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	tbtcChain := local.NewTBTCLocalChain(ctx)
+	tbtcChain.CreateDeposit(
+		depositAddress,
+		append(
+			[]common.Address{tbtcChain.OperatorAddress()},
+			local.RandomSigningGroup(2)...,
+		),
+	)
+
+	keep, err := tbtcChain.Keep(depositAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey := (*cecdsa.PublicKey)(privateKey.PubKey())
+
+	redemptionRequest := newTestRedemptionRequest(publicKey)
+
+	modifiedFeeRequest := newTestRedemptionRequest(publicKey)
+	modifiedFeeRequest.RequestedFee = big.NewInt(1)
+
+	var tests = map[string]struct {
+		events          []*chain.DepositRedemptionRequestedEvent
+		digest          [32]byte
+		expectedVerdict policy.Verdict
+		expectedReason  string
+	}{
+		"digest of requested redemption": {
+			events:          []*chain.DepositRedemptionRequestedEvent{redemptionRequest},
+			digest:          redemptionRequest.Digest,
+			expectedVerdict: policy.Approve,
+			expectedReason:  "digest matches sighash",
+		},
+		"digest not matching redemption parameters": {
+			events:          []*chain.DepositRedemptionRequestedEvent{modifiedFeeRequest},
+			digest:          modifiedFeeRequest.Digest,
+			expectedVerdict: policy.Veto,
+			expectedReason:  "digest does not match sighash",
+		},
+		"digest of no redemption": {
+			events:          []*chain.DepositRedemptionRequestedEvent{redemptionRequest},
+			digest:          [32]byte{1},
+			expectedVerdict: policy.Veto,
+			expectedReason:  "no redemption",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			validator := NewRedemptionValidator(
+				&redemptionRequestsHandle{
+					TBTCHandle: tbtcChain,
+					events:     test.events,
+				},
+				tbtcChain.GetKeepApplication,
+			)
+
+			decision, err := validator.Validate(&policy.Request{
+				Keep:      keep,
+				PublicKey: publicKey,
+				Event: &chain.SignatureRequestedEvent{
+					Digest:      test.digest,
+					BlockNumber: 10,
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if decision.Verdict != test.expectedVerdict {
+				t.Errorf(
+					"unexpected verdict\nexpected: [%v]\nactual:   [%v]",
+					test.expectedVerdict,
+					decision.Verdict,
+				)
+			}
+			if !strings.Contains(decision.Reason, test.expectedReason) {
+				t.Errorf("unexpected reason: [%v]", decision.Reason)
+			}
+		})
+	}
+}
+
+func TestRedemptionValidator_AbstainsForKeepsNotBackingDeposits(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	tbtcChain := local.NewTBTCLocalChain(ctx)
+	members := append(
+		[]common.Address{tbtcChain.OperatorAddress()},
+		local.RandomSigningGroup(2)...,
+	)
+
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey := (*cecdsa.PublicKey)(privateKey.PubKey())
+
+	var tests = map[string]struct {
+		keep           chain.BondedECDSAKeepHandle
+		expectedReason string
+	}{
+		"keep of another application": {
+			keep: tbtcChain.OpenKeepForApplication(
+				local.RandomSigningGroup(1)[0],
+				local.RandomSigningGroup(1)[0],
+				local.RandomSigningGroup(1)[0],
+				members,
+			),
+			expectedReason: "other than tBTC",
+		},
+		"tBTC keep not owned by a deposit": {
+			keep: tbtcChain.OpenKeep(
+				local.RandomSigningGroup(1)[0],
+				local.RandomSigningGroup(1)[0],
+				members,
+			),
+			expectedReason: "is not a deposit",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			validator := NewRedemptionValidator(
+				tbtcChain,
+				tbtcChain.GetKeepApplication,
+			)
+
+			request := &policy.Request{
+				Keep:      test.keep,
+				PublicKey: publicKey,
+				Event: &chain.SignatureRequestedEvent{
+					Digest:      [32]byte{1},
+					BlockNumber: 10,
+				},
+			}
+
+			decision, err := validator.Validate(request)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if decision.Verdict != policy.Abstain {
+				t.Errorf(
+					"unexpected verdict\nexpected: [%v]\nactual:   [%v]",
+					policy.Abstain,
+					decision.Verdict,
+				)
+			}
+			if !strings.Contains(decision.Reason, test.expectedReason) {
+				t.Errorf("unexpected reason: [%v]", decision.Reason)
+			}
+
+			engine := policy.NewEngine(policy.ModeEnforce, validator)
+			if err := engine.Check(request); err != nil {
+				t.Errorf("unexpected error in enforce mode: [%v]", err)
+			}
+		})
+	}
+}
@@ -194,9 +194,13 @@ func buildSignedTransactionHexString(
 }
 
 // BuildBitcoinTransaction generates a signed transaction hex string that can
-// recover an underlying bitcoin deposit that has been liquidated.
+// recover an underlying bitcoin deposit that has been liquidated. The attempt
+// number identifies the signing session of the liquidation recovery attempt.
+// The signature calculated over the transaction sighash is passed to the
+// signature handler, if it is not nil.
 func BuildBitcoinTransaction(
 	ctx context.Context,
+	attempt int,
 	networkProvider net.Provider,
 	hostChain chain.Handle,
 	fundingInfo *chain.FundingInfo,
@@ -204,6 +208,7 @@ func BuildBitcoinTransaction(
 	chainParams *chaincfg.Params,
 	retrievalAddresses []string,
 	maxFeePerVByte int32,
+	handleSignature func(sighash [32]byte, signature *ecdsa.Signature),
 ) (string, error) {
 	scriptCodeBytes, err := publicKeyToP2WPKHScriptCode(signer.PublicKey(), chainParams)
 	if err != nil {
@@ -249,8 +254,10 @@ func BuildBitcoinTransaction(
 	signature, err := signer.CalculateSignature(
 		ctx,
 		sighashBytes,
+		attempt,
 		networkProvider,
 		hostChain.Signing().PublicKeyToAddress,
+		nil,
 	)
 	if err != nil {
 		return "", fmt.Errorf("failed to calculate signature: [%w]", err)
@@ -262,6 +269,12 @@ func BuildBitcoinTransaction(
 		signature,
 	)
 
+	if handleSignature != nil {
+		var sighash [32]byte
+		copy(sighash[:], sighashBytes)
+		handleSignature(sighash, signature)
+	}
+
 	return buildSignedTransactionHexString(
 		unsignedTransaction,
 		signature,
@@ -218,12 +218,14 @@ func TestBuildBitcoinTransaction(t *testing.T) {
 			signer, err := tss.GenerateThresholdSigner(
 				ctx,
 				keep.ID().String(),
+				1,
 				memberID,
 				groupMembers,
 				uint(len(groupMembers)-1),
 				networkProvider,
 				pubKeyToAddressFn,
 				params.NewBox(&preParams),
+				nil,
 			)
 			if err != nil {
 				errChan <- err
@@ -232,6 +234,7 @@ func TestBuildBitcoinTransaction(t *testing.T) {
 
 			signedBtcTransaction, err := BuildBitcoinTransaction(
 				ctx,
+				1,
 				networkProvider,
 				localChain,
 				fundingInfo,
@@ -239,6 +242,7 @@ func TestBuildBitcoinTransaction(t *testing.T) {
 				&chaincfg.MainNetParams,
 				btcAddresses,
 				maxFeePerVByte,
+				nil,
 			)
 			if err != nil {
 				errChan <- err
@@ -4,6 +4,7 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 	"math/big"
+	"strings"
 	"sync"
 	"time"
 
@@ -65,6 +66,39 @@ func NewStakeOrActiveKeepPolicy(
 	}
 }
 
+// NewAnyOfPolicy is a firewall policy letting the remote peer connect if it
+// meets at least one of the provided policies. It is used when one network
+// provider serves multiple chains and the remote peer has to be validated
+// against the policy of any of these chains.
+func NewAnyOfPolicy(policies ...coreNet.Firewall) coreNet.Firewall {
+	if len(policies) == 1 {
+		return policies[0]
+	}
+
+	return &anyOfPolicy{policies}
+}
+
+type anyOfPolicy struct {
+	policies []coreNet.Firewall
+}
+
+func (aop *anyOfPolicy) Validate(remotePeerPublicKey *ecdsa.PublicKey) error {
+	errs := make([]string, 0, len(aop.policies))
+	for _, policy := range aop.policies {
+		err := policy.Validate(remotePeerPublicKey)
+		if err == nil {
+			return nil
+		}
+
+		errs = append(errs, err.Error())
+	}
+
+	return fmt.Errorf(
+		"remote peer does not meet any of the policies: [%v]",
+		strings.Join(errs, "; "),
+	)
+}
+
 type stakeOrActiveKeepPolicy struct {
 	chain                       chain.Handle
 	minimumStakePolicy          coreNet.Firewall
@@ -802,6 +802,55 @@ func TestGetKeepMembersCaching(t *testing.T) {
 	}
 }
 
+// Meets policy of one of the chains.
+// Should allow to connect.
+func TestAnyOfPolicyOneMet(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	localChain := local.Connect(ctx)
+	firewall1 := newMockCoreFirewall()
+	firewall2 := newMockCoreFirewall()
+	policy := NewAnyOfPolicy(firewall1, firewall2)
+
+	remotePeerPublicKey, _, _ := newPeer(t, localChain)
+
+	firewall2.updatePeer(remotePeerPublicKey, true)
+
+	if err := policy.Validate(
+		key.NetworkKeyToECDSAKey(remotePeerPublicKey),
+	); err != nil {
+		t.Fatalf("validation should pass: [%v]", err)
+	}
+}
+
+// Meets policy of none of the chains.
+// Should NOT allow to connect.
+func TestAnyOfPolicyNoneMet(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	localChain := local.Connect(ctx)
+	firewall1 := newMockCoreFirewall()
+	firewall2 := newMockCoreFirewall()
+	policy := NewAnyOfPolicy(firewall1, firewall2)
+
+	remotePeerPublicKey, _, _ := newPeer(t, localChain)
+
+	expectedError := "remote peer does not meet any of the policies: " +
+		"[remote peer does not meet firewall criteria; " +
+		"remote peer does not meet firewall criteria]"
+
+	err := policy.Validate(key.NetworkKeyToECDSAKey(remotePeerPublicKey))
+	if err == nil || err.Error() != expectedError {
+		t.Fatalf(
+			"unexpected error\nexpected: [%v]\nactual:   [%v]",
+			expectedError,
+			err,
+		)
+	}
+}
+
 func createNewPolicy(
 	chainHandle chain.Handle,
 	coreFirewall coreNet.Firewall,
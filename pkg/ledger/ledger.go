@@ -0,0 +1,158 @@
+// Package ledger implements a local, append-only record of transactions
+// submitted by the client to the host chain, along with the gas and fees
+// spent on them.
+package ledger
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/keep-network/keep-ecdsa/pkg/utils/fileutil"
+)
+
+const (
+	directoryName = "ledger"
+	fileName      = "transactions.jsonl"
+)
+
+// Outcome is the final result of a transaction submitted to the host chain.
+type Outcome string
+
+const (
+	// OutcomeSucceeded marks a transaction that was mined and succeeded.
+	OutcomeSucceeded Outcome = "succeeded"
+	// OutcomeReverted marks a transaction that was mined but reverted, e.g.
+	// a signature submission that lost the race with another keep member.
+	// The fee of such a transaction is considered to be wasted.
+	OutcomeReverted Outcome = "reverted"
+	// OutcomeUnknown marks a transaction for which no receipt was found
+	// within the tracking period.
+	OutcomeUnknown Outcome = "unknown"
+)
+
+// Entry is a single ledger record describing a transaction submitted to the
+// host chain.
+type Entry struct {
+	Timestamp         time.Time `json:"timestamp"`
+	Chain             string    `json:"chain"`
+	Operation         string    `json:"operation"`
+	KeepID            string    `json:"keepId,omitempty"`
+	DepositAddress    string    `json:"depositAddress,omitempty"`
+	TransactionHash   string    `json:"transactionHash"`
+	GasUsed           uint64    `json:"gasUsed"`
+	EffectiveGasPrice *big.Int  `json:"effectiveGasPrice"`
+	Outcome           Outcome   `json:"outcome"`
+}
+
+// Fee returns the total fee paid for the transaction, that is, gas used
+// multiplied by the effective gas price.
+func (e *Entry) Fee() *big.Int {
+	if e.EffectiveGasPrice == nil {
+		return big.NewInt(0)
+	}
+
+	return new(big.Int).Mul(
+		new(big.Int).SetUint64(e.GasUsed),
+		e.EffectiveGasPrice,
+	)
+}
+
+// Ledger is an append-only ledger of transactions stored in a single file
+// under the data directory. Each entry is stored as a separate JSON line.
+// Transactions whose outcome is not known yet are stored in a separate file
+// until they are completed.
+type Ledger struct {
+	path        string
+	pendingPath string
+	mutex       sync.Mutex
+}
+
+// Open opens the ledger stored in the given data directory, creating the
+// ledger directory if it does not exist yet.
+func Open(dataDir string) (*Ledger, error) {
+	directory, err := fileutil.EnsureStoreDirectory(dataDir, directoryName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ledger{
+		path:        filepath.Join(directory, fileName),
+		pendingPath: filepath.Join(directory, pendingFileName),
+	}, nil
+}
+
+// Append appends the entry to the ledger. Entries are never modified or
+// removed once appended.
+func (l *Ledger) Append(entry *Entry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("could not marshal ledger entry: [%v]", err)
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	file, err := os.OpenFile(
+		l.path,
+		os.O_APPEND|os.O_CREATE|os.O_WRONLY,
+		0600,
+	)
+	if err != nil {
+		return fmt.Errorf("could not open ledger file: [%v]", err)
+	}
+	defer fileutil.CloseFile(file)
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("could not write ledger entry: [%v]", err)
+	}
+
+	return file.Sync()
+}
+
+// Entries returns all entries stored in the ledger, in the order they were
+// appended.
+func (l *Ledger) Entries() ([]*Entry, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	file, err := os.Open(l.path)
+	if os.IsNotExist(err) {
+		return []*Entry{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not open ledger file: [%v]", err)
+	}
+	defer fileutil.CloseFile(file)
+
+	entries := make([]*Entry, 0)
+
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		entry := &Entry{}
+		if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			return nil, fmt.Errorf(
+				"could not unmarshal ledger entry at line [%v]: [%v]",
+				lineNumber,
+				err,
+			)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read ledger file: [%v]", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,181 @@
+package ledger
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLedger_AppendAndRead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ledger, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := ledger.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("expected empty ledger, has [%v] entries", len(entries))
+	}
+
+	expectedEntries := []*Entry{
+		{
+			Timestamp:         time.Unix(1600000000, 0).UTC(),
+			Chain:             "ethereum",
+			Operation:         "SubmitSignature",
+			KeepID:            "0xA",
+			TransactionHash:   "0x01",
+			GasUsed:           50000,
+			EffectiveGasPrice: big.NewInt(100),
+			Outcome:           OutcomeReverted,
+		},
+		{
+			Timestamp:         time.Unix(1600000100, 0).UTC(),
+			Chain:             "ethereum",
+			Operation:         "RetrieveSignerPubkey",
+			DepositAddress:    "0xD",
+			TransactionHash:   "0x02",
+			GasUsed:           70000,
+			EffectiveGasPrice: big.NewInt(120),
+			Outcome:           OutcomeSucceeded,
+		},
+	}
+
+	for _, entry := range expectedEntries {
+		if err := ledger.Append(entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Reopen the ledger to make sure entries are read from disk.
+	ledger, err = Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err = ledger.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expectedEntries, entries) {
+		t.Errorf(
+			"unexpected entries\nexpected: %+v\nactual:   %+v",
+			expectedEntries,
+			entries,
+		)
+	}
+}
+
+func TestEntryFee(t *testing.T) {
+	entry := &Entry{GasUsed: 21000, EffectiveGasPrice: big.NewInt(3)}
+	if entry.Fee().Cmp(big.NewInt(63000)) != 0 {
+		t.Errorf("unexpected fee\nexpected: 63000\nactual:   %v", entry.Fee())
+	}
+
+	entry = &Entry{GasUsed: 21000}
+	if entry.Fee().Sign() != 0 {
+		t.Errorf("unexpected fee\nexpected: 0\nactual:   %v", entry.Fee())
+	}
+}
+
+func TestLedger_PendingSurvivesReopen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ledger, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := &Entry{
+		Timestamp:       time.Unix(1600000000, 0).UTC(),
+		Chain:           "ethereum",
+		Operation:       "SubmitSignature",
+		KeepID:          "0xA",
+		TransactionHash: "0x01",
+		Outcome:         OutcomeUnknown,
+	}
+	err = ledger.AddPending(&Pending{
+		Entry:             entry,
+		Nonce:             7,
+		TransactionHashes: []string{"0x01"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ledger.AddPendingTransactionHash("ethereum", 7, "0x02"); err != nil {
+		t.Fatal(err)
+	}
+	// Transactions of other chains and nonces are not affected.
+	if err := ledger.AddPendingTransactionHash("arbitrum", 7, "0x03"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reopen the ledger to make sure pending transactions are read from disk.
+	ledger, err = Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedPendings := []*Pending{
+		{
+			Entry:             entry,
+			Nonce:             7,
+			TransactionHashes: []string{"0x01", "0x02"},
+		},
+	}
+	pendings, err := ledger.Pending("ethereum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expectedPendings, pendings) {
+		t.Errorf(
+			"unexpected pending transactions\nexpected: %+v\nactual:   %+v",
+			expectedPendings,
+			pendings,
+		)
+	}
+
+	entry.TransactionHash = "0x02"
+	entry.GasUsed = 21000
+	entry.EffectiveGasPrice = big.NewInt(100)
+	entry.Outcome = OutcomeSucceeded
+	if err := ledger.Complete(7, entry); err != nil {
+		t.Fatal(err)
+	}
+
+	pendings, err = ledger.Pending("ethereum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pendings) != 0 {
+		t.Errorf("unexpected pending transactions: [%v]", len(pendings))
+	}
+
+	entries, err := ledger.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual([]*Entry{entry}, entries) {
+		t.Errorf(
+			"unexpected entries\nexpected: %+v\nactual:   %+v",
+			[]*Entry{entry},
+			entries,
+		)
+	}
+}
@@ -0,0 +1,170 @@
+package ledger
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+const pendingFileName = "pending.json"
+
+// Pending is a transaction submitted to the host chain whose outcome has not
+// been recorded in the ledger yet. Pending transactions are stored next to the
+// ledger so that they can be tracked again after the client restarts.
+type Pending struct {
+	Entry *Entry `json:"entry"`
+	// Nonce is the nonce of the transaction. The transaction may be
+	// resubmitted with a higher fee under the same nonce, so hashes of all
+	// transactions submitted with the nonce are stored.
+	Nonce             uint64   `json:"nonce"`
+	TransactionHashes []string `json:"transactionHashes"`
+}
+
+// AddPending stores the pending transaction. A pending transaction previously
+// stored for the same chain and nonce is replaced.
+func (l *Ledger) AddPending(pending *Pending) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	pendings, err := l.readPending()
+	if err != nil {
+		return err
+	}
+
+	updated := []*Pending{pending}
+	for _, stored := range pendings {
+		if stored.Entry.Chain == pending.Entry.Chain &&
+			stored.Nonce == pending.Nonce {
+			continue
+		}
+		updated = append(updated, stored)
+	}
+
+	return l.writePending(updated)
+}
+
+// AddPendingTransactionHash adds the hash of a transaction resubmitted with
+// the given nonce to the pending transaction stored for the chain and nonce.
+// If there is no such pending transaction, this function does nothing.
+func (l *Ledger) AddPendingTransactionHash(
+	chain string,
+	nonce uint64,
+	transactionHash string,
+) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	pendings, err := l.readPending()
+	if err != nil {
+		return err
+	}
+
+	for _, pending := range pendings {
+		if pending.Entry.Chain != chain || pending.Nonce != nonce {
+			continue
+		}
+
+		for _, hash := range pending.TransactionHashes {
+			if hash == transactionHash {
+				return nil
+			}
+		}
+		pending.TransactionHashes = append(
+			pending.TransactionHashes,
+			transactionHash,
+		)
+
+		return l.writePending(pendings)
+	}
+
+	return nil
+}
+
+// Pending returns pending transactions stored for the given chain.
+func (l *Ledger) Pending(chain string) ([]*Pending, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	pendings, err := l.readPending()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*Pending, 0)
+	for _, pending := range pendings {
+		if pending.Entry.Chain == chain {
+			result = append(result, pending)
+		}
+	}
+
+	return result, nil
+}
+
+// Complete appends the entry of the pending transaction submitted with the
+// given nonce to the ledger and removes the pending transaction of the entry's
+// chain.
+func (l *Ledger) Complete(nonce uint64, entry *Entry) error {
+	if err := l.Append(entry); err != nil {
+		return err
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	pendings, err := l.readPending()
+	if err != nil {
+		return err
+	}
+
+	remaining := make([]*Pending, 0, len(pendings))
+	for _, pending := range pendings {
+		if pending.Entry.Chain == entry.Chain && pending.Nonce == nonce {
+			continue
+		}
+		remaining = append(remaining, pending)
+	}
+
+	return l.writePending(remaining)
+}
+
+func (l *Ledger) readPending() ([]*Pending, error) {
+	content, err := ioutil.ReadFile(l.pendingPath)
+	if os.IsNotExist(err) {
+		return []*Pending{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read pending transactions: [%v]", err)
+	}
+
+	pendings := make([]*Pending, 0)
+	if err := json.Unmarshal(content, &pendings); err != nil {
+		return nil, fmt.Errorf(
+			"could not unmarshal pending transactions: [%v]",
+			err,
+		)
+	}
+
+	return pendings, nil
+}
+
+// writePending replaces the stored pending transactions. The file is written
+// next to the final one and renamed, so that a crash while writing does not
+// lose pending transactions stored before.
+func (l *Ledger) writePending(pendings []*Pending) error {
+	content, err := json.Marshal(pendings)
+	if err != nil {
+		return fmt.Errorf("could not marshal pending transactions: [%v]", err)
+	}
+
+	temporaryPath := l.pendingPath + ".tmp"
+	if err := ioutil.WriteFile(temporaryPath, content, 0600); err != nil {
+		return fmt.Errorf("could not write pending transactions: [%v]", err)
+	}
+
+	if err := os.Rename(temporaryPath, l.pendingPath); err != nil {
+		return fmt.Errorf("could not store pending transactions: [%v]", err)
+	}
+
+	return nil
+}
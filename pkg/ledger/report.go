@@ -0,0 +1,155 @@
+package ledger
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// GroupBy determines how ledger entries are aggregated in a report.
+type GroupBy string
+
+const (
+	// GroupByKeep aggregates entries per keep. Entries not related to any
+	// keep are aggregated under an empty key.
+	GroupByKeep GroupBy = "keep"
+	// GroupByOperation aggregates entries per chain operation.
+	GroupByOperation GroupBy = "operation"
+)
+
+// TimeRange limits report entries to those with a timestamp in [From, To).
+// Zero values mean the range is unbounded on the given side.
+type TimeRange struct {
+	From time.Time
+	To   time.Time
+}
+
+func (tr TimeRange) contains(timestamp time.Time) bool {
+	if !tr.From.IsZero() && timestamp.Before(tr.From) {
+		return false
+	}
+	if !tr.To.IsZero() && !timestamp.Before(tr.To) {
+		return false
+	}
+	return true
+}
+
+// Summary aggregates spending of a group of ledger entries.
+type Summary struct {
+	Key          string   `json:"key"`
+	Transactions int      `json:"transactions"`
+	Reverted     int      `json:"reverted"`
+	Unknown      int      `json:"unknown"`
+	GasUsed      uint64   `json:"gasUsed"`
+	TotalFee     *big.Int `json:"totalFee"`
+	WastedFee    *big.Int `json:"wastedFee"`
+}
+
+// Summarize aggregates ledger entries within the time range by the given
+// grouping. Returned summaries are sorted by key.
+func Summarize(
+	entries []*Entry,
+	groupBy GroupBy,
+	timeRange TimeRange,
+) ([]*Summary, error) {
+	summaries := make(map[string]*Summary)
+
+	for _, entry := range entries {
+		if !timeRange.contains(entry.Timestamp) {
+			continue
+		}
+
+		var key string
+		switch groupBy {
+		case GroupByKeep:
+			key = entry.KeepID
+		case GroupByOperation:
+			key = entry.Operation
+		default:
+			return nil, fmt.Errorf("unsupported grouping: [%v]", groupBy)
+		}
+
+		summary, ok := summaries[key]
+		if !ok {
+			summary = &Summary{
+				Key:       key,
+				TotalFee:  big.NewInt(0),
+				WastedFee: big.NewInt(0),
+			}
+			summaries[key] = summary
+		}
+
+		fee := entry.Fee()
+
+		summary.Transactions++
+		summary.GasUsed += entry.GasUsed
+		summary.TotalFee.Add(summary.TotalFee, fee)
+
+		switch entry.Outcome {
+		case OutcomeReverted:
+			summary.Reverted++
+			summary.WastedFee.Add(summary.WastedFee, fee)
+		case OutcomeUnknown:
+			summary.Unknown++
+		}
+	}
+
+	result := make([]*Summary, 0, len(summaries))
+	for _, summary := range summaries {
+		result = append(result, summary)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+
+	return result, nil
+}
+
+// WriteCSV writes summaries in the CSV format, preceded by a header line.
+func WriteCSV(writer io.Writer, summaries []*Summary) error {
+	csvWriter := csv.NewWriter(writer)
+
+	err := csvWriter.Write([]string{
+		"key",
+		"transactions",
+		"reverted",
+		"unknown",
+		"gas_used",
+		"total_fee",
+		"wasted_fee",
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, summary := range summaries {
+		err := csvWriter.Write([]string{
+			summary.Key,
+			strconv.Itoa(summary.Transactions),
+			strconv.Itoa(summary.Reverted),
+			strconv.Itoa(summary.Unknown),
+			strconv.FormatUint(summary.GasUsed, 10),
+			summary.TotalFee.String(),
+			summary.WastedFee.String(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// WriteJSON writes summaries as an indented JSON array.
+func WriteJSON(writer io.Writer, summaries []*Summary) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(summaries)
+}
@@ -0,0 +1,149 @@
+package ledger
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var testEntries = []*Entry{
+	{
+		Timestamp:         time.Unix(1000, 0),
+		Operation:         "SubmitKeepPublicKey",
+		KeepID:            "0xA",
+		GasUsed:           100,
+		EffectiveGasPrice: big.NewInt(10),
+		Outcome:           OutcomeSucceeded,
+	},
+	{
+		Timestamp:         time.Unix(2000, 0),
+		Operation:         "SubmitSignature",
+		KeepID:            "0xA",
+		GasUsed:           50,
+		EffectiveGasPrice: big.NewInt(10),
+		Outcome:           OutcomeReverted,
+	},
+	{
+		Timestamp:         time.Unix(3000, 0),
+		Operation:         "SubmitSignature",
+		KeepID:            "0xB",
+		GasUsed:           60,
+		EffectiveGasPrice: big.NewInt(20),
+		Outcome:           OutcomeSucceeded,
+	},
+	{
+		Timestamp: time.Unix(4000, 0),
+		Operation: "UpdateStatusForApplication",
+		Outcome:   OutcomeUnknown,
+	},
+}
+
+func TestSummarize(t *testing.T) {
+	var tests = map[string]struct {
+		groupBy           GroupBy
+		timeRange         TimeRange
+		expectedSummaries []*Summary
+	}{
+		"by keep": {
+			groupBy: GroupByKeep,
+			expectedSummaries: []*Summary{
+				{
+					Key:          "",
+					Transactions: 1,
+					Unknown:      1,
+					TotalFee:     big.NewInt(0),
+					WastedFee:    big.NewInt(0),
+				},
+				{
+					Key:          "0xA",
+					Transactions: 2,
+					Reverted:     1,
+					GasUsed:      150,
+					TotalFee:     big.NewInt(1500),
+					WastedFee:    big.NewInt(500),
+				},
+				{
+					Key:          "0xB",
+					Transactions: 1,
+					GasUsed:      60,
+					TotalFee:     big.NewInt(1200),
+					WastedFee:    big.NewInt(0),
+				},
+			},
+		},
+		"by operation within time range": {
+			groupBy: GroupByOperation,
+			timeRange: TimeRange{
+				From: time.Unix(2000, 0),
+				To:   time.Unix(4000, 0),
+			},
+			expectedSummaries: []*Summary{
+				{
+					Key:          "SubmitSignature",
+					Transactions: 2,
+					Reverted:     1,
+					GasUsed:      110,
+					TotalFee:     big.NewInt(1700),
+					WastedFee:    big.NewInt(500),
+				},
+			},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			summaries, err := Summarize(testEntries, test.groupBy, test.timeRange)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(test.expectedSummaries, summaries) {
+				t.Errorf(
+					"unexpected summaries\nexpected: %+v\nactual:   %+v",
+					test.expectedSummaries,
+					summaries,
+				)
+			}
+		})
+	}
+}
+
+func TestSummarize_UnsupportedGrouping(t *testing.T) {
+	_, err := Summarize(testEntries, GroupBy("deposit"), TimeRange{})
+
+	expectedError := "unsupported grouping: [deposit]"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf(
+			"unexpected error\nexpected: %v\nactual:   %v",
+			expectedError,
+			err,
+		)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	summaries, err := Summarize(testEntries, GroupByOperation, TimeRange{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := WriteCSV(buffer, summaries); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedCSV := "key,transactions,reverted,unknown,gas_used,total_fee,wasted_fee\n" +
+		"SubmitKeepPublicKey,1,0,0,100,1000,0\n" +
+		"SubmitSignature,2,1,0,110,1700,500\n" +
+		"UpdateStatusForApplication,1,0,1,0,0,0\n"
+
+	if buffer.String() != expectedCSV {
+		t.Errorf(
+			"unexpected CSV\nexpected:\n%s\nactual:\n%s",
+			expectedCSV,
+			buffer.String(),
+		)
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-ecdsa/pkg/attribution"
 	"github.com/keep-network/keep-ecdsa/pkg/client"
+	"github.com/keep-network/keep-ecdsa/pkg/reputation"
 
 	"github.com/keep-network/keep-common/pkg/metrics"
 )
@@ -39,6 +41,69 @@ func ObserveTSSPreParamsPoolSize(
 	)
 }
 
+// ObserveAttributedProtocolFailures triggers an observation process of the
+// tss_attributed_protocol_failures metric.
+func ObserveAttributedProtocolFailures(
+	ctx context.Context,
+	registry *metrics.Registry,
+	attributionStore *attribution.Store,
+	tick time.Duration,
+) {
+	input := func() float64 {
+		return float64(attributionStore.RecordedCount())
+	}
+
+	observe(
+		ctx,
+		"tss_attributed_protocol_failures",
+		input,
+		registry,
+		validateTick(tick, DefaultClientMetricsTick),
+	)
+}
+
+// ObserveTrackedPeers triggers an observation process of the
+// tss_tracked_peers metric.
+func ObserveTrackedPeers(
+	ctx context.Context,
+	registry *metrics.Registry,
+	reputationStore *reputation.Store,
+	tick time.Duration,
+) {
+	input := func() float64 {
+		return float64(reputationStore.PeersCount())
+	}
+
+	observe(
+		ctx,
+		"tss_tracked_peers",
+		input,
+		registry,
+		validateTick(tick, DefaultClientMetricsTick),
+	)
+}
+
+// ObserveUnreliablePeers triggers an observation process of the
+// tss_unreliable_peers metric.
+func ObserveUnreliablePeers(
+	ctx context.Context,
+	registry *metrics.Registry,
+	reputationStore *reputation.Store,
+	tick time.Duration,
+) {
+	input := func() float64 {
+		return float64(reputationStore.UnreliablePeersCount())
+	}
+
+	observe(
+		ctx,
+		"tss_unreliable_peers",
+		input,
+		registry,
+		validateTick(tick, DefaultClientMetricsTick),
+	)
+}
+
 func observe(
 	ctx context.Context,
 	name string,
@@ -0,0 +1,92 @@
+package node
+
+import (
+	"errors"
+	"time"
+
+	"github.com/keep-network/keep-ecdsa/pkg/attribution"
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+)
+
+const (
+	keyGenerationProtocol = "key generation"
+	signingProtocol       = "signing"
+)
+
+// recordProtocolFailure logs members the failed protocol attempt is
+// attributed to and records the failure in the attribution and reputation
+// stores, if the node has them. Failures not attributed to any member are not
+// recorded.
+func (n *Node) recordProtocolFailure(
+	keepID chain.ID,
+	protocol string,
+	attempt int,
+	err error,
+) {
+	var protocolError *tss.ProtocolError
+	if !errors.As(err, &protocolError) {
+		return
+	}
+
+	culprits := n.memberIDsToOperatorIDs(protocolError.Culprits)
+	missingOperators := n.memberIDsToOperatorIDs(protocolError.MissingMembers)
+
+	if len(culprits) == 0 && len(missingOperators) == 0 {
+		return
+	}
+
+	logger.Warningf(
+		"[%s] attempt [%v] for keep [%s] failed on stage [%s] in round [%v]; "+
+			"culprits: %v; missing operators: %v",
+		protocol,
+		attempt,
+		keepID,
+		protocolError.Stage,
+		protocolError.Round,
+		culprits,
+		missingOperators,
+	)
+
+	n.recordPeerFailures(protocolError)
+
+	if n.attributionStore == nil {
+		return
+	}
+
+	recordErr := n.attributionStore.Record(&attribution.Failure{
+		Timestamp:        time.Now().UTC(),
+		Chain:            n.chain.Name(),
+		KeepID:           keepID.String(),
+		Protocol:         protocol,
+		Attempt:          attempt,
+		Stage:            protocolError.Stage,
+		Round:            protocolError.Round,
+		Culprits:         culprits,
+		MissingOperators: missingOperators,
+		Error:            err.Error(),
+	})
+	if recordErr != nil {
+		logger.Errorf(
+			"failed to record [%s] failure for keep [%s]: [%v]",
+			protocol,
+			keepID,
+			recordErr,
+		)
+	}
+}
+
+func (n *Node) memberIDsToOperatorIDs(memberIDs []tss.MemberID) []string {
+	operatorIDs := []string{}
+
+	for _, memberID := range memberIDs {
+		operatorID, ok := n.memberIDToOperatorID(memberID)
+		if !ok {
+			continue
+		}
+
+		operatorIDs = append(operatorIDs, operatorID.String())
+	}
+
+	return operatorIDs
+}
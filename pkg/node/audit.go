@@ -0,0 +1,55 @@
+package node
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/keep-network/keep-ecdsa/pkg/audit"
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+)
+
+// RecordSignature appends the signature calculated by the signer for the keep
+// to the audit log, if the node has one. The request identifies the digest
+// and, unless the signature has not been requested on-chain, the requesting
+// event. Other members of the signing group are recorded as co-signers.
+func (n *Node) RecordSignature(
+	source audit.Source,
+	keepID chain.ID,
+	signer *tss.ThresholdSigner,
+	request *chain.SignatureRequestedEvent,
+	signature *ecdsa.Signature,
+) {
+	if n.auditLog == nil {
+		return
+	}
+
+	coSignerIDs := []tss.MemberID{}
+	for _, memberID := range signer.GroupMemberIDs() {
+		if !memberID.Equal(signer.MemberID()) {
+			coSignerIDs = append(coSignerIDs, memberID)
+		}
+	}
+
+	err := n.auditLog.Append(&audit.Entry{
+		Timestamp:              time.Now().UTC(),
+		Source:                 source,
+		Chain:                  n.chain.Name(),
+		KeepID:                 keepID.String(),
+		Digest:                 hex.EncodeToString(request.Digest[:]),
+		RequestBlock:           request.BlockNumber,
+		RequestTransactionHash: request.TransactionHash,
+		Signature:              audit.NewSignature(signature),
+		CoSigners:              n.memberIDsToOperatorIDs(coSignerIDs),
+	})
+	if err != nil {
+		logger.Errorf(
+			"failed to record signature for keep [%s] and digest [%x] "+
+				"in the audit log: [%v]",
+			keepID,
+			request.Digest,
+			err,
+		)
+	}
+}
@@ -0,0 +1,47 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/keep-network/keep-core/pkg/net"
+)
+
+// chainScopedProvider is a network provider scoping broadcast channel names
+// to the given chain so that keeps with the same identifier on different
+// chains do not share a broadcast channel when one network provider serves
+// multiple chains.
+type chainScopedProvider struct {
+	net.Provider
+
+	chainName string
+}
+
+// ChainScopedProvider wraps the network provider so that all broadcast
+// channels it provides are scoped to the chain with the given name. It should
+// be used only for chains operated in the multi-chain mode; members of keeps
+// of the chain configured in the top level chain sections have been using
+// bare keep identifiers as channel names and have to keep using them to hear
+// members running a client without the multi-chain mode.
+func ChainScopedProvider(
+	networkProvider net.Provider,
+	chainName string,
+) net.Provider {
+	return &chainScopedProvider{
+		Provider:  networkProvider,
+		chainName: chainName,
+	}
+}
+
+func (csp *chainScopedProvider) BroadcastChannelFor(
+	name string,
+) (net.BroadcastChannel, error) {
+	return csp.Provider.BroadcastChannelFor(csp.channelName(name))
+}
+
+func (csp *chainScopedProvider) BroadcastChannelForwarderFor(name string) {
+	csp.Provider.BroadcastChannelForwarderFor(csp.channelName(name))
+}
+
+func (csp *chainScopedProvider) channelName(name string) string {
+	return fmt.Sprintf("%s-%s", csp.chainName, name)
+}
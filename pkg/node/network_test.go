@@ -0,0 +1,78 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/net/local"
+)
+
+func TestChainScopedProvider(t *testing.T) {
+	var tests = map[string]struct {
+		chainName           string
+		expectedChannelName string
+	}{
+		"ethereum": {
+			chainName:           "ethereum",
+			expectedChannelName: "ethereum-0x4e09cadc7037afa36603138d1c0b76fe2aa5039c",
+		},
+		"other network": {
+			chainName:           "arbitrum",
+			expectedChannelName: "arbitrum-0x4e09cadc7037afa36603138d1c0b76fe2aa5039c",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			networkProvider := ChainScopedProvider(local.Connect(), test.chainName)
+
+			broadcastChannel, err := networkProvider.BroadcastChannelFor(
+				"0x4e09cadc7037afa36603138d1c0b76fe2aa5039c",
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if broadcastChannel.Name() != test.expectedChannelName {
+				t.Errorf(
+					"unexpected channel name\nexpected: [%v]\nactual:   [%v]",
+					test.expectedChannelName,
+					broadcastChannel.Name(),
+				)
+			}
+		})
+	}
+}
+
+func TestChainScopedProvider_TwoEVMChains(t *testing.T) {
+	networkProvider := local.Connect()
+
+	keepID := "0x4e09cadc7037afa36603138d1c0b76fe2aa5039c"
+
+	// The chain configured in the top level chain sections is not scoped.
+	ethereumChannel, err := networkProvider.BroadcastChannelFor(keepID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	arbitrumChannel, err := ChainScopedProvider(
+		networkProvider,
+		"arbitrum",
+	).BroadcastChannelFor(keepID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ethereumChannel.Name() != keepID {
+		t.Errorf(
+			"unexpected channel name\nexpected: [%v]\nactual:   [%v]",
+			keepID,
+			ethereumChannel.Name(),
+		)
+	}
+	if ethereumChannel.Name() == arbitrumChannel.Name() {
+		t.Errorf(
+			"keeps with the same ID on two chains share channel [%v]",
+			ethereumChannel.Name(),
+		)
+	}
+}
@@ -17,10 +17,14 @@ import (
 	"github.com/ipfs/go-log"
 
 	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-ecdsa/pkg/attribution"
+	"github.com/keep-network/keep-ecdsa/pkg/audit"
 	"github.com/keep-network/keep-ecdsa/pkg/chain"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss/params"
+	"github.com/keep-network/keep-ecdsa/pkg/policy"
+	"github.com/keep-network/keep-ecdsa/pkg/reputation"
 )
 
 var logger = log.Logger("keep-ecdsa")
@@ -45,31 +49,55 @@ const (
 type Node struct {
 	chain           chain.Handle
 	networkProvider net.Provider
-	tssParamsPool   *tssPreParamsPool
+	tssParamsPool   *TSSPreParamsPool
 	tssConfig       *tss.Config
+
+	attributionStore *attribution.Store
+	reputationStore  *reputation.Store
+	auditLog         *audit.Log
+	signingPolicy    *policy.Engine
+
+	knownMembers *knownMembers
 }
 
-// NewNode initializes node struct with provided chain interface and
-// network provider. It also initializes TSS Pre-Parameters pool. But does not
-// start parameters generation. This should be called separately.
+// NewNode initializes node struct with provided chain interface, network
+// provider and the pool of TSS pre-parameters used for key generation.
+// Failed protocol attempts are recorded in the attribution store, outcomes
+// of protocols for peers in the reputation store and produced signatures in
+// the audit log, unless they are nil. Signature requests are checked against
+// the signing policy, unless it is nil.
 func NewNode(
 	chain chain.Handle,
 	networkProvider net.Provider,
 	tssConfig *tss.Config,
+	tssParamsPool *TSSPreParamsPool,
+	attributionStore *attribution.Store,
+	reputationStore *reputation.Store,
+	auditLog *audit.Log,
+	signingPolicy *policy.Engine,
 ) *Node {
 	return &Node{
-		chain:           chain,
-		networkProvider: networkProvider,
-		tssConfig:       tssConfig,
+		chain:            chain,
+		networkProvider:  networkProvider,
+		tssConfig:        tssConfig,
+		tssParamsPool:    tssParamsPool,
+		attributionStore: attributionStore,
+		reputationStore:  reputationStore,
+		auditLog:         auditLog,
+		signingPolicy:    signingPolicy,
+		knownMembers:     newKnownMembers(),
 	}
 }
 
 // AnnounceSignerPresence triggers the announce protocol in order to signal
-// signer presence and gather information about other signers.
+// signer presence and gather information about other signers. The announcement
+// is scoped to the given attempt of the given protocol executed for the keep.
 func (n *Node) AnnounceSignerPresence(
 	ctx context.Context,
 	operatorPublicKey *operator.PublicKey,
 	keepID chain.ID,
+	protocol string,
+	attempt int,
 	keepMemberIDs []chain.ID,
 ) ([]tss.MemberID, error) {
 	broadcastChannel, err := n.networkProvider.BroadcastChannelFor(keepID.String())
@@ -88,14 +116,25 @@ func (n *Node) AnnounceSignerPresence(
 		return nil, fmt.Errorf("failed to set broadcast channel filter: [%v]", err)
 	}
 
-	return tss.AnnounceProtocol(
+	memberIDs, err := tss.AnnounceProtocol(
 		ctx,
 		operatorPublicKey,
 		keepID,
+		protocol,
+		attempt,
 		keepMemberIDs,
 		broadcastChannel,
 		n.chain.PublicKeyToOperatorID,
+		n.peerObserver(),
 	)
+	if err != nil {
+		n.recordAnnounceFailure(err)
+		return nil, err
+	}
+
+	n.rememberMembers(memberIDs)
+
+	return memberIDs, nil
 }
 
 func createAddressFilter(
@@ -138,6 +177,7 @@ func (n *Node) GenerateSignerForKeep(
 	memberID := tss.MemberIDFromPublicKey(operatorPublicKey)
 	preParamsBox := params.NewBox(n.tssParamsPool.get())
 
+	attemptLimit := maxAttempt(ctx)
 	attemptCounter := 0
 	for {
 		attemptCounter++
@@ -178,6 +218,14 @@ func (n *Node) GenerateSignerForKeep(
 			return nil, fmt.Errorf("key generation timeout exceeded")
 		}
 
+		// Check if other members are reachable and report their reachability
+		// before announcing presence. A member which can not be dialed
+		// directly, e.g. behind NAT or a relay, may still connect to this
+		// node itself, so the announcement proceeds regardless of the report.
+		n.recordUnreachableMembers(
+			logReachability(keep.ID(), n.ProbeKeepMembers(members)),
+		)
+
 		// Announce signer presence. Other members of the keep need to receive
 		// the public key of this members. This member, need to receive public
 		// keys of all other members. Up to this point, only addresses from
@@ -188,10 +236,15 @@ func (n *Node) GenerateSignerForKeep(
 			ctx,
 			operatorPublicKey,
 			keep.ID(),
+			tss.KeyGenerationProtocol,
+			attemptCounter,
 			members,
 		)
 		if err != nil {
 			logger.Warningf("failed to announce signer presence: [%v]", err)
+			if catchUpAttempt(&attemptCounter, attemptLimit, err) {
+				continue
+			}
 			time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
 			continue
 		}
@@ -203,19 +256,34 @@ func (n *Node) GenerateSignerForKeep(
 		signer, err := tss.GenerateThresholdSigner(
 			ctx,
 			keep.ID().String(),
+			attemptCounter,
 			memberID,
 			memberIDs,
 			uint(len(memberIDs)-1),
 			n.networkProvider,
 			n.chain.Signing().PublicKeyToAddress,
 			preParamsBox,
+			n.peerObserver(),
 		)
 		if err != nil {
 			logger.Errorf("failed to generate threshold signer: [%v]", err)
+			if catchUpAttempt(&attemptCounter, attemptLimit, err) {
+				continue
+			}
+			n.recordProtocolFailure(
+				keep.ID(),
+				keyGenerationProtocol,
+				attemptCounter,
+				err,
+			)
 			time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
 			continue
 		}
 
+		n.recordParticipation(memberID, memberIDs)
+
+		signer.SetChainName(n.chain.Name())
+
 		// Make a snapshot of the generated signer before publishing the public
 		// key to the keep. This guarantees the signer and their key share are
 		// safely persisted before the public key is registered on-chain.
@@ -251,8 +319,12 @@ func (n *Node) GenerateSignerForKeep(
 	}
 }
 
-// CalculateSignature calculates a signature over a digest with threshold
-// signer and publishes the result to the keep associated with the signer.
+// CalculateSignature calculates a signature over the digest of the signature
+// request with threshold signer and publishes the result to the keep
+// associated with the signer.
+//
+// The signature request is checked against the signing policy first; the
+// function returns policy.ErrVetoed if signing has been refused.
 //
 // The attempt for generating and publishing signature is retried on failure
 // until the provided context is done.
@@ -260,10 +332,22 @@ func (n *Node) CalculateSignature(
 	ctx context.Context,
 	keep chain.BondedECDSAKeepHandle,
 	signer *tss.ThresholdSigner,
-	digest [32]byte,
+	request *chain.SignatureRequestedEvent,
 ) error {
+	if n.signingPolicy != nil {
+		if err := n.signingPolicy.Check(&policy.Request{
+			Keep:      keep,
+			PublicKey: signer.PublicKey(),
+			Event:     request,
+		}); err != nil {
+			return err
+		}
+	}
+
+	digest := request.Digest
 	keepAddress := common.HexToAddress(signer.GroupID())
 
+	attemptLimit := maxAttempt(ctx)
 	attemptCounter := 0
 	for {
 		attemptCounter++
@@ -287,8 +371,10 @@ func (n *Node) CalculateSignature(
 		signature, err := signer.CalculateSignature(
 			ctx,
 			digest[:],
+			attemptCounter,
 			n.networkProvider,
 			n.chain.Signing().PublicKeyToAddress,
+			n.peerObserver(),
 		)
 		if err != nil {
 			logger.Errorf(
@@ -296,6 +382,15 @@ func (n *Node) CalculateSignature(
 				keepAddress.String(),
 				err,
 			)
+			if catchUpAttempt(&attemptCounter, attemptLimit, err) {
+				continue
+			}
+			n.recordProtocolFailure(
+				keep.ID(),
+				signingProtocol,
+				attemptCounter,
+				err,
+			)
 			time.Sleep(retryDelay) // TODO: #413 Replace with backoff.
 			continue
 		}
@@ -306,6 +401,15 @@ func (n *Node) CalculateSignature(
 			signature,
 		)
 
+		n.recordParticipation(signer.MemberID(), signer.GroupMemberIDs())
+		n.RecordSignature(
+			audit.SourceSignatureRequested,
+			keep.ID(),
+			signer,
+			request,
+			signature,
+		)
+
 		// We have the signature so now we need to publish it.
 		// This function implements internal retries so we do not need to
 		// retry here.
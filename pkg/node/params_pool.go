@@ -7,42 +7,65 @@ import (
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
 )
 
-// tssPreParamsPool is a pool holding TSS pre parameters. It autogenerates entries
-// up to the pool size. When an entry is pulled from the pool it will generate
-// new entry.
-type tssPreParamsPool struct {
+// TSSPreParamsPool is a pool holding TSS pre parameters. It autogenerates
+// entries up to the pool size. When an entry is pulled from the pool it will
+// generate new entry. A single pool can be shared by nodes operating on
+// different chains.
+type TSSPreParamsPool struct {
 	pool chan *keygen.LocalPreParams
 	new  func() (*keygen.LocalPreParams, error)
 }
 
-// InitializeTSSPreParamsPool generates TSS pre-parameters and stores them in a pool.
-func (n *Node) InitializeTSSPreParamsPool() {
-	poolSize := n.tssConfig.GetPreParamsTargetPoolSize()
+// NewTSSPreParamsPool creates a pool of TSS pre-parameters and starts
+// generating them in the background.
+func NewTSSPreParamsPool(tssConfig *tss.Config) *TSSPreParamsPool {
+	poolSize := tssConfig.GetPreParamsTargetPoolSize()
 
 	logger.Infof("TSS pre-parameters target pool size is [%v]", poolSize)
 
-	n.tssParamsPool = &tssPreParamsPool{
-		pool: make(chan *keygen.LocalPreParams, poolSize),
-		new: func() (*keygen.LocalPreParams, error) {
+	return NewTSSPreParamsPoolWithSource(
+		poolSize,
+		func() (*keygen.LocalPreParams, error) {
 			return tss.GenerateTSSPreParams(
-				n.tssConfig.GetPreParamsGenerationTimeout(),
+				tssConfig.GetPreParamsGenerationTimeout(),
 			)
 		},
+	)
+}
+
+// NewTSSPreParamsPoolWithSource creates a pool of TSS pre-parameters of the
+// given size and starts filling it in the background with pre-parameters
+// obtained from the given source. It lets tests use precomputed
+// pre-parameters instead of generating them.
+func NewTSSPreParamsPoolWithSource(
+	poolSize int,
+	source func() (*keygen.LocalPreParams, error),
+) *TSSPreParamsPool {
+	tssParamsPool := &TSSPreParamsPool{
+		pool: make(chan *keygen.LocalPreParams, poolSize),
+		new:  source,
 	}
 
-	go n.tssParamsPool.pumpPool()
+	go tssParamsPool.pumpPool()
+
+	return tssParamsPool
 }
 
-// TSSPreParamsPoolSize returns the current size of the TSS params pool.
-func (n *Node) TSSPreParamsPoolSize() int {
-	if n.tssParamsPool == nil {
+// Size returns the current size of the pool.
+func (t *TSSPreParamsPool) Size() int {
+	if t == nil {
 		return 0
 	}
 
-	return len(n.tssParamsPool.pool)
+	return len(t.pool)
+}
+
+// TSSPreParamsPoolSize returns the current size of the TSS params pool.
+func (n *Node) TSSPreParamsPoolSize() int {
+	return n.tssParamsPool.Size()
 }
 
-func (t *tssPreParamsPool) pumpPool() {
+func (t *TSSPreParamsPool) pumpPool() {
 	for {
 		logger.Info("generating new tss pre parameters")
 
@@ -70,6 +93,6 @@ func (t *tssPreParamsPool) pumpPool() {
 
 // get returns TSS pre parameters from the pool. It pumps the pool after getting
 // and entry. If the pool is empty it will wait for a new entry to be generated.
-func (t *tssPreParamsPool) get() *keygen.LocalPreParams {
+func (t *TSSPreParamsPool) get() *keygen.LocalPreParams {
 	return <-t.pool
 }
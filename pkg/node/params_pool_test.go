@@ -167,8 +167,8 @@ func TestTSSPreParamsPoolConcurrent(t *testing.T) {
 	}
 }
 
-func newTestPool(poolSize int) *tssPreParamsPool {
-	return &tssPreParamsPool{
+func newTestPool(poolSize int) *TSSPreParamsPool {
+	return &TSSPreParamsPool{
 		pool: make(chan *keygen.LocalPreParams, poolSize),
 		new: func() (*keygen.LocalPreParams, error) {
 			time.Sleep(10 * time.Millisecond)
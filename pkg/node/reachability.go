@@ -0,0 +1,220 @@
+package node
+
+import (
+	cecdsa "crypto/ecdsa"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+)
+
+const (
+	// Determines how many times dialing a member not connected yet is
+	// retried during the reachability probe and how long to wait between
+	// attempts. The probe is expected to take much less than the announce
+	// protocol which would time out for an unreachable member.
+	reachabilityProbeRetryCount    = 1
+	reachabilityProbeRetryWaitTime = 5 * time.Second
+)
+
+// Reachability determines whether a keep member can be reached over the
+// network.
+type Reachability int
+
+const (
+	// MemberUnknown means the member is not connected and its network
+	// identity is not known yet so it could not be dialed. It will be known
+	// once the member announces its presence.
+	MemberUnknown Reachability = iota
+	// MemberConnected means the member is connected.
+	MemberConnected
+	// MemberDialed means the member was not connected but has been
+	// successfully dialed.
+	MemberDialed
+	// MemberUnreachable means the member was not connected and dialing it
+	// failed.
+	MemberUnreachable
+)
+
+func (r Reachability) String() string {
+	switch r {
+	case MemberConnected:
+		return "connected"
+	case MemberDialed:
+		return "dialed"
+	case MemberUnreachable:
+		return "unreachable"
+	default:
+		return "unknown"
+	}
+}
+
+// MemberReachability holds the reachability of a single keep member. Err is
+// set for unreachable members and describes why dialing failed.
+type MemberReachability struct {
+	Operator     chain.ID
+	Reachability Reachability
+	Err          error
+}
+
+// knownMembers caches member identifiers of operators the node learned about
+// from connected peers and announcements, so the operators can be dialed
+// when they are not connected.
+type knownMembers struct {
+	mutex   sync.RWMutex
+	members map[string]tss.MemberID // operator ID -> member ID
+}
+
+func newKnownMembers() *knownMembers {
+	return &knownMembers{
+		members: make(map[string]tss.MemberID),
+	}
+}
+
+func (km *knownMembers) add(operatorID chain.ID, memberID tss.MemberID) {
+	km.mutex.Lock()
+	defer km.mutex.Unlock()
+
+	km.members[strings.ToLower(operatorID.String())] = memberID
+}
+
+func (km *knownMembers) get(operatorID chain.ID) (tss.MemberID, bool) {
+	km.mutex.RLock()
+	defer km.mutex.RUnlock()
+
+	memberID, ok := km.members[strings.ToLower(operatorID.String())]
+	return memberID, ok
+}
+
+// ProbeKeepMembers checks whether the other keep members are reachable before
+// a protocol is started with them. A member is reachable if it is connected or
+// it can be dialed using addresses known to the network provider. Members
+// are dialed concurrently. Reachability is reported for each member except
+// the node itself, in the order of the given members.
+func (n *Node) ProbeKeepMembers(members []chain.ID) []*MemberReachability {
+	connectedOperators := n.connectedOperators()
+
+	ownOperatorID := strings.ToLower(n.chain.OperatorID().String())
+
+	report := make([]*MemberReachability, 0, len(members))
+
+	var wg sync.WaitGroup
+	for _, member := range members {
+		if strings.ToLower(member.String()) == ownOperatorID {
+			continue
+		}
+
+		memberReachability := &MemberReachability{Operator: member}
+		report = append(report, memberReachability)
+
+		if connectedOperators[strings.ToLower(member.String())] {
+			memberReachability.Reachability = MemberConnected
+			continue
+		}
+
+		memberID, ok := n.knownMembers.get(member)
+		if !ok {
+			memberReachability.Reachability = MemberUnknown
+			continue
+		}
+
+		wg.Add(1)
+		go func(memberReachability *MemberReachability, memberID tss.MemberID) {
+			defer wg.Done()
+
+			err := tss.ProbeMember(
+				n.networkProvider,
+				memberID,
+				reachabilityProbeRetryCount,
+				reachabilityProbeRetryWaitTime,
+			)
+			if err != nil {
+				memberReachability.Reachability = MemberUnreachable
+				memberReachability.Err = err
+				return
+			}
+
+			memberReachability.Reachability = MemberDialed
+		}(memberReachability, memberID)
+	}
+
+	wg.Wait()
+
+	return report
+}
+
+// connectedOperators returns operators of all peers connected to the node.
+// Member identifiers of the connected operators are cached so they can be
+// dialed later if they disconnect.
+func (n *Node) connectedOperators() map[string]bool {
+	connectionManager := n.networkProvider.ConnectionManager()
+
+	connectedOperators := make(map[string]bool)
+	for _, connectedPeer := range connectionManager.ConnectedPeers() {
+		peerPublicKey, err := connectionManager.GetPeerPublicKey(connectedPeer)
+		if err != nil {
+			logger.Warningf(
+				"could not get public key of peer [%s]: [%v]",
+				connectedPeer,
+				err,
+			)
+			continue
+		}
+
+		publicKey := (*cecdsa.PublicKey)(peerPublicKey)
+		operatorID := n.chain.PublicKeyToOperatorID(publicKey)
+
+		connectedOperators[strings.ToLower(operatorID.String())] = true
+		n.knownMembers.add(operatorID, tss.MemberIDFromPublicKey(publicKey))
+	}
+
+	return connectedOperators
+}
+
+// rememberMembers caches member identifiers of operators who announced their
+// presence so they can be dialed when they are not connected.
+func (n *Node) rememberMembers(memberIDs []tss.MemberID) {
+	for _, memberID := range memberIDs {
+		operatorID, ok := n.memberIDToOperatorID(memberID)
+		if !ok {
+			continue
+		}
+
+		n.knownMembers.add(operatorID, memberID)
+	}
+}
+
+// logReachability logs the reachability of each keep member and returns
+// members found unreachable.
+func logReachability(
+	keepID chain.ID,
+	report []*MemberReachability,
+) []*MemberReachability {
+	unreachable := []*MemberReachability{}
+
+	for _, memberReachability := range report {
+		switch memberReachability.Reachability {
+		case MemberUnreachable:
+			unreachable = append(unreachable, memberReachability)
+			logger.Warningf(
+				"member [%s] of keep [%s] is unreachable: [%v]; "+
+					"check if keep client for that operator is active and "+
+					"connected",
+				memberReachability.Operator,
+				keepID,
+				memberReachability.Err,
+			)
+		default:
+			logger.Infof(
+				"member [%s] of keep [%s] reachability: [%s]",
+				memberReachability.Operator,
+				keepID,
+				memberReachability.Reachability,
+			)
+		}
+	}
+
+	return unreachable
+}
@@ -0,0 +1,121 @@
+package node
+
+import (
+	"context"
+	cecdsa "crypto/ecdsa"
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/net/key"
+	netLocal "github.com/keep-network/keep-core/pkg/net/local"
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/local"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+)
+
+func TestProbeKeepMembers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	localChain := local.Connect(ctx)
+
+	_, nodeNetworkKey, err := key.GenerateStaticNetworkKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	networkProvider := netLocal.ConnectWithKey(nodeNetworkKey)
+
+	node := NewNode(localChain, networkProvider, nil, nil, nil, nil, nil, nil)
+
+	generateMember := func() (*key.NetworkPublic, tss.MemberID, chain.ID) {
+		_, networkKey, err := key.GenerateStaticNetworkKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		publicKey := (*cecdsa.PublicKey)(networkKey)
+
+		return networkKey,
+			tss.MemberIDFromPublicKey(publicKey),
+			localChain.PublicKeyToOperatorID(publicKey)
+	}
+
+	// Connected member is reachable without dialing.
+	connectedKey, _, connectedOperator := generateMember()
+	networkProvider.AddPeer("connected-peer", connectedKey)
+
+	// Dialable member is not connected but known from its announcement and
+	// present in the network.
+	dialableKey, dialableMemberID, dialableOperator := generateMember()
+	netLocal.ConnectWithKey(dialableKey)
+	node.rememberMembers([]tss.MemberID{dialableMemberID})
+
+	// Unreachable member is known from its announcement but not present
+	// in the network.
+	_, unreachableMemberID, unreachableOperator := generateMember()
+	node.rememberMembers([]tss.MemberID{unreachableMemberID})
+
+	// Unknown member has neither been connected nor announced its presence.
+	_, _, unknownOperator := generateMember()
+
+	report := node.ProbeKeepMembers([]chain.ID{
+		localChain.OperatorID(),
+		connectedOperator,
+		dialableOperator,
+		unreachableOperator,
+		unknownOperator,
+	})
+
+	expectedReport := []struct {
+		operator     chain.ID
+		reachability Reachability
+	}{
+		{connectedOperator, MemberConnected},
+		{dialableOperator, MemberDialed},
+		{unreachableOperator, MemberUnreachable},
+		{unknownOperator, MemberUnknown},
+	}
+
+	if len(report) != len(expectedReport) {
+		t.Fatalf(
+			"unexpected report length\nexpected: [%v]\nactual:   [%v]",
+			len(expectedReport),
+			len(report),
+		)
+	}
+
+	for i, expected := range expectedReport {
+		if report[i].Operator.String() != expected.operator.String() {
+			t.Errorf(
+				"unexpected operator at position [%v]\n"+
+					"expected: [%v]\nactual:   [%v]",
+				i,
+				expected.operator,
+				report[i].Operator,
+			)
+		}
+
+		if report[i].Reachability != expected.reachability {
+			t.Errorf(
+				"unexpected reachability of operator [%v]\n"+
+					"expected: [%v]\nactual:   [%v]",
+				expected.operator,
+				expected.reachability,
+				report[i].Reachability,
+			)
+		}
+
+		if (report[i].Err != nil) != (expected.reachability == MemberUnreachable) {
+			t.Errorf(
+				"unexpected error for operator [%v]: [%v]",
+				expected.operator,
+				report[i].Err,
+			)
+		}
+	}
+
+	unreachable := logReachability(localChain.OperatorID(), report)
+	if len(unreachable) != 1 ||
+		unreachable[0].Operator.String() != unreachableOperator.String() {
+		t.Errorf("unexpected unreachable members: [%v]", unreachable)
+	}
+}
@@ -0,0 +1,138 @@
+package node
+
+import (
+	"errors"
+	"time"
+
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+	"github.com/keep-network/keep-ecdsa/pkg/reputation"
+)
+
+// reputationObserver records responses of peer members observed during
+// protocol executions in the reputation store.
+type reputationObserver struct {
+	node *Node
+}
+
+func (ro *reputationObserver) PeerResponded(
+	stage string,
+	peer tss.MemberID,
+	latency time.Duration,
+) {
+	operatorID, ok := ro.node.memberIDToOperatorID(peer)
+	if !ok {
+		return
+	}
+
+	ro.node.reputationStore.RecordResponse(operatorID, latency)
+}
+
+// peerObserver returns the observer of peer members responses for the
+// protocols executed by the node or nil if the node does not track reputation
+// of peers.
+func (n *Node) peerObserver() tss.PeerObserver {
+	if n.reputationStore == nil {
+		return nil
+	}
+
+	return &reputationObserver{n}
+}
+
+// recordParticipation records participation of peer members in a successful
+// protocol execution in the reputation store, if the node has one.
+func (n *Node) recordParticipation(
+	memberID tss.MemberID,
+	groupMemberIDs []tss.MemberID,
+) {
+	if n.reputationStore == nil {
+		return
+	}
+
+	for _, groupMemberID := range groupMemberIDs {
+		if groupMemberID.Equal(memberID) {
+			continue
+		}
+
+		operatorID, ok := n.memberIDToOperatorID(groupMemberID)
+		if !ok {
+			continue
+		}
+
+		n.reputationStore.RecordParticipation(operatorID)
+	}
+}
+
+// recordAnnounceFailure records operators who have not announced their
+// presence before the announce protocol timed out in the reputation store,
+// if the node has one.
+func (n *Node) recordAnnounceFailure(err error) {
+	if n.reputationStore == nil {
+		return
+	}
+
+	var timeoutError *tss.AnnounceTimeoutError
+	if !errors.As(err, &timeoutError) {
+		return
+	}
+
+	for _, operatorID := range timeoutError.MissingOperators {
+		n.reputationStore.RecordFailure(
+			operatorID,
+			reputation.AnnounceTimeout,
+		)
+	}
+}
+
+// recordUnreachableMembers records members found unreachable by the
+// reachability probe in the reputation store, if the node has one.
+func (n *Node) recordUnreachableMembers(unreachable []*MemberReachability) {
+	if n.reputationStore == nil {
+		return
+	}
+
+	for _, memberReachability := range unreachable {
+		n.reputationStore.RecordUnreachable(memberReachability.Operator)
+	}
+}
+
+// recordPeerFailures records members the failed protocol stage is attributed
+// to in the reputation store, if the node has one.
+func (n *Node) recordPeerFailures(protocolError *tss.ProtocolError) {
+	if n.reputationStore == nil {
+		return
+	}
+
+	timeoutKind := reputation.ProtocolTimeout
+	if protocolError.Stage == tss.ReadinessStage {
+		timeoutKind = reputation.ReadinessTimeout
+	}
+
+	record := func(memberIDs []tss.MemberID, kind reputation.FailureKind) {
+		for _, memberID := range memberIDs {
+			operatorID, ok := n.memberIDToOperatorID(memberID)
+			if !ok {
+				continue
+			}
+
+			n.reputationStore.RecordFailure(operatorID, kind)
+		}
+	}
+
+	record(protocolError.MissingMembers, timeoutKind)
+	record(protocolError.Culprits, reputation.BadMessage)
+}
+
+func (n *Node) memberIDToOperatorID(memberID tss.MemberID) (chain.ID, bool) {
+	publicKey, err := memberID.PublicKey()
+	if err != nil {
+		logger.Errorf(
+			"failed to get public key of member [%s]: [%v]",
+			memberID,
+			err,
+		)
+		return nil, false
+	}
+
+	return n.chain.PublicKeyToOperatorID(publicKey), true
+}
@@ -0,0 +1,121 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/operator"
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/local"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+	"github.com/keep-network/keep-ecdsa/pkg/reputation"
+)
+
+func TestRecordPeerReputation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir, err := ioutil.TempDir("", "reputation")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	reputationStore, err := reputation.Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	localChain := local.Connect(ctx)
+	node := NewNode(localChain, nil, nil, nil, nil, reputationStore, nil, nil)
+
+	memberIDs := make([]tss.MemberID, 4)
+	operatorIDs := make([]chain.ID, 4)
+	for i := range memberIDs {
+		_, publicKey, err := operator.GenerateKeyPair()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		memberIDs[i] = tss.MemberIDFromPublicKey(publicKey)
+		operatorIDs[i] = localChain.PublicKeyToOperatorID(publicKey)
+	}
+
+	// Member 0 is the node itself.
+	node.recordParticipation(memberIDs[0], memberIDs)
+	node.recordAnnounceFailure(
+		fmt.Errorf("announce failed: [%w]", &tss.AnnounceTimeoutError{
+			MissingOperators: []chain.ID{operatorIDs[1]},
+		}),
+	)
+	node.recordProtocolFailure(
+		operatorIDs[0],
+		keyGenerationProtocol,
+		1,
+		&tss.ProtocolError{
+			Stage:          tss.ReadinessStage,
+			MissingMembers: []tss.MemberID{memberIDs[1], memberIDs[2]},
+		},
+	)
+	node.recordProtocolFailure(
+		operatorIDs[0],
+		signingProtocol,
+		1,
+		&tss.ProtocolError{
+			Stage:          tss.SigningStage,
+			Culprits:       []tss.MemberID{memberIDs[3]},
+			MissingMembers: []tss.MemberID{memberIDs[2]},
+		},
+	)
+
+	expectedPeers := map[string]reputation.Peer{
+		strings.ToLower(operatorIDs[1].String()): {
+			Participations:    1,
+			AnnounceTimeouts:  1,
+			ReadinessTimeouts: 1,
+		},
+		strings.ToLower(operatorIDs[2].String()): {
+			Participations:    1,
+			ReadinessTimeouts: 1,
+			ProtocolTimeouts:  1,
+		},
+		strings.ToLower(operatorIDs[3].String()): {
+			Participations: 1,
+			BadMessages:    1,
+		},
+	}
+
+	peers := reputationStore.Peers()
+	if len(peers) != len(expectedPeers) {
+		t.Fatalf(
+			"unexpected number of peers\nexpected: [%v]\nactual:   [%v]",
+			len(expectedPeers),
+			len(peers),
+		)
+	}
+
+	for _, peer := range peers {
+		expectedPeer, ok := expectedPeers[peer.Operator]
+		if !ok {
+			t.Errorf("unexpected peer [%v]", peer.Operator)
+			continue
+		}
+
+		if peer.Participations != expectedPeer.Participations ||
+			peer.AnnounceTimeouts != expectedPeer.AnnounceTimeouts ||
+			peer.ReadinessTimeouts != expectedPeer.ReadinessTimeouts ||
+			peer.ProtocolTimeouts != expectedPeer.ProtocolTimeouts ||
+			peer.BadMessages != expectedPeer.BadMessages {
+			t.Errorf(
+				"unexpected reputation of peer [%v]\nexpected: [%+v]\nactual:   [%+v]",
+				peer.Operator,
+				expectedPeer,
+				peer,
+			)
+		}
+	}
+}
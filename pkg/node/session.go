@@ -0,0 +1,58 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+)
+
+// defaultMaxAttempt is the highest attempt of peer members the member can
+// catch up with when the protocol is executed without a deadline.
+const defaultMaxAttempt = 1000
+
+// maxAttempt returns the highest attempt of the protocol executed within the
+// given context the member can catch up with. Members wait for retryDelay
+// after each failed attempt they have not caught up on, so honest members can
+// not execute more attempts than fit before the protocol deadline.
+func maxAttempt(ctx context.Context) int {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return defaultMaxAttempt
+	}
+
+	return int(time.Until(deadline)/retryDelay) + 1
+}
+
+// catchUpAttempt checks if the protocol attempt failed because peer members
+// already execute a later attempt. In such case, the attempt counter is set so
+// that the next attempt is the one executed by peer members and true is
+// returned. The next attempt should be started without a delay. Attempts
+// higher than the given maximum are not caught up with, as no honest member
+// could have executed them.
+func catchUpAttempt(attemptCounter *int, maxAttempt int, err error) bool {
+	var sessionBehindError *tss.SessionBehindError
+	if !errors.As(err, &sessionBehindError) {
+		return false
+	}
+
+	if sessionBehindError.Attempt > maxAttempt {
+		logger.Warningf(
+			"not catching up with attempt [%v] reported by peer member; "+
+				"attempt exceeds the maximum attempt [%v]",
+			sessionBehindError.Attempt,
+			maxAttempt,
+		)
+		return false
+	}
+
+	logger.Infof(
+		"catching up with attempt [%v] executed by peer members",
+		sessionBehindError.Attempt,
+	)
+
+	*attemptCounter = sessionBehindError.Attempt - 1
+
+	return true
+}
@@ -0,0 +1,87 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+)
+
+func TestCatchUpAttempt(t *testing.T) {
+	var tests = map[string]struct {
+		err                    error
+		expectedCatchUp        bool
+		expectedAttemptCounter int
+	}{
+		"peer members execute later attempt": {
+			err: fmt.Errorf(
+				"readiness signaling protocol failed: [%w]",
+				&tss.SessionBehindError{Attempt: 5},
+			),
+			expectedCatchUp:        true,
+			expectedAttemptCounter: 4,
+		},
+		"peer members report attempt above the maximum": {
+			err: fmt.Errorf(
+				"readiness signaling protocol failed: [%w]",
+				&tss.SessionBehindError{Attempt: 11},
+			),
+			expectedCatchUp:        false,
+			expectedAttemptCounter: 2,
+		},
+		"other error": {
+			err:                    fmt.Errorf("protocol failed"),
+			expectedCatchUp:        false,
+			expectedAttemptCounter: 2,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			attemptCounter := 2
+
+			catchUp := catchUpAttempt(&attemptCounter, 10, test.err)
+
+			if catchUp != test.expectedCatchUp {
+				t.Errorf(
+					"unexpected catch up\nexpected: [%v]\nactual:   [%v]",
+					test.expectedCatchUp,
+					catchUp,
+				)
+			}
+
+			if attemptCounter != test.expectedAttemptCounter {
+				t.Errorf(
+					"unexpected attempt counter\nexpected: [%v]\nactual:   [%v]",
+					test.expectedAttemptCounter,
+					attemptCounter,
+				)
+			}
+		})
+	}
+}
+
+func TestMaxAttempt(t *testing.T) {
+	if attempt := maxAttempt(context.Background()); attempt != defaultMaxAttempt {
+		t.Errorf(
+			"unexpected maximum attempt without deadline\nexpected: [%v]\nactual:   [%v]",
+			defaultMaxAttempt,
+			attempt,
+		)
+	}
+
+	ctx, cancelCtx := context.WithTimeout(
+		context.Background(),
+		10*retryDelay+retryDelay/2,
+	)
+	defer cancelCtx()
+
+	if attempt := maxAttempt(ctx); attempt != 11 {
+		t.Errorf(
+			"unexpected maximum attempt\nexpected: [%v]\nactual:   [%v]",
+			11,
+			attempt,
+		)
+	}
+}
@@ -0,0 +1,200 @@
+// Package policy implements signing policies validating signature requests
+// of keeps before the threshold signing protocol is executed. Validators
+// specific to applications opening keeps decide whether the requested digest
+// may be signed.
+package policy
+
+import (
+	cecdsa "crypto/ecdsa"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ipfs/go-log"
+
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+)
+
+var logger = log.Logger("keep-policy")
+
+// Mode determines what happens with signature requests vetoed by a validator.
+type Mode string
+
+const (
+	// ModeAudit only logs vetoed signature requests; they are signed anyway.
+	ModeAudit Mode = "audit"
+	// ModeEnforce refuses to sign vetoed signature requests.
+	ModeEnforce Mode = "enforce"
+)
+
+// ParseMode parses the signing policy mode. An empty string stands for
+// the audit mode.
+func ParseMode(mode string) (Mode, error) {
+	switch Mode(strings.ToLower(mode)) {
+	case "", ModeAudit:
+		return ModeAudit, nil
+	case ModeEnforce:
+		return ModeEnforce, nil
+	default:
+		return "", fmt.Errorf(
+			"unsupported signing policy mode [%s]; use [%s] or [%s]",
+			mode,
+			ModeAudit,
+			ModeEnforce,
+		)
+	}
+}
+
+var (
+	// ErrVetoed is returned for signature requests vetoed by a validator when
+	// the policy is enforced.
+	ErrVetoed = errors.New("signature request vetoed by the signing policy")
+
+	// ErrValidationFailed is returned for signature requests a validator
+	// failed to decide about when the policy is enforced.
+	ErrValidationFailed = errors.New("signing policy validation failed")
+)
+
+// Request describes a signature request of a keep to validate.
+type Request struct {
+	Keep      chain.BondedECDSAKeepHandle
+	PublicKey *cecdsa.PublicKey
+	Event     *chain.SignatureRequestedEvent
+}
+
+// Verdict of a validator about a signature request.
+type Verdict int
+
+const (
+	// Abstain is the verdict of validators not concerned with the keep.
+	Abstain Verdict = iota
+	// Approve is the verdict of validators which confirmed the digest is
+	// expected to be signed.
+	Approve
+	// Veto is the verdict of validators which found the digest should not be
+	// signed.
+	Veto
+)
+
+func (v Verdict) String() string {
+	switch v {
+	case Approve:
+		return "approve"
+	case Veto:
+		return "veto"
+	default:
+		return "abstain"
+	}
+}
+
+// Decision of a validator about a signature request, with the reason
+// explaining the verdict.
+type Decision struct {
+	Verdict Verdict
+	Reason  string
+}
+
+// Validator validates signature requests of keeps opened by a single
+// application.
+type Validator interface {
+	// Name identifies the validator in logged decisions.
+	Name() string
+	// Validate decides about the signature request. It returns an error if
+	// the decision could not be made, e.g. the chain could not be queried.
+	Validate(request *Request) (*Decision, error)
+}
+
+// Engine runs signature requests through validators. In the enforce mode,
+// a request is refused if any validator vetoed it or failed to decide about
+// it. In the audit mode, decisions are only logged.
+type Engine struct {
+	mode       Mode
+	validators []Validator
+}
+
+// NewEngine creates a signing policy engine with the given validators.
+func NewEngine(mode Mode, validators ...Validator) *Engine {
+	return &Engine{
+		mode:       mode,
+		validators: validators,
+	}
+}
+
+// Mode returns the mode of the engine.
+func (e *Engine) Mode() Mode {
+	return e.mode
+}
+
+// Check runs the signature request through all validators and logs their
+// decisions. It returns ErrVetoed if the request has been vetoed and the
+// policy is enforced. It returns ErrValidationFailed if any validator failed
+// to decide and the policy is enforced; the request may be checked again
+// later.
+func (e *Engine) Check(request *Request) error {
+	for _, validator := range e.validators {
+		decision, err := validator.Validate(request)
+		if err != nil {
+			logger.Errorf(
+				"signing policy validator [%s] failed to validate digest [%x] "+
+					"of keep [%s] in [%s] mode: [%v]",
+				validator.Name(),
+				request.Event.Digest,
+				request.Keep.ID(),
+				e.mode,
+				err,
+			)
+
+			if e.mode == ModeEnforce {
+				return fmt.Errorf(
+					"%w: validator [%s]: [%v]",
+					ErrValidationFailed,
+					validator.Name(),
+					err,
+				)
+			}
+			continue
+		}
+
+		switch decision.Verdict {
+		case Veto:
+			logger.Warningf(
+				"signing policy validator [%s] vetoed digest [%x] of "+
+					"keep [%s] in [%s] mode: [%s]",
+				validator.Name(),
+				request.Event.Digest,
+				request.Keep.ID(),
+				e.mode,
+				decision.Reason,
+			)
+
+			if e.mode == ModeEnforce {
+				return fmt.Errorf(
+					"%w: validator [%s]: [%s]",
+					ErrVetoed,
+					validator.Name(),
+					decision.Reason,
+				)
+			}
+		case Approve:
+			logger.Infof(
+				"signing policy validator [%s] approved digest [%x] of "+
+					"keep [%s]: [%s]",
+				validator.Name(),
+				request.Event.Digest,
+				request.Keep.ID(),
+				decision.Reason,
+			)
+		default:
+			logger.Debugf(
+				"signing policy validator [%s] abstained for digest [%x] of "+
+					"keep [%s]: [%s]",
+				validator.Name(),
+				request.Event.Digest,
+				request.Keep.ID(),
+				decision.Reason,
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/local"
+)
+
+type testValidator struct {
+	decision *Decision
+	err      error
+}
+
+func (tv *testValidator) Name() string {
+	return "test"
+}
+
+func (tv *testValidator) Validate(request *Request) (*Decision, error) {
+	return tv.decision, tv.err
+}
+
+func TestParseMode(t *testing.T) {
+	var tests = map[string]struct {
+		mode          string
+		expectedMode  Mode
+		expectedError bool
+	}{
+		"empty":   {mode: "", expectedMode: ModeAudit},
+		"audit":   {mode: "audit", expectedMode: ModeAudit},
+		"enforce": {mode: "Enforce", expectedMode: ModeEnforce},
+		"unknown": {mode: "strict", expectedError: true},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			mode, err := ParseMode(test.mode)
+			if test.expectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if mode != test.expectedMode {
+				t.Errorf(
+					"unexpected mode\nexpected: [%v]\nactual:   [%v]",
+					test.expectedMode,
+					mode,
+				)
+			}
+		})
+	}
+}
+
+func TestEngineCheck(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	localChain := local.Connect(ctx)
+	keep := localChain.OpenKeep(
+		common.HexToAddress("0x4e09cadc7037afa36603138d1c0b76fe2aa5039c"),
+		common.HexToAddress("0xa5FA806723A7c7c8523F33c39686f20b52612877"),
+		[]common.Address{localChain.OperatorAddress()},
+	)
+
+	request := &Request{
+		Keep:  keep,
+		Event: &chain.SignatureRequestedEvent{Digest: [32]byte{1}},
+	}
+
+	approve := &testValidator{decision: &Decision{Verdict: Approve}}
+	abstain := &testValidator{decision: &Decision{Verdict: Abstain}}
+	veto := &testValidator{decision: &Decision{Verdict: Veto, Reason: "unknown"}}
+	failing := &testValidator{err: fmt.Errorf("chain unavailable")}
+
+	var tests = map[string]struct {
+		mode          Mode
+		validators    []Validator
+		expectedError error
+		expectedVeto  bool
+	}{
+		"no validators": {
+			mode: ModeEnforce,
+		},
+		"approved and abstained in enforce mode": {
+			mode:       ModeEnforce,
+			validators: []Validator{approve, abstain},
+		},
+		"vetoed in enforce mode": {
+			mode:         ModeEnforce,
+			validators:   []Validator{approve, veto},
+			expectedVeto: true,
+		},
+		"vetoed in audit mode": {
+			mode:       ModeAudit,
+			validators: []Validator{veto},
+		},
+		"failed in enforce mode": {
+			mode:          ModeEnforce,
+			validators:    []Validator{failing, approve},
+			expectedError: ErrValidationFailed,
+		},
+		"failed in audit mode": {
+			mode:       ModeAudit,
+			validators: []Validator{failing, approve},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			err := NewEngine(test.mode, test.validators...).Check(request)
+
+			if test.expectedVeto != errors.Is(err, ErrVetoed) {
+				t.Errorf("unexpected veto: [%v]", err)
+			}
+
+			if test.expectedError != nil {
+				if !errors.Is(err, test.expectedError) {
+					t.Errorf(
+						"unexpected error\nexpected: [%v]\nactual:   [%v]",
+						test.expectedError,
+						err,
+					)
+				}
+			} else if !test.expectedVeto && err != nil {
+				t.Errorf("unexpected error: [%v]", err)
+			}
+		})
+	}
+}
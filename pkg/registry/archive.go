@@ -0,0 +1,241 @@
+package registry
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ArchiveReason determines why signers of a keep have been archived.
+type ArchiveReason string
+
+const (
+	// ArchiveReasonUnknown is the reason of keeps archived before reasons
+	// were recorded.
+	ArchiveReasonUnknown ArchiveReason = ""
+	// ArchiveReasonClosed is the reason of keeps archived once their closure
+	// has been confirmed.
+	ArchiveReasonClosed ArchiveReason = "closed"
+	// ArchiveReasonTerminated is the reason of keeps archived once their
+	// termination has been confirmed and the liquidation recovery has been
+	// completed.
+	ArchiveReasonTerminated ArchiveReason = "terminated"
+	// ArchiveReasonInactive is the reason of keeps archived when the client
+	// found them no longer active, without knowing if they have been closed
+	// or terminated.
+	ArchiveReasonInactive ArchiveReason = "inactive"
+)
+
+func (ar ArchiveReason) String() string {
+	if ar == ArchiveReasonUnknown {
+		return "unknown"
+	}
+	return string(ar)
+}
+
+// ArchivedKeep describes archived signers of a keep.
+type ArchivedKeep struct {
+	// KeepID is the ID of the keep the way it is stored.
+	KeepID string `json:"keepId"`
+	// Reason why signers of the keep have been archived.
+	Reason ArchiveReason `json:"reason"`
+	// ArchivedAt is the time signers of the keep have been archived. It is
+	// zero for keeps archived before archive times were recorded.
+	ArchivedAt time.Time `json:"archivedAt"`
+	// RecoveryConfirmedAt is the time the operator confirmed funds held by
+	// the keep have been recovered. It is zero if recovery has not been
+	// confirmed.
+	RecoveryConfirmedAt time.Time `json:"recoveryConfirmedAt"`
+}
+
+// ExpiresAt returns the time after which archived signers of the keep can be
+// destroyed given the retention period. Signers of closed keeps are retained
+// for the retention period after the keep has been archived. Signers of all
+// other keeps, which may still control funds, are retained for the retention
+// period after the operator confirmed the funds have been recovered. It
+// returns false if signers have to be retained until recovery is confirmed.
+func (ak *ArchivedKeep) ExpiresAt(retention time.Duration) (time.Time, bool) {
+	if ak.Reason == ArchiveReasonClosed && !ak.ArchivedAt.IsZero() {
+		return ak.ArchivedAt.Add(retention), true
+	}
+
+	if !ak.RecoveryConfirmedAt.IsZero() {
+		return ak.RecoveryConfirmedAt.Add(retention), true
+	}
+
+	return time.Time{}, false
+}
+
+// DestroyedEntry describes a destroyed copy of an archived signer.
+type DestroyedEntry struct {
+	// Name of the destroyed signer; the path of the file relative to
+	// the storage directory or the path of the database entry, made of
+	// the names of its buckets and its key.
+	Name string `json:"name"`
+	// Digest is the hex-encoded SHA-256 digest of the encrypted signer.
+	Digest string `json:"digest"`
+}
+
+func newDestroyedEntry(name string, encrypted []byte) *DestroyedEntry {
+	digest := sha256.Sum256(encrypted)
+
+	return &DestroyedEntry{
+		Name:   name,
+		Digest: hex.EncodeToString(digest[:]),
+	}
+}
+
+// PrunePolicy determines which archived signers are destroyed when archives
+// are pruned.
+type PrunePolicy struct {
+	// Retention is the period for which archived signers are retained, see
+	// ArchivedKeep.ExpiresAt.
+	Retention time.Duration
+	// Log records destroyed archives.
+	Log *PruneLog
+}
+
+// destroyFile overwrites the file with random bytes, syncs it and removes it,
+// so that the original content is not left in the file system blocks of
+// the file.
+func destroyFile(path string) error {
+	// #nosec G304 (file path provided as taint input)
+	// The path is a file of the client storage.
+	file, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if _, err := io.CopyN(file, rand.Reader, info.Size()); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("could not overwrite file: [%v]", err)
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Remove(path)
+}
+
+// validateKeepDirName fails if the keep ID can not be a name of a keep
+// directory.
+func validateKeepDirName(keepID string) error {
+	if keepID == "" ||
+		keepID == "." ||
+		keepID == ".." ||
+		filepath.Base(keepID) != keepID {
+		return fmt.Errorf("invalid keep ID: [%s]", keepID)
+	}
+
+	return nil
+}
+
+// keepFiles returns paths of signer files of the keep, relative to the storage
+// directory, kept in the keep directories of the given directories of
+// the storage directory.
+func keepFiles(storageDir string, keepID string, dirs ...string) ([]string, error) {
+	if err := validateKeepDirName(keepID); err != nil {
+		return nil, err
+	}
+
+	paths := []string{}
+	for _, dir := range dirs {
+		keepDirPath := filepath.Join(storageDir, dir, keepID)
+
+		files, err := ioutil.ReadDir(keepDirPath)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not read directory [%s]: [%v]",
+				keepDirPath,
+				err,
+			)
+		}
+
+		for _, file := range files {
+			if file.Mode().IsRegular() {
+				paths = append(paths, filepath.Join(dir, keepID, file.Name()))
+			}
+		}
+	}
+
+	return paths, nil
+}
+
+// fileEntries describes signer files of the given paths, relative to
+// the storage directory, as destroyed entries.
+func fileEntries(storageDir string, paths []string) ([]*DestroyedEntry, error) {
+	entries := []*DestroyedEntry{}
+	for _, path := range paths {
+		filePath := filepath.Join(storageDir, path)
+
+		// #nosec G304 (file path provided as taint input)
+		// The path is a file of the client storage.
+		encrypted, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not read file [%s]: [%v]",
+				filePath,
+				err,
+			)
+		}
+
+		entries = append(entries, newDestroyedEntry(path, encrypted))
+	}
+
+	return entries, nil
+}
+
+// destroyKeepFiles destroys signer files of the given paths, relative to
+// the storage directory, and removes keep directories of the given
+// directories of the storage directory.
+func destroyKeepFiles(
+	storageDir string,
+	keepID string,
+	paths []string,
+	dirs ...string,
+) error {
+	for _, path := range paths {
+		filePath := filepath.Join(storageDir, path)
+		if err := destroyFile(filePath); err != nil {
+			return fmt.Errorf(
+				"could not destroy file [%s]: [%v]",
+				filePath,
+				err,
+			)
+		}
+	}
+
+	for _, dir := range dirs {
+		keepDirPath := filepath.Join(storageDir, dir, keepID)
+		if err := os.RemoveAll(keepDirPath); err != nil {
+			return fmt.Errorf(
+				"could not remove directory [%s]: [%v]",
+				keepDirPath,
+				err,
+			)
+		}
+	}
+
+	return nil
+}
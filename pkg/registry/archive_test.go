@@ -0,0 +1,312 @@
+package registry
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestArchivedKeepExpiresAt(t *testing.T) {
+	archivedAt := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	confirmedAt := time.Date(2021, 10, 5, 0, 0, 0, 0, time.UTC)
+	retention := 24 * time.Hour
+
+	var tests = map[string]struct {
+		archivedKeep      *ArchivedKeep
+		expectedExpires   bool
+		expectedExpiresAt time.Time
+	}{
+		"closed keep": {
+			archivedKeep: &ArchivedKeep{
+				Reason:     ArchiveReasonClosed,
+				ArchivedAt: archivedAt,
+			},
+			expectedExpires:   true,
+			expectedExpiresAt: archivedAt.Add(retention),
+		},
+		"terminated keep with unconfirmed recovery": {
+			archivedKeep: &ArchivedKeep{
+				Reason:     ArchiveReasonTerminated,
+				ArchivedAt: archivedAt,
+			},
+			expectedExpires: false,
+		},
+		"terminated keep with confirmed recovery": {
+			archivedKeep: &ArchivedKeep{
+				Reason:              ArchiveReasonTerminated,
+				ArchivedAt:          archivedAt,
+				RecoveryConfirmedAt: confirmedAt,
+			},
+			expectedExpires:   true,
+			expectedExpiresAt: confirmedAt.Add(retention),
+		},
+		"inactive keep with unconfirmed recovery": {
+			archivedKeep: &ArchivedKeep{
+				Reason:     ArchiveReasonInactive,
+				ArchivedAt: archivedAt,
+			},
+			expectedExpires: false,
+		},
+		"keep archived with unknown reason": {
+			archivedKeep:    &ArchivedKeep{},
+			expectedExpires: false,
+		},
+		"keep archived with unknown reason and confirmed recovery": {
+			archivedKeep: &ArchivedKeep{
+				RecoveryConfirmedAt: confirmedAt,
+			},
+			expectedExpires:   true,
+			expectedExpiresAt: confirmedAt.Add(retention),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			expiresAt, expires := test.archivedKeep.ExpiresAt(retention)
+
+			if expires != test.expectedExpires {
+				t.Fatalf(
+					"unexpected expiration\nexpected: [%v]\nactual:   [%v]",
+					test.expectedExpires,
+					expires,
+				)
+			}
+
+			if !expiresAt.Equal(test.expectedExpiresAt) {
+				t.Errorf(
+					"unexpected expiration time\nexpected: [%v]\nactual:   [%v]",
+					test.expectedExpiresAt,
+					expiresAt,
+				)
+			}
+		})
+	}
+}
+
+func TestPruneArchives(t *testing.T) {
+	var storages = map[string]func(t *testing.T, storageDir string) Storage{
+		"disk": func(t *testing.T, storageDir string) Storage {
+			storage, err := NewDiskStorage(storageDir, testStoragePassword)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return storage
+		},
+		"bolt": func(t *testing.T, storageDir string) Storage {
+			return openTestBoltStorage(t, storageDir)
+		},
+	}
+
+	for storageName, newStorage := range storages {
+		t.Run(storageName, func(t *testing.T) {
+			storageDir := newTestStorageDir(t)
+			defer os.RemoveAll(storageDir)
+
+			storage := newStorage(t, storageDir)
+			if boltStorage, ok := storage.(*BoltStorage); ok {
+				defer boltStorage.Close()
+			}
+
+			kr := NewKeepsRegistryWithStorage(storage, localChain.UnmarshalID)
+
+			signers, err := testSigners()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for i, keepID := range []string{
+				keepID1String,
+				keepID2String,
+				keepID3String,
+			} {
+				id, err := localChain.UnmarshalID(keepID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := kr.RegisterSigner(id, signers[i]); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			// Snapshots of archived signers are destroyed together with
+			// them.
+			if err := kr.SnapshotSigner(keepID1, signers[0]); err != nil {
+				t.Fatal(err)
+			}
+
+			kr.UnregisterKeep(keepID1, ArchiveReasonClosed)
+			kr.UnregisterKeep(keepID2, ArchiveReasonTerminated)
+			kr.UnregisterKeep(keepID3, ArchiveReasonInactive)
+
+			if err := kr.ConfirmRecovery(keepID3.String()); err != nil {
+				t.Fatal(err)
+			}
+
+			// Files of the directory layout imported by older client
+			// versions were left in the storage directory.
+			expectedEntries := map[string]int{
+				keepID1.String(): 2,
+				keepID3.String(): 1,
+			}
+			legacyDirPath := filepath.Join(storageDir, "current", keepID3.String())
+			if _, ok := storage.(*BoltStorage); ok {
+				if err := os.MkdirAll(legacyDirPath, 0700); err != nil {
+					t.Fatal(err)
+				}
+				if err := ioutil.WriteFile(
+					filepath.Join(legacyDirPath, "membership_1"),
+					[]byte("legacy"),
+					0600,
+				); err != nil {
+					t.Fatal(err)
+				}
+				expectedEntries[keepID3.String()]++
+			}
+
+			pruneLog, err := OpenPruneLog(storageDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			policy := &PrunePolicy{Retention: 0, Log: pruneLog}
+
+			pruned, errs := kr.PruneArchives(policy)
+			if len(errs) > 0 {
+				t.Fatalf("unexpected errors: [%v]", errs)
+			}
+
+			prunedKeepIDs := []string{}
+			for _, archivedKeep := range pruned {
+				prunedKeepIDs = append(prunedKeepIDs, archivedKeep.KeepID)
+			}
+			sort.Strings(prunedKeepIDs)
+
+			expectedPrunedKeepIDs := []string{keepID1.String(), keepID3.String()}
+			sort.Strings(expectedPrunedKeepIDs)
+
+			if !reflect.DeepEqual(expectedPrunedKeepIDs, prunedKeepIDs) {
+				t.Errorf(
+					"unexpected pruned keeps\nexpected: [%v]\nactual:   [%v]",
+					expectedPrunedKeepIDs,
+					prunedKeepIDs,
+				)
+			}
+
+			archivedKeeps, err := kr.ArchivedKeeps()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(archivedKeeps) != 1 ||
+				archivedKeeps[0].KeepID != keepID2.String() ||
+				archivedKeeps[0].Reason != ArchiveReasonTerminated {
+				t.Errorf("unexpected archived keeps: [%+v]", archivedKeeps)
+			}
+
+			records, err := pruneLog.Records()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(records) != 2 {
+				t.Fatalf(
+					"unexpected number of prune records\nexpected: [%v]\nactual:   [%v]",
+					2,
+					len(records),
+				)
+			}
+			for _, record := range records {
+				if len(record.Entries) != expectedEntries[record.KeepID] {
+					t.Errorf(
+						"unexpected prune record entries of keep [%s]: [%+v]",
+						record.KeepID,
+						record.Entries,
+					)
+				}
+			}
+
+			remainingCopies := []string{}
+			for _, path := range []string{
+				filepath.Join(storageDir, "snapshot", keepID1.String()),
+				filepath.Join(storageDir, "archive", keepID1.String()),
+				legacyDirPath,
+			} {
+				if _, err := os.Stat(path); !os.IsNotExist(err) {
+					remainingCopies = append(remainingCopies, path)
+				}
+			}
+			if boltStorage, ok := storage.(*BoltStorage); ok {
+				remainingCopies = append(remainingCopies, bucketKeys(
+					t,
+					boltStorage,
+					snapshotBucket,
+					keepID1.String(),
+				)...)
+			}
+			if len(remainingCopies) != 0 {
+				t.Errorf("unexpected remaining copies: [%v]", remainingCopies)
+			}
+
+			// Nothing is left to prune until the recovery of the terminated
+			// keep is confirmed.
+			if pruned, errs := kr.PruneArchives(policy); len(pruned) != 0 ||
+				len(errs) != 0 {
+				t.Errorf("unexpected second prune result: [%v] [%v]", pruned, errs)
+			}
+
+			leftovers, err := filepath.Glob(filepath.Join(storageDir, "*.destroyed"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(leftovers) != 0 {
+				t.Errorf("unexpected leftover files: [%v]", leftovers)
+			}
+		})
+	}
+}
+
+func TestPruneLog_Tampered(t *testing.T) {
+	storageDir := newTestStorageDir(t)
+	defer os.RemoveAll(storageDir)
+
+	pruneLog, err := OpenPruneLog(storageDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, keepID := range []string{keepID1String, keepID2String} {
+		if err := pruneLog.Append(&PruneRecord{
+			PrunedAt: time.Now().UTC(),
+			KeepID:   keepID,
+			Reason:   ArchiveReasonClosed,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := OpenPruneLog(storageDir); err != nil {
+		t.Fatalf("unexpected error: [%v]", err)
+	}
+
+	path := filepath.Join(storageDir, PruneLogFileName)
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if err := ioutil.WriteFile(path, []byte(lines[1]+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = OpenPruneLog(storageDir)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "chain of hashes is broken at line [1]") {
+		t.Errorf("unexpected error: [%v]", err)
+	}
+}
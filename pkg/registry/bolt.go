@@ -0,0 +1,1563 @@
+package registry
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/keep-network/keep-common/pkg/encryption"
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// BoltDatabaseFileName is the name of the embedded database file created by
+// the bolt storage in the storage directory.
+const BoltDatabaseFileName = "registry.db"
+
+// boltOpenTimeout is the time the bolt storage waits for the lock of
+// the database file held by another process.
+const boltOpenTimeout = 5 * time.Second
+
+const (
+	// compactedSuffix is appended to the path of the database to name
+	// the file the database is compacted into.
+	compactedSuffix = ".compacted"
+	// destroyedSuffix is appended to the path of the database to name
+	// the link to the original database file kept until the file is
+	// destroyed after compaction.
+	destroyedSuffix = ".destroyed"
+)
+
+var (
+	// Buckets of the database holding a nested bucket for each keep, named
+	// after the keep ID. Keep buckets map member file names, the same as
+	// used by the disk persistence, to encrypted signers.
+	currentBucket  = []byte("current")
+	snapshotBucket = []byte("snapshot")
+	archiveBucket  = []byte("archive")
+
+	// archivedKeepsBucket maps IDs of archived keeps to their JSON-encoded
+	// descriptions.
+	archivedKeepsBucket = []byte("archived_keeps")
+
+	// metaBucket holds information about the database itself.
+	metaBucket = []byte("meta")
+	// importedKey in the meta bucket holds the time the directory layout of
+	// the disk persistence has been imported to the database.
+	importedKey = []byte("imported")
+
+	// directoryLayoutBuckets maps directories of the disk persistence to
+	// buckets of the database holding the same data.
+	directoryLayoutBuckets = map[string][]byte{
+		"current":  currentBucket,
+		"snapshot": snapshotBucket,
+		"archive":  archiveBucket,
+	}
+)
+
+// BoltStorage stores signers in an embedded bolt database. All changes of
+// the storage, including archiving all signers of a keep, are done in a single
+// database transaction. Signers are encrypted the same way the encrypted
+// persistence encrypts files.
+type BoltStorage struct {
+	// dbMutex guards replacing the database when it is compacted.
+	dbMutex sync.RWMutex
+	db      *bolt.DB
+	box     encryption.Box
+
+	// storageDir is the directory of the database. Files of the directory
+	// layout imported to the database are left in it until they are
+	// destroyed with DestroyImportedFiles.
+	storageDir string
+}
+
+// NewBoltStorage opens the bolt storage in the given storage directory,
+// creating the database if it does not exist yet. When the database is
+// created, signers, snapshots and archived signers stored in the directory
+// layout of the disk persistence in the same storage directory are imported
+// to the database. The import is done once; files written to the directory
+// layout later are not read. Imported files are left in place, so that
+// the disk storage can still be used, until they are destroyed with
+// DestroyImportedFiles.
+//
+// The database can be opened by one process at a time. The storage should be
+// closed once it is no longer used.
+func NewBoltStorage(storageDir string, password string) (*BoltStorage, error) {
+	path := filepath.Join(storageDir, BoltDatabaseFileName)
+
+	db, err := openBoltDatabase(path)
+	if err != nil {
+		return nil, err
+	}
+
+	storage := &BoltStorage{
+		db:         db,
+		box:        newBoltBox(password),
+		storageDir: storageDir,
+	}
+
+	importedPaths, err := storage.importDirectoryLayout(storageDir)
+	if err != nil {
+		if closeErr := db.Close(); closeErr != nil {
+			logger.Errorf("could not close database [%s]: [%v]", path, closeErr)
+		}
+		return nil, err
+	}
+
+	if len(importedPaths) > 0 {
+		logger.Infof(
+			"imported [%d] files of the storage directory [%s] to "+
+				"the database; the files are left in place until they are "+
+				"destroyed with `keep-ecdsa registry destroy-imported`",
+			len(importedPaths),
+			storageDir,
+		)
+	}
+
+	return storage, nil
+}
+
+// openBoltDatabase opens the database file at the given path, creating it if
+// it does not exist yet. Files left behind by an interrupted compaction of
+// the database are cleaned up before the database is opened.
+func openBoltDatabase(path string) (*bolt.DB, error) {
+	if err := recoverCompaction(path); err != nil {
+		return nil, err
+	}
+
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: boltOpenTimeout})
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not open database [%s]; the database may be in use by "+
+				"another process: [%v]",
+			path,
+			err,
+		)
+	}
+
+	return db, nil
+}
+
+// newBoltBox creates a box encrypting signers the same way the encrypted
+// persistence encrypts files, so that encrypted signers can be moved between
+// the disk persistence and the database as they are.
+func newBoltBox(password string) encryption.Box {
+	return encryption.NewBox(sha256.Sum256([]byte(password)))
+}
+
+// Close closes the database of the storage.
+func (bs *BoltStorage) Close() error {
+	bs.dbMutex.Lock()
+	defer bs.dbMutex.Unlock()
+
+	return bs.db.Close()
+}
+
+// importDirectoryLayout creates buckets of the database and, if it has not
+// been done before, imports files of the disk persistence directory layout.
+// Every file is decrypted before it is imported to make sure the storage
+// password is right. The import is done in a single transaction, so either
+// all files are imported or none. It returns paths of the imported files.
+func (bs *BoltStorage) importDirectoryLayout(storageDir string) ([]string, error) {
+	importedPaths := []string{}
+
+	err := bs.db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{
+			currentBucket,
+			snapshotBucket,
+			archiveBucket,
+			archivedKeepsBucket,
+			metaBucket,
+		} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return fmt.Errorf("could not create bucket [%s]: [%v]", name, err)
+			}
+		}
+
+		meta := tx.Bucket(metaBucket)
+		if meta.Get(importedKey) != nil {
+			return nil
+		}
+
+		for dir, bucketName := range directoryLayoutBuckets {
+			dirPaths, err := bs.importDirectory(
+				filepath.Join(storageDir, dir),
+				tx.Bucket(bucketName),
+			)
+			if err != nil {
+				return err
+			}
+			importedPaths = append(importedPaths, dirPaths...)
+		}
+
+		archivedKeepsPath, err := importArchivedKeeps(
+			storageDir,
+			tx.Bucket(archivedKeepsBucket),
+		)
+		if err != nil {
+			return err
+		}
+		if archivedKeepsPath != "" {
+			importedPaths = append(importedPaths, archivedKeepsPath)
+		}
+
+		return meta.Put(
+			importedKey,
+			[]byte(time.Now().UTC().Format(time.RFC3339)),
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return importedPaths, nil
+}
+
+// importDirectory imports files of keep directories in the given directory to
+// keep buckets of the given bucket. It returns paths of the imported files.
+func (bs *BoltStorage) importDirectory(
+	dirPath string,
+	bucket *bolt.Bucket,
+) ([]string, error) {
+	keepDirs, err := ioutil.ReadDir(dirPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read directory [%s]: [%v]", dirPath, err)
+	}
+
+	importedPaths := []string{}
+	for _, keepDir := range keepDirs {
+		if !keepDir.IsDir() {
+			continue
+		}
+
+		keepDirPath := filepath.Join(dirPath, keepDir.Name())
+		files, err := ioutil.ReadDir(keepDirPath)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not read directory [%s]: [%v]",
+				keepDirPath,
+				err,
+			)
+		}
+
+		keepBucket, err := bucket.CreateBucketIfNotExists([]byte(keepDir.Name()))
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not create bucket for keep [%s]: [%v]",
+				keepDir.Name(),
+				err,
+			)
+		}
+
+		for _, file := range files {
+			if !file.Mode().IsRegular() {
+				continue
+			}
+
+			filePath := filepath.Join(keepDirPath, file.Name())
+
+			// #nosec G304 (file path provided as taint input)
+			// The path is a file of the client storage.
+			encrypted, err := ioutil.ReadFile(filePath)
+			if err != nil {
+				return nil, fmt.Errorf("could not read file [%s]: [%v]", filePath, err)
+			}
+
+			if _, err := bs.box.Decrypt(encrypted); err != nil {
+				return nil, fmt.Errorf(
+					"could not decrypt file [%s]: [%v]",
+					filePath,
+					err,
+				)
+			}
+
+			if err := keepBucket.Put([]byte(file.Name()), encrypted); err != nil {
+				return nil, fmt.Errorf(
+					"could not import file [%s]: [%v]",
+					filePath,
+					err,
+				)
+			}
+
+			importedPaths = append(importedPaths, filePath)
+		}
+	}
+
+	return importedPaths, nil
+}
+
+// importArchivedKeeps imports descriptions of archived keeps kept by the disk
+// storage in the storage directory. It returns the path of the imported file
+// or an empty string if there was nothing to import.
+func importArchivedKeeps(
+	storageDir string,
+	bucket *bolt.Bucket,
+) (string, error) {
+	archivedKeeps, err := readArchivedKeeps(storageDir)
+	if err != nil {
+		return "", err
+	}
+
+	if len(archivedKeeps) == 0 {
+		return "", nil
+	}
+
+	for keepID, archivedKeep := range archivedKeeps {
+		if err := putArchivedKeep(bucket, keepID, archivedKeep); err != nil {
+			return "", err
+		}
+	}
+
+	return filepath.Join(storageDir, archivedKeepsFileName), nil
+}
+
+func putArchivedKeep(
+	bucket *bolt.Bucket,
+	keepID string,
+	archivedKeep *ArchivedKeep,
+) error {
+	encoded, err := json.Marshal(archivedKeep)
+	if err != nil {
+		return fmt.Errorf(
+			"could not encode description of archived keep [%s]: [%v]",
+			keepID,
+			err,
+		)
+	}
+
+	return bucket.Put([]byte(keepID), encoded)
+}
+
+// directoryLayoutFiles returns paths of signer files of all keeps, relative to
+// the storage directory, kept in the directory layout of the disk persistence.
+func directoryLayoutFiles(storageDir string) ([]string, error) {
+	paths := []string{}
+	for _, dir := range directoryLayoutDirs() {
+		dirPath := filepath.Join(storageDir, dir)
+
+		keepDirs, err := ioutil.ReadDir(dirPath)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not read directory [%s]: [%v]",
+				dirPath,
+				err,
+			)
+		}
+
+		for _, keepDir := range keepDirs {
+			if !keepDir.IsDir() {
+				continue
+			}
+
+			keepPaths, err := keepFiles(storageDir, keepDir.Name(), dir)
+			if err != nil {
+				return nil, err
+			}
+			paths = append(paths, keepPaths...)
+		}
+	}
+
+	return paths, nil
+}
+
+// DestroyImportedFiles destroys files of the directory layout of the disk
+// persistence in the given storage directory which have been imported to
+// the database of the bolt storage. A file is destroyed only if the database
+// holds a signer of the same keep with the same name and content, no matter
+// if the signer has been archived since it was imported. Descriptions of
+// archived keeps are removed once no files are left. It returns paths of
+// destroyed files and paths of files left in place, relative to the storage
+// directory.
+//
+// The database must not be used by another process while imported files are
+// destroyed.
+func DestroyImportedFiles(
+	storageDir string,
+	password string,
+) ([]string, []string, error) {
+	storage, err := NewBoltStorage(storageDir, password)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if err := storage.Close(); err != nil {
+			logger.Errorf("could not close database: [%v]", err)
+		}
+	}()
+
+	paths, err := directoryLayoutFiles(storageDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	destroyedPaths := []string{}
+	remainingPaths := []string{}
+	for _, path := range paths {
+		imported, err := storage.isImported(path)
+		if err != nil {
+			return destroyedPaths, nil, err
+		}
+
+		if !imported {
+			remainingPaths = append(remainingPaths, path)
+			continue
+		}
+
+		filePath := filepath.Join(storageDir, path)
+		if err := destroyFile(filePath); err != nil {
+			return destroyedPaths, nil, fmt.Errorf(
+				"could not destroy file [%s]: [%v]",
+				filePath,
+				err,
+			)
+		}
+		destroyedPaths = append(destroyedPaths, path)
+	}
+
+	// Removing a directory fails if it is not empty, so keep directories
+	// holding files which have not been imported are left in place.
+	for _, dir := range directoryLayoutDirs() {
+		keepDirs, err := ioutil.ReadDir(filepath.Join(storageDir, dir))
+		if err != nil {
+			continue
+		}
+
+		for _, keepDir := range keepDirs {
+			if keepDir.IsDir() {
+				_ = os.Remove(filepath.Join(storageDir, dir, keepDir.Name()))
+			}
+		}
+	}
+
+	if len(remainingPaths) == 0 {
+		archivedKeepsPath := filepath.Join(storageDir, archivedKeepsFileName)
+		if err := os.Remove(archivedKeepsPath); err != nil && !os.IsNotExist(err) {
+			return destroyedPaths, remainingPaths, fmt.Errorf(
+				"could not remove file [%s]: [%v]",
+				archivedKeepsPath,
+				err,
+			)
+		}
+	}
+
+	return destroyedPaths, remainingPaths, nil
+}
+
+// isImported checks if the signer file of the given path, relative to
+// the storage directory, is stored in the database with the same content in
+// any bucket of the keep.
+func (bs *BoltStorage) isImported(path string) (bool, error) {
+	filePath := filepath.Join(bs.storageDir, path)
+
+	// #nosec G304 (file path provided as taint input)
+	// The path is a file of the client storage.
+	encrypted, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return false, fmt.Errorf("could not read file [%s]: [%v]", filePath, err)
+	}
+
+	content, err := bs.box.Decrypt(encrypted)
+	if err != nil {
+		return false, fmt.Errorf(
+			"could not decrypt file [%s]: [%v]",
+			filePath,
+			err,
+		)
+	}
+
+	keepID := []byte(filepath.Base(filepath.Dir(path)))
+	key := []byte(filepath.Base(path))
+
+	imported := false
+
+	bs.dbMutex.RLock()
+	defer bs.dbMutex.RUnlock()
+
+	err = bs.db.View(func(tx *bolt.Tx) error {
+		for _, bucketName := range [][]byte{
+			currentBucket,
+			snapshotBucket,
+			archiveBucket,
+		} {
+			keepBucket := tx.Bucket(bucketName).Bucket(keepID)
+			if keepBucket == nil {
+				continue
+			}
+
+			stored := keepBucket.Get(key)
+			if stored == nil {
+				continue
+			}
+
+			storedContent, err := bs.box.Decrypt(stored)
+			if err != nil {
+				return fmt.Errorf(
+					"could not decrypt entry [%s] of keep [%s] in "+
+						"bucket [%s]: [%v]",
+					key,
+					keepID,
+					bucketName,
+					err,
+				)
+			}
+
+			if bytes.Equal(content, storedContent) {
+				imported = true
+				return nil
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return imported, nil
+}
+
+// ExportBoltStorage writes signers, snapshots and archived signers of the bolt
+// storage in the given storage directory to the directory layout of the disk
+// persistence, together with descriptions of archived keeps, so that the disk
+// storage can be used instead of the bolt storage. Signers are written
+// encrypted as they are stored in the database. It returns the number of
+// exported signers.
+//
+// The export fails if any file is left in the directory layout, so that
+// exported signers are never mixed with files which are not in the database;
+// files imported to the database can be destroyed with DestroyImportedFiles.
+// Once all exported files have been read back and compared with the database,
+// the database is destroyed. If the bolt storage is used again, exported
+// files are imported to a new database.
+//
+// The database must not be used by another process while it is exported.
+func ExportBoltStorage(storageDir string, password string) (int, error) {
+	storage, err := NewBoltStorage(storageDir, password)
+	if err != nil {
+		return 0, err
+	}
+
+	exportedPaths, err := storage.exportDirectoryLayout()
+	if closeErr := storage.Close(); closeErr != nil && err == nil {
+		err = fmt.Errorf("could not close database: [%v]", closeErr)
+		destroyExportedFiles(storageDir, exportedPaths)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	path := filepath.Join(storageDir, BoltDatabaseFileName)
+	if err := destroyFile(path); err != nil {
+		return 0, fmt.Errorf(
+			"signers have been exported but the database [%s] could not be "+
+				"destroyed: [%v]",
+			path,
+			err,
+		)
+	}
+
+	return len(exportedPaths), nil
+}
+
+// exportDirectoryLayout writes signers stored in the database to files of
+// the directory layout of the disk persistence and verifies the written
+// files. It returns paths of the exported files. Files written before any
+// failure are destroyed.
+func (bs *BoltStorage) exportDirectoryLayout() ([]string, error) {
+	paths, err := directoryLayoutFiles(bs.storageDir)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) > 0 {
+		return nil, fmt.Errorf(
+			"directory layout of the storage directory [%s] holds [%d] "+
+				"files; files imported to the database have to be destroyed "+
+				"and other files moved away before the export",
+			bs.storageDir,
+			len(paths),
+		)
+	}
+
+	archivedKeeps, err := bs.ArchivedKeeps()
+	if err != nil {
+		return nil, err
+	}
+
+	signers := map[string][]*storedSigner{}
+
+	bs.dbMutex.RLock()
+	err = bs.db.View(func(tx *bolt.Tx) error {
+		for dir, bucketName := range directoryLayoutBuckets {
+			dirSigners, err := readBucket(tx, bucketName)
+			if err != nil {
+				return err
+			}
+			signers[dir] = dirSigners
+		}
+		return nil
+	})
+	bs.dbMutex.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	exportedPaths := []string{}
+	for _, dir := range directoryLayoutDirs() {
+		for _, stored := range signers[dir] {
+			path, err := exportSigner(bs.storageDir, dir, stored)
+			if path != "" {
+				exportedPaths = append(exportedPaths, path)
+			}
+			if err != nil {
+				destroyExportedFiles(bs.storageDir, exportedPaths)
+				return nil, err
+			}
+		}
+	}
+
+	if len(archivedKeeps) > 0 {
+		archivedKeepsMap := map[string]*ArchivedKeep{}
+		for _, archivedKeep := range archivedKeeps {
+			archivedKeepsMap[archivedKeep.KeepID] = archivedKeep
+		}
+
+		if err := writeArchivedKeeps(bs.storageDir, archivedKeepsMap); err != nil {
+			destroyExportedFiles(bs.storageDir, exportedPaths)
+			return nil, err
+		}
+	}
+
+	return exportedPaths, nil
+}
+
+// exportSigner writes the stored signer to a file of the keep directory in
+// the given directory of the storage directory and verifies the file has been
+// written with the stored content. It returns the path of the file, relative
+// to the storage directory, also if the file has been written but could not
+// be verified.
+func exportSigner(
+	storageDir string,
+	dir string,
+	stored *storedSigner,
+) (string, error) {
+	if err := validateKeepDirName(stored.keepID); err != nil {
+		return "", err
+	}
+	if stored.key == "" || filepath.Base(stored.key) != stored.key {
+		return "", fmt.Errorf(
+			"invalid name [%s] of signer of keep [%s]",
+			stored.key,
+			stored.keepID,
+		)
+	}
+
+	keepDirPath := filepath.Join(storageDir, dir, stored.keepID)
+	if err := os.MkdirAll(keepDirPath, 0700); err != nil {
+		return "", fmt.Errorf(
+			"could not create directory [%s]: [%v]",
+			keepDirPath,
+			err,
+		)
+	}
+
+	path := filepath.Join(dir, stored.keepID, stored.key)
+	filePath := filepath.Join(storageDir, path)
+
+	if err := ioutil.WriteFile(filePath, stored.encrypted, 0600); err != nil {
+		return path, fmt.Errorf("could not write file [%s]: [%v]", filePath, err)
+	}
+
+	// #nosec G304 (file path provided as taint input)
+	// The path is a file of the client storage.
+	written, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return path, fmt.Errorf("could not read file [%s]: [%v]", filePath, err)
+	}
+	if !bytes.Equal(written, stored.encrypted) {
+		return path, fmt.Errorf(
+			"file [%s] does not hold the exported signer",
+			filePath,
+		)
+	}
+
+	return path, nil
+}
+
+// destroyExportedFiles destroys files of an export which failed and removes
+// exported descriptions of archived keeps. Failures are logged, as
+// the database still holds all signers.
+func destroyExportedFiles(storageDir string, paths []string) {
+	archivedKeepsPath := filepath.Join(storageDir, archivedKeepsFileName)
+	if err := os.Remove(archivedKeepsPath); err != nil && !os.IsNotExist(err) {
+		logger.Errorf(
+			"could not remove exported file [%s]: [%v]",
+			archivedKeepsPath,
+			err,
+		)
+	}
+
+	for _, path := range paths {
+		filePath := filepath.Join(storageDir, path)
+		if err := destroyFile(filePath); err != nil && !os.IsNotExist(err) {
+			logger.Errorf(
+				"could not destroy exported file [%s]; "+
+					"the file should be removed manually: [%v]",
+				filePath,
+				err,
+			)
+		}
+	}
+}
+
+// signerKey returns the key of the signer in the keep bucket.
+func signerKey(signer *tss.ThresholdSigner) string {
+	// Take just the first 20 bytes of member ID to use the same names as
+	// the disk persistence.
+	return fmt.Sprintf("membership_%.40s", signer.MemberID().String())
+}
+
+// put encrypts the value and stores it under the key in the bucket of
+// the keep, nested in the given bucket.
+func (bs *BoltStorage) put(
+	tx *bolt.Tx,
+	bucketName []byte,
+	keepID string,
+	key string,
+	value []byte,
+) error {
+	encrypted, err := bs.box.Encrypt(value)
+	if err != nil {
+		return fmt.Errorf("could not encrypt signer: [%v]", err)
+	}
+
+	keepBucket, err := tx.Bucket(bucketName).CreateBucketIfNotExists(
+		[]byte(keepID),
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"could not create bucket for keep [%s]: [%v]",
+			keepID,
+			err,
+		)
+	}
+
+	return keepBucket.Put([]byte(key), encrypted)
+}
+
+// Save stores the signer of the keep in the current signers bucket.
+func (bs *BoltStorage) Save(keepID chain.ID, signer *tss.ThresholdSigner) error {
+	signerBytes, err := signer.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal signer: [%v]", err)
+	}
+
+	bs.dbMutex.RLock()
+	defer bs.dbMutex.RUnlock()
+
+	return bs.db.Update(func(tx *bolt.Tx) error {
+		return bs.put(
+			tx,
+			currentBucket,
+			keepID.String(),
+			signerKey(signer),
+			signerBytes,
+		)
+	})
+}
+
+// Snapshot stores the signer of the keep in the snapshot bucket. Keys of
+// snapshots are suffixed with the time of the snapshot the same way
+// the disk persistence names snapshot files.
+func (bs *BoltStorage) Snapshot(
+	keepID chain.ID,
+	signer *tss.ThresholdSigner,
+) error {
+	signerBytes, err := signer.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal signer: [%v]", err)
+	}
+
+	bs.dbMutex.RLock()
+	defer bs.dbMutex.RUnlock()
+
+	return bs.db.Update(func(tx *bolt.Tx) error {
+		return bs.snapshot(tx, keepID.String(), signerKey(signer), signerBytes)
+	})
+}
+
+func (bs *BoltStorage) snapshot(
+	tx *bolt.Tx,
+	keepID string,
+	key string,
+	value []byte,
+) error {
+	snapshotKey := fmt.Sprintf(
+		"%s.%d",
+		key,
+		time.Now().UnixNano()/int64(time.Millisecond),
+	)
+
+	if keepBucket := tx.Bucket(snapshotBucket).Bucket(
+		[]byte(keepID),
+	); keepBucket != nil && keepBucket.Get([]byte(snapshotKey)) != nil {
+		return fmt.Errorf(
+			"could not create unique snapshot; " +
+				"snapshot name collision has been detected",
+		)
+	}
+
+	return bs.put(tx, snapshotBucket, keepID, snapshotKey, value)
+}
+
+// storedSigner is an encrypted signer read from a keep bucket.
+type storedSigner struct {
+	keepID    string
+	key       string
+	encrypted []byte
+}
+
+// readBucket returns copies of all signers stored in keep buckets of
+// the given bucket.
+func readBucket(tx *bolt.Tx, bucketName []byte) ([]*storedSigner, error) {
+	signers := []*storedSigner{}
+
+	bucket := tx.Bucket(bucketName)
+	err := bucket.ForEach(func(keepID, value []byte) error {
+		keepBucket := bucket.Bucket(keepID)
+		if value != nil || keepBucket == nil {
+			return nil
+		}
+
+		return keepBucket.ForEach(func(key, encrypted []byte) error {
+			// Values returned by the database are valid only within
+			// the transaction.
+			signers = append(signers, &storedSigner{
+				keepID:    string(keepID),
+				key:       string(key),
+				encrypted: append([]byte{}, encrypted...),
+			})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not read bucket [%s]: [%v]", bucketName, err)
+	}
+
+	return signers, nil
+}
+
+// ReadAll reads signers of all keeps from the current signers bucket.
+// Signers are read in a single transaction and then decrypted and unmarshalled
+// one by one.
+func (bs *BoltStorage) ReadAll(
+	unmarshalIDFunc func(string) (chain.ID, error),
+) (<-chan *KeepSigner, <-chan error) {
+	outputKeepSigner := make(chan *KeepSigner)
+	outputErrors := make(chan error)
+
+	go func() {
+		defer close(outputKeepSigner)
+		defer close(outputErrors)
+
+		var signers []*storedSigner
+		bs.dbMutex.RLock()
+		err := bs.db.View(func(tx *bolt.Tx) error {
+			var err error
+			signers, err = readBucket(tx, currentBucket)
+			return err
+		})
+		bs.dbMutex.RUnlock()
+		if err != nil {
+			outputErrors <- err
+			return
+		}
+
+		for _, stored := range signers {
+			content, err := bs.box.Decrypt(stored.encrypted)
+			if err != nil {
+				outputErrors <- fmt.Errorf(
+					"failed to decrypt signer [%v] of keep [%v]: [%v]",
+					stored.key,
+					stored.keepID,
+					err,
+				)
+				continue
+			}
+
+			keepID, err := unmarshalIDFunc(stored.keepID)
+			if err != nil {
+				outputErrors <- fmt.Errorf(
+					"bucket name [%v] could not be converted to a keep ID: [%v]",
+					stored.keepID,
+					err,
+				)
+				continue
+			}
+
+			signer := &tss.ThresholdSigner{}
+			if err := signer.Unmarshal(content); err != nil {
+				outputErrors <- fmt.Errorf(
+					"failed to unmarshal signer [%v] of keep [%v]: [%v]",
+					stored.key,
+					stored.keepID,
+					err,
+				)
+				continue
+			}
+
+			outputKeepSigner <- &KeepSigner{
+				KeepID: keepID,
+				Signer: signer,
+			}
+		}
+	}()
+
+	return outputKeepSigner, outputErrors
+}
+
+// Archive moves all signers of the keep from the current signers bucket to
+// the archive bucket and records the description of the archived keep in
+// a single transaction.
+func (bs *BoltStorage) Archive(keepID chain.ID, reason ArchiveReason) error {
+	bs.dbMutex.RLock()
+	defer bs.dbMutex.RUnlock()
+
+	return bs.db.Update(func(tx *bolt.Tx) error {
+		keepBucketName := []byte(keepID.String())
+
+		current := tx.Bucket(currentBucket)
+		currentKeepBucket := current.Bucket(keepBucketName)
+		if currentKeepBucket == nil {
+			return fmt.Errorf(
+				"no signers of keep [%s] in the storage",
+				keepID.String(),
+			)
+		}
+
+		archivedKeepBucket, err := tx.Bucket(archiveBucket).
+			CreateBucketIfNotExists(keepBucketName)
+		if err != nil {
+			return fmt.Errorf(
+				"could not create bucket for keep [%s]: [%v]",
+				keepID.String(),
+				err,
+			)
+		}
+
+		if err := currentKeepBucket.ForEach(func(key, value []byte) error {
+			return archivedKeepBucket.Put(key, value)
+		}); err != nil {
+			return fmt.Errorf(
+				"could not archive signers of keep [%s]: [%v]",
+				keepID.String(),
+				err,
+			)
+		}
+
+		if err := current.DeleteBucket(keepBucketName); err != nil {
+			return err
+		}
+
+		return putArchivedKeep(
+			tx.Bucket(archivedKeepsBucket),
+			keepID.String(),
+			&ArchivedKeep{
+				KeepID:     keepID.String(),
+				Reason:     reason,
+				ArchivedAt: time.Now().UTC(),
+			},
+		)
+	})
+}
+
+// archivedKeep returns the description of the archived keep. Keeps archived
+// before archives were described are returned with the unknown reason.
+func archivedKeep(tx *bolt.Tx, keepID []byte) (*ArchivedKeep, error) {
+	encoded := tx.Bucket(archivedKeepsBucket).Get(keepID)
+	if encoded == nil {
+		return &ArchivedKeep{KeepID: string(keepID)}, nil
+	}
+
+	archivedKeep := &ArchivedKeep{}
+	if err := json.Unmarshal(encoded, archivedKeep); err != nil {
+		return nil, fmt.Errorf(
+			"could not decode description of archived keep [%s]: [%v]",
+			keepID,
+			err,
+		)
+	}
+
+	return archivedKeep, nil
+}
+
+// ArchivedKeeps returns keeps with buckets in the archive bucket.
+func (bs *BoltStorage) ArchivedKeeps() ([]*ArchivedKeep, error) {
+	bs.dbMutex.RLock()
+	defer bs.dbMutex.RUnlock()
+
+	result := []*ArchivedKeep{}
+
+	err := bs.db.View(func(tx *bolt.Tx) error {
+		archive := tx.Bucket(archiveBucket)
+		return archive.ForEach(func(keepID, value []byte) error {
+			if value != nil || archive.Bucket(keepID) == nil {
+				return nil
+			}
+
+			archivedKeep, err := archivedKeep(tx, keepID)
+			if err != nil {
+				return err
+			}
+
+			result = append(result, archivedKeep)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ConfirmRecovery records the recovery confirmation in the description of
+// the archived keep.
+func (bs *BoltStorage) ConfirmRecovery(
+	keepID string,
+	confirmedAt time.Time,
+) error {
+	bs.dbMutex.RLock()
+	defer bs.dbMutex.RUnlock()
+
+	return bs.db.Update(func(tx *bolt.Tx) error {
+		if tx.Bucket(archiveBucket).Bucket([]byte(keepID)) == nil {
+			return fmt.Errorf("keep [%s] has not been archived", keepID)
+		}
+
+		archivedKeep, err := archivedKeep(tx, []byte(keepID))
+		if err != nil {
+			return err
+		}
+
+		archivedKeep.RecoveryConfirmedAt = confirmedAt.UTC()
+
+		return putArchivedKeep(
+			tx.Bucket(archivedKeepsBucket),
+			keepID,
+			archivedKeep,
+		)
+	})
+}
+
+// legacyKeepFiles returns paths of files of the keep, relative to the storage
+// directory, left in the directory layout imported to the database.
+func (bs *BoltStorage) legacyKeepFiles(keepID string) ([]string, error) {
+	return keepFiles(bs.storageDir, keepID, directoryLayoutDirs()...)
+}
+
+// directoryLayoutDirs returns directories of the disk persistence imported to
+// the database.
+func directoryLayoutDirs() []string {
+	dirs := []string{}
+	for dir := range directoryLayoutBuckets {
+		dirs = append(dirs, dir)
+	}
+
+	sort.Strings(dirs)
+	return dirs
+}
+
+// ArchiveEntries describes archived signers and snapshots of signers of
+// the keep stored in the database and files of the keep left in the directory
+// layout imported to the database.
+func (bs *BoltStorage) ArchiveEntries(keepID string) ([]*DestroyedEntry, error) {
+	entries := []*DestroyedEntry{}
+
+	bs.dbMutex.RLock()
+	err := bs.db.View(func(tx *bolt.Tx) error {
+		if tx.Bucket(archiveBucket).Bucket([]byte(keepID)) == nil {
+			return fmt.Errorf("keep [%s] has not been archived", keepID)
+		}
+
+		for _, bucketName := range [][]byte{archiveBucket, snapshotBucket} {
+			keepBucket := tx.Bucket(bucketName).Bucket([]byte(keepID))
+			if keepBucket == nil {
+				continue
+			}
+
+			if err := keepBucket.ForEach(func(key, encrypted []byte) error {
+				entries = append(entries, newDestroyedEntry(
+					fmt.Sprintf("%s/%s/%s", bucketName, keepID, key),
+					encrypted,
+				))
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	bs.dbMutex.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	legacyPaths, err := bs.legacyKeepFiles(keepID)
+	if err != nil {
+		return nil, err
+	}
+
+	legacyEntries, err := fileEntries(bs.storageDir, legacyPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(entries, legacyEntries...), nil
+}
+
+// DestroyArchive removes archived signers and snapshots of signers of
+// the keep and its description in a single transaction. Removed signers remain
+// in free pages of the database file, so the database is compacted into a new
+// file afterwards and the original file is overwritten with random bytes
+// before it is removed. Files of the keep left in the imported directory
+// layout are destroyed as well.
+func (bs *BoltStorage) DestroyArchive(keepID string) error {
+	bs.dbMutex.RLock()
+	err := bs.db.Update(func(tx *bolt.Tx) error {
+		archive := tx.Bucket(archiveBucket)
+		if archive.Bucket([]byte(keepID)) == nil {
+			return fmt.Errorf("keep [%s] has not been archived", keepID)
+		}
+
+		if err := archive.DeleteBucket([]byte(keepID)); err != nil {
+			return err
+		}
+
+		snapshot := tx.Bucket(snapshotBucket)
+		if snapshot.Bucket([]byte(keepID)) != nil {
+			if err := snapshot.DeleteBucket([]byte(keepID)); err != nil {
+				return err
+			}
+		}
+
+		return tx.Bucket(archivedKeepsBucket).Delete([]byte(keepID))
+	})
+	bs.dbMutex.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	if err := bs.compact(); err != nil {
+		return fmt.Errorf(
+			"archived signers have been removed but the database could not "+
+				"be compacted: [%v]",
+			err,
+		)
+	}
+
+	legacyPaths, err := bs.legacyKeepFiles(keepID)
+	if err != nil {
+		return err
+	}
+
+	return destroyKeepFiles(
+		bs.storageDir,
+		keepID,
+		legacyPaths,
+		directoryLayoutDirs()...,
+	)
+}
+
+// compact compacts the database and opens the compacted database.
+func (bs *BoltStorage) compact() error {
+	bs.dbMutex.Lock()
+	defer bs.dbMutex.Unlock()
+
+	path := bs.db.Path()
+
+	return bs.reopen(path, compactDatabase(bs.db))
+}
+
+// compactDatabase copies the database to a new file which does not hold free
+// pages and replaces the original file with it. The database is closed in any
+// case and has to be opened again.
+//
+// The compacted file replaces the original file in a single rename, so
+// the database file exists at any time. Before the rename, the original file
+// is linked under another name so that it can be overwritten with random
+// bytes once it has been replaced. Files left behind if the process stops in
+// between are cleaned up by recoverCompaction.
+func compactDatabase(db *bolt.DB) error {
+	path := db.Path()
+	compactedPath := path + compactedSuffix
+	destroyedPath := path + destroyedSuffix
+
+	if err := recoverCompaction(path); err != nil {
+		_ = db.Close()
+		return err
+	}
+
+	compacted, err := bolt.Open(
+		compactedPath,
+		0600,
+		&bolt.Options{Timeout: boltOpenTimeout},
+	)
+	if err != nil {
+		_ = db.Close()
+		return fmt.Errorf("could not create compacted database: [%v]", err)
+	}
+
+	compactErr := bolt.Compact(compacted, db, 0)
+	if err := compacted.Close(); err != nil && compactErr == nil {
+		compactErr = err
+	}
+	if err := db.Close(); err != nil && compactErr == nil {
+		compactErr = err
+	}
+	if compactErr != nil {
+		destroyCompactedDatabase(compactedPath)
+		return fmt.Errorf("could not compact database: [%v]", compactErr)
+	}
+
+	if err := os.Link(path, destroyedPath); err != nil {
+		destroyCompactedDatabase(compactedPath)
+		return fmt.Errorf("could not link the original database: [%v]", err)
+	}
+
+	if err := os.Rename(compactedPath, path); err != nil {
+		_ = os.Remove(destroyedPath)
+		destroyCompactedDatabase(compactedPath)
+		return fmt.Errorf("could not replace database: [%v]", err)
+	}
+
+	if err := destroyFile(destroyedPath); err != nil {
+		return fmt.Errorf(
+			"could not destroy the original database [%s]: [%v]",
+			destroyedPath,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// destroyCompactedDatabase destroys the compacted database which has not
+// replaced the original database. Failures are logged, as the compacted
+// database is destroyed when the database is opened again.
+func destroyCompactedDatabase(compactedPath string) {
+	if err := destroyFile(compactedPath); err != nil && !os.IsNotExist(err) {
+		logger.Errorf(
+			"could not destroy compacted database [%s]: [%v]",
+			compactedPath,
+			err,
+		)
+	}
+}
+
+// recoverCompaction cleans up files left behind by an interrupted compaction
+// of the database at the given path. A compacted database which has not
+// replaced the original database is destroyed. The link to the original
+// database is removed if the original database has not been replaced yet and
+// the original database is destroyed otherwise.
+func recoverCompaction(path string) error {
+	compactedPath := path + compactedSuffix
+	if _, err := os.Stat(compactedPath); err == nil {
+		logger.Warningf(
+			"destroying database [%s] left by an interrupted compaction",
+			compactedPath,
+		)
+		if err := destroyFile(compactedPath); err != nil {
+			return fmt.Errorf(
+				"could not destroy compacted database [%s]: [%v]",
+				compactedPath,
+				err,
+			)
+		}
+	}
+
+	destroyedPath := path + destroyedSuffix
+	destroyedInfo, err := os.Stat(destroyedPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not read file [%s]: [%v]", destroyedPath, err)
+	}
+
+	// The database file always exists once the original database has been
+	// linked, so a missing database must not be created from scratch.
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf(
+			"could not read database [%s]; the original database remains "+
+				"in [%s]: [%v]",
+			path,
+			destroyedPath,
+			err,
+		)
+	}
+
+	if os.SameFile(info, destroyedInfo) {
+		if err := os.Remove(destroyedPath); err != nil {
+			return fmt.Errorf(
+				"could not remove link [%s] to the database: [%v]",
+				destroyedPath,
+				err,
+			)
+		}
+		return nil
+	}
+
+	logger.Warningf(
+		"destroying the original database [%s] left by an interrupted "+
+			"compaction",
+		destroyedPath,
+	)
+	if err := destroyFile(destroyedPath); err != nil {
+		return fmt.Errorf(
+			"could not destroy the original database [%s]: [%v]",
+			destroyedPath,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// reopen opens the database file at the given path after it has been closed
+// for compaction. It returns the cause of the reopening, if any, unless
+// the database could not be opened.
+func (bs *BoltStorage) reopen(path string, cause error) error {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: boltOpenTimeout})
+	if err != nil {
+		if cause != nil {
+			return fmt.Errorf(
+				"%v; reopening database failed: [%v]",
+				cause,
+				err,
+			)
+		}
+		return fmt.Errorf("could not reopen database: [%v]", err)
+	}
+
+	bs.db = db
+
+	return cause
+}
+
+// Migrate rewrites current signers stored with an outdated format version
+// using the current format version. The original content of each rewritten
+// signer is stored as a snapshot. Migrated signers which do not record
+// the name of the chain get the given chain name. All signers are migrated in
+// a single transaction; signers which could not be migrated are left as they
+// are. It returns the number of migrated signers and errors of signers which
+// could not be migrated.
+func (bs *BoltStorage) Migrate(chainName string) (int, []error) {
+	bs.dbMutex.RLock()
+	defer bs.dbMutex.RUnlock()
+
+	migratedCount := 0
+	errors := []error{}
+
+	err := bs.db.Update(func(tx *bolt.Tx) error {
+		migratedCount = 0
+		errors = []error{}
+
+		signers, err := readBucket(tx, currentBucket)
+		if err != nil {
+			return err
+		}
+
+		for _, stored := range signers {
+			migrated, err := bs.migrateSigner(tx, stored, chainName)
+			if err != nil {
+				errors = append(errors, fmt.Errorf(
+					"failed to migrate signer [%v] of keep [%v]: [%v]",
+					stored.key,
+					stored.keepID,
+					err,
+				))
+				continue
+			}
+
+			if migrated {
+				migratedCount++
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, []error{err}
+	}
+
+	return migratedCount, errors
+}
+
+// migrateSigner rewrites the stored signer if it is stored with an outdated
+// format version. It returns true if the signer has been rewritten.
+func (bs *BoltStorage) migrateSigner(
+	tx *bolt.Tx,
+	stored *storedSigner,
+	chainName string,
+) (bool, error) {
+	content, err := bs.box.Decrypt(stored.encrypted)
+	if err != nil {
+		return false, fmt.Errorf("failed to decrypt signer: [%v]", err)
+	}
+
+	formatVersion, err := tss.EncodedSignerFormatVersion(content)
+	if err != nil {
+		return false, err
+	}
+
+	if formatVersion == tss.SignerFormatVersion {
+		return false, nil
+	}
+
+	signer := &tss.ThresholdSigner{}
+	if err := signer.Unmarshal(content); err != nil {
+		return false, fmt.Errorf("failed to unmarshal signer: [%v]", err)
+	}
+
+	if signer.ChainName() == "" {
+		signer.SetChainName(chainName)
+	}
+
+	signerBytes, err := signer.Marshal()
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal signer: [%v]", err)
+	}
+
+	if err := bs.snapshot(tx, stored.keepID, stored.key, content); err != nil {
+		return false, fmt.Errorf("failed to make snapshot: [%v]", err)
+	}
+
+	if err := bs.put(
+		tx,
+		currentBucket,
+		stored.keepID,
+		stored.key,
+		signerBytes,
+	); err != nil {
+		return false, fmt.Errorf("failed to save migrated signer: [%v]", err)
+	}
+
+	return true, nil
+}
+
+// RekeyBoltStorage re-encrypts all signers, snapshots and archived signers of
+// the bolt storage in the given storage directory with the new password. All
+// of them are re-encrypted in a single transaction, so if any of them could
+// not be decrypted with the old password, the database is left unchanged.
+// Entries encrypted with the old password remain in free pages of
+// the database file, so the database is compacted the same way as when
+// archived signers are destroyed. It returns the number of re-encrypted
+// entries; if the database has been re-encrypted but could not be compacted,
+// the number is returned together with the error.
+//
+// The database must not be used by another process while it is re-encrypted.
+func RekeyBoltStorage(
+	storageDir string,
+	oldPassword string,
+	newPassword string,
+) (int, error) {
+	path := filepath.Join(storageDir, BoltDatabaseFileName)
+
+	db, err := openBoltDatabase(path)
+	if err != nil {
+		return 0, err
+	}
+
+	oldBox := newBoltBox(oldPassword)
+	newBox := newBoltBox(newPassword)
+
+	rekeyedCount := 0
+	err = db.Update(func(tx *bolt.Tx) error {
+		rekeyedCount = 0
+
+		for _, bucketName := range [][]byte{
+			currentBucket,
+			snapshotBucket,
+			archiveBucket,
+		} {
+			if tx.Bucket(bucketName) == nil {
+				continue
+			}
+
+			signers, err := readBucket(tx, bucketName)
+			if err != nil {
+				return err
+			}
+
+			for _, stored := range signers {
+				content, err := oldBox.Decrypt(stored.encrypted)
+				if err != nil {
+					return fmt.Errorf(
+						"could not decrypt entry [%s] of keep [%s] in "+
+							"bucket [%s] with the old password: [%v]",
+						stored.key,
+						stored.keepID,
+						bucketName,
+						err,
+					)
+				}
+
+				reencrypted, err := newBox.Encrypt(content)
+				if err != nil {
+					return fmt.Errorf(
+						"could not encrypt entry [%s] of keep [%s] in "+
+							"bucket [%s]: [%v]",
+						stored.key,
+						stored.keepID,
+						bucketName,
+						err,
+					)
+				}
+
+				if err := tx.Bucket(bucketName).
+					Bucket([]byte(stored.keepID)).
+					Put([]byte(stored.key), reencrypted); err != nil {
+					return err
+				}
+
+				rekeyedCount++
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		if closeErr := db.Close(); closeErr != nil {
+			logger.Errorf("could not close database [%s]: [%v]", path, closeErr)
+		}
+		return 0, fmt.Errorf("could not re-encrypt database [%s]: [%v]", path, err)
+	}
+
+	if err := compactDatabase(db); err != nil {
+		return rekeyedCount, fmt.Errorf(
+			"database [%s] has been re-encrypted but could not be "+
+				"compacted: [%v]",
+			path,
+			err,
+		)
+	}
+
+	return rekeyedCount, nil
+}
@@ -0,0 +1,768 @@
+package registry
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/keep-network/keep-common/pkg/persistence"
+	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const testStoragePassword = "storage-password"
+
+func newTestStorageDir(t *testing.T) string {
+	storageDir, err := ioutil.TempDir("", "registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return storageDir
+}
+
+func openTestBoltStorage(t *testing.T, storageDir string) *BoltStorage {
+	storage, err := NewBoltStorage(storageDir, testStoragePassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return storage
+}
+
+// readAllSigners reads current signers of the storage mapped by keep IDs.
+func readAllSigners(t *testing.T, storage Storage) map[string]*tss.ThresholdSigner {
+	signers := map[string]*tss.ThresholdSigner{}
+
+	keepSigners, errs := storage.ReadAll(localChain.UnmarshalID)
+
+	done := make(chan struct{})
+	go func() {
+		for err := range errs {
+			t.Errorf("unexpected error: [%v]", err)
+		}
+		close(done)
+	}()
+
+	for keepSigner := range keepSigners {
+		signers[keepSigner.KeepID.String()] = keepSigner.Signer
+	}
+	<-done
+
+	return signers
+}
+
+// bucketKeys returns keys of the keep bucket nested in the given bucket.
+func bucketKeys(
+	t *testing.T,
+	storage *BoltStorage,
+	bucketName []byte,
+	keepID string,
+) []string {
+	keys := []string{}
+
+	err := storage.db.View(func(tx *bolt.Tx) error {
+		keepBucket := tx.Bucket(bucketName).Bucket([]byte(keepID))
+		if keepBucket == nil {
+			return nil
+		}
+
+		return keepBucket.ForEach(func(key, _ []byte) error {
+			keys = append(keys, string(key))
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+func assertSignerKeeps(
+	t *testing.T,
+	expectedKeepIDs []string,
+	signers map[string]*tss.ThresholdSigner,
+) {
+	keepIDs := []string{}
+	for keepID := range signers {
+		keepIDs = append(keepIDs, keepID)
+	}
+	sort.Strings(keepIDs)
+	sort.Strings(expectedKeepIDs)
+
+	if !reflect.DeepEqual(expectedKeepIDs, keepIDs) {
+		t.Errorf(
+			"unexpected keeps\nexpected: [%v]\nactual:   [%v]",
+			expectedKeepIDs,
+			keepIDs,
+		)
+	}
+}
+
+func TestBoltStorage(t *testing.T) {
+	storageDir := newTestStorageDir(t)
+	defer os.RemoveAll(storageDir)
+
+	storage := openTestBoltStorage(t, storageDir)
+
+	signers, err := testSigners()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := storage.Save(keepID1, signers[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.Save(keepID2, signers[1]); err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.Snapshot(keepID1, signers[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.Archive(keepID2, ArchiveReasonClosed); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := storage.Archive(keepID3, ArchiveReasonClosed); err == nil {
+		t.Errorf("expected error when archiving keep with no signers")
+	}
+
+	if err := storage.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	storage = openTestBoltStorage(t, storageDir)
+	defer storage.Close()
+
+	readSigners := readAllSigners(t, storage)
+	assertSignerKeeps(t, []string{keepID1.String()}, readSigners)
+
+	if !reflect.DeepEqual(signers[0], readSigners[keepID1.String()]) {
+		t.Errorf(
+			"unexpected signer\nexpected: [%+v]\nactual:   [%+v]",
+			signers[0],
+			readSigners[keepID1.String()],
+		)
+	}
+
+	snapshots := bucketKeys(t, storage, snapshotBucket, keepID1.String())
+	if len(snapshots) != 1 ||
+		!strings.HasPrefix(snapshots[0], signerKey(signers[0])+".") {
+		t.Errorf("unexpected snapshots: [%v]", snapshots)
+	}
+
+	expectedArchived := []string{signerKey(signers[1])}
+	archived := bucketKeys(t, storage, archiveBucket, keepID2.String())
+	if !reflect.DeepEqual(expectedArchived, archived) {
+		t.Errorf(
+			"unexpected archived signers\nexpected: [%v]\nactual:   [%v]",
+			expectedArchived,
+			archived,
+		)
+	}
+}
+
+// newTestDirectoryLayout stores a current signer of the first keep,
+// an archived signer of the second keep and a snapshot of the first keep
+// signer with the encrypted disk persistence.
+func newTestDirectoryLayout(
+	t *testing.T,
+	storageDir string,
+) persistence.Handle {
+	diskHandle, err := persistence.NewDiskHandle(storageDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	persistentStorage := NewPersistentStorage(
+		persistence.NewEncryptedPersistence(diskHandle, testStoragePassword),
+	)
+
+	signers, err := testSigners()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := persistentStorage.Save(keepID1, signers[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := persistentStorage.Save(keepID2, signers[1]); err != nil {
+		t.Fatal(err)
+	}
+	if err := persistentStorage.Archive(keepID2, ArchiveReasonClosed); err != nil {
+		t.Fatal(err)
+	}
+	if err := persistentStorage.Snapshot(keepID1, signers[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	return diskHandle
+}
+
+func TestBoltStorage_ImportDirectoryLayout(t *testing.T) {
+	storageDir := newTestStorageDir(t)
+	defer os.RemoveAll(storageDir)
+
+	diskHandle := newTestDirectoryLayout(t, storageDir)
+
+	storage := openTestBoltStorage(t, storageDir)
+
+	assertSignerKeeps(
+		t,
+		[]string{keepID1.String()},
+		readAllSigners(t, storage),
+	)
+
+	if snapshots := bucketKeys(
+		t,
+		storage,
+		snapshotBucket,
+		keepID1.String(),
+	); len(snapshots) != 1 {
+		t.Errorf("unexpected snapshots: [%v]", snapshots)
+	}
+
+	if archived := bucketKeys(
+		t,
+		storage,
+		archiveBucket,
+		keepID2.String(),
+	); len(archived) != 1 {
+		t.Errorf("unexpected archived signers: [%v]", archived)
+	}
+
+	// Imported files are left in place until they are destroyed explicitly.
+	for _, path := range []string{
+		filepath.Join(storageDir, "current", keepID1.String()),
+		filepath.Join(storageDir, "snapshot", keepID1.String()),
+		filepath.Join(storageDir, "archive", keepID2.String()),
+	} {
+		if _, err := os.Stat(path); err != nil {
+			t.Errorf("imported [%s] has been removed: [%v]", path, err)
+		}
+	}
+
+	if err := storage.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Files written to the directory layout after the import are not
+	// imported when the database is opened again.
+	signer, err := newTestSigner(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := NewPersistentStorage(
+		persistence.NewEncryptedPersistence(diskHandle, testStoragePassword),
+	).Save(keepID3, signer); err != nil {
+		t.Fatal(err)
+	}
+
+	storage = openTestBoltStorage(t, storageDir)
+	defer storage.Close()
+
+	assertSignerKeeps(
+		t,
+		[]string{keepID1.String()},
+		readAllSigners(t, storage),
+	)
+}
+
+func TestBoltStorage_ImportDirectoryLayoutWrongPassword(t *testing.T) {
+	storageDir := newTestStorageDir(t)
+	defer os.RemoveAll(storageDir)
+
+	newTestDirectoryLayout(t, storageDir)
+
+	_, err := NewBoltStorage(storageDir, "wrong-password")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "could not decrypt file") {
+		t.Errorf("unexpected error: [%v]", err)
+	}
+
+	// The failed import is not recorded, so it is retried when the database
+	// is opened with the right password.
+	storage := openTestBoltStorage(t, storageDir)
+	defer storage.Close()
+
+	assertSignerKeeps(
+		t,
+		[]string{keepID1.String()},
+		readAllSigners(t, storage),
+	)
+}
+
+func TestDestroyImportedFiles(t *testing.T) {
+	storageDir := newTestStorageDir(t)
+	defer os.RemoveAll(storageDir)
+
+	diskHandle := newTestDirectoryLayout(t, storageDir)
+
+	// The signer of the first keep is archived after it has been imported,
+	// so its file is found in another bucket than it was imported to.
+	storage := openTestBoltStorage(t, storageDir)
+	if err := storage.Archive(keepID1, ArchiveReasonClosed); err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The file written to the directory layout after the import is not in
+	// the database, so it must not be destroyed.
+	signer, err := newTestSigner(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := NewPersistentStorage(
+		persistence.NewEncryptedPersistence(diskHandle, testStoragePassword),
+	).Save(keepID3, signer); err != nil {
+		t.Fatal(err)
+	}
+	notImportedPath := filepath.Join(
+		"current",
+		keepID3.String(),
+		fmt.Sprintf("membership_%.40s", signer.MemberID().String()),
+	)
+
+	destroyed, remaining, err := DestroyImportedFiles(
+		storageDir,
+		testStoragePassword,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(destroyed) != 3 {
+		t.Errorf("unexpected destroyed files: [%v]", destroyed)
+	}
+	if !reflect.DeepEqual([]string{notImportedPath}, remaining) {
+		t.Errorf(
+			"unexpected remaining files\nexpected: [%v]\nactual:   [%v]",
+			[]string{notImportedPath},
+			remaining,
+		)
+	}
+
+	for _, path := range []string{
+		filepath.Join(storageDir, "current", keepID1.String()),
+		filepath.Join(storageDir, "snapshot", keepID1.String()),
+		filepath.Join(storageDir, "archive", keepID2.String()),
+	} {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Errorf("imported [%s] has not been removed: [%v]", path, err)
+		}
+	}
+
+	storage = openTestBoltStorage(t, storageDir)
+	defer storage.Close()
+
+	if archived := bucketKeys(
+		t,
+		storage,
+		archiveBucket,
+		keepID1.String(),
+	); len(archived) != 1 {
+		t.Errorf("unexpected archived signers: [%v]", archived)
+	}
+}
+
+func TestExportBoltStorage(t *testing.T) {
+	storageDir := newTestStorageDir(t)
+	defer os.RemoveAll(storageDir)
+
+	storage := openTestBoltStorage(t, storageDir)
+
+	signers, err := testSigners()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := storage.Save(keepID1, signers[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.Save(keepID2, signers[1]); err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.Snapshot(keepID1, signers[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.Archive(keepID2, ArchiveReasonClosed); err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The disk storage does not start with no keeps while the database has
+	// not been exported.
+	if _, err := NewDiskStorage(storageDir, testStoragePassword); err == nil {
+		t.Fatal("expected error")
+	}
+
+	exportedCount, err := ExportBoltStorage(storageDir, testStoragePassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exportedCount != 3 {
+		t.Errorf(
+			"unexpected number of exported signers\nexpected: [%d]\nactual:   [%d]",
+			3,
+			exportedCount,
+		)
+	}
+
+	if _, err := os.Stat(
+		filepath.Join(storageDir, BoltDatabaseFileName),
+	); !os.IsNotExist(err) {
+		t.Errorf("database has not been removed: [%v]", err)
+	}
+
+	diskStorage, err := NewDiskStorage(storageDir, testStoragePassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	readSigners := readAllSigners(t, diskStorage)
+	assertSignerKeeps(t, []string{keepID1.String()}, readSigners)
+	if !reflect.DeepEqual(signers[0], readSigners[keepID1.String()]) {
+		t.Errorf(
+			"unexpected signer\nexpected: [%+v]\nactual:   [%+v]",
+			signers[0],
+			readSigners[keepID1.String()],
+		)
+	}
+
+	archivedKeeps, err := diskStorage.ArchivedKeeps()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(archivedKeeps) != 1 ||
+		archivedKeeps[0].KeepID != keepID2.String() ||
+		archivedKeeps[0].Reason != ArchiveReasonClosed {
+		t.Errorf("unexpected archived keeps: [%v]", archivedKeeps)
+	}
+
+	snapshots, err := keepFiles(storageDir, keepID1.String(), "snapshot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 1 {
+		t.Errorf("unexpected snapshots: [%v]", snapshots)
+	}
+}
+
+func TestExportBoltStorage_DirectoryLayoutNotEmpty(t *testing.T) {
+	storageDir := newTestStorageDir(t)
+	defer os.RemoveAll(storageDir)
+
+	newTestDirectoryLayout(t, storageDir)
+
+	if _, err := ExportBoltStorage(
+		storageDir,
+		testStoragePassword,
+	); err == nil {
+		t.Fatal("expected error")
+	}
+
+	// Nothing has been exported, so the database is left in place.
+	storage := openTestBoltStorage(t, storageDir)
+	defer storage.Close()
+
+	assertSignerKeeps(
+		t,
+		[]string{keepID1.String()},
+		readAllSigners(t, storage),
+	)
+}
+
+func TestBoltStorage_InterruptedCompaction(t *testing.T) {
+	copyFile := func(t *testing.T, source string, destination string) {
+		content, err := ioutil.ReadFile(source)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(destination, content, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var tests = map[string]struct {
+		// interrupt leaves files of a compaction interrupted at some step
+		// next to the database file of the given path.
+		interrupt func(t *testing.T, path string)
+	}{
+		"interrupted before the compacted database replaced the original": {
+			interrupt: func(t *testing.T, path string) {
+				copyFile(t, path, path+compactedSuffix)
+				if err := os.Link(path, path+destroyedSuffix); err != nil {
+					t.Fatal(err)
+				}
+			},
+		},
+		"interrupted before the original database has been destroyed": {
+			interrupt: func(t *testing.T, path string) {
+				copyFile(t, path, path+destroyedSuffix)
+			},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			storageDir := newTestStorageDir(t)
+			defer os.RemoveAll(storageDir)
+
+			newTestDirectoryLayout(t, storageDir)
+			storage := openTestBoltStorage(t, storageDir)
+			if err := storage.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			path := filepath.Join(storageDir, BoltDatabaseFileName)
+			test.interrupt(t, path)
+
+			storage = openTestBoltStorage(t, storageDir)
+			defer storage.Close()
+
+			assertSignerKeeps(
+				t,
+				[]string{keepID1.String()},
+				readAllSigners(t, storage),
+			)
+
+			for _, leftover := range []string{
+				path + compactedSuffix,
+				path + destroyedSuffix,
+			} {
+				if _, err := os.Stat(leftover); !os.IsNotExist(err) {
+					t.Errorf(
+						"file [%s] has not been removed: [%v]",
+						leftover,
+						err,
+					)
+				}
+			}
+		})
+	}
+}
+
+func TestBoltStorage_InterruptedCompactionWithoutDatabase(t *testing.T) {
+	storageDir := newTestStorageDir(t)
+	defer os.RemoveAll(storageDir)
+
+	newTestDirectoryLayout(t, storageDir)
+	storage := openTestBoltStorage(t, storageDir)
+	if err := storage.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// A database missing next to the original database must not be created
+	// from scratch, as the client would start with no keeps.
+	path := filepath.Join(storageDir, BoltDatabaseFileName)
+	if err := os.Rename(path, path+destroyedSuffix); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewBoltStorage(storageDir, testStoragePassword); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if _, err := os.Stat(path + destroyedSuffix); err != nil {
+		t.Errorf("the original database has been removed: [%v]", err)
+	}
+}
+
+func TestBoltStorage_Migrate(t *testing.T) {
+	storageDir := newTestStorageDir(t)
+	defer os.RemoveAll(storageDir)
+
+	storage := openTestBoltStorage(t, storageDir)
+	defer storage.Close()
+
+	legacySignerBytes, err := newLegacyTestSignerBytes(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.db.Update(func(tx *bolt.Tx) error {
+		return storage.put(
+			tx,
+			currentBucket,
+			keepID1.String(),
+			"membership_0",
+			legacySignerBytes,
+		)
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	signer2, err := newTestSigner(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.Save(keepID2, signer2); err != nil {
+		t.Fatal(err)
+	}
+
+	migratedCount, errs := storage.Migrate("ethereum")
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: [%v]", errs)
+	}
+	if migratedCount != 1 {
+		t.Errorf(
+			"unexpected number of migrated signers\nexpected: [%d]\nactual:   [%d]",
+			1,
+			migratedCount,
+		)
+	}
+
+	if snapshots := bucketKeys(
+		t,
+		storage,
+		snapshotBucket,
+		keepID1.String(),
+	); len(snapshots) != 1 {
+		t.Errorf("unexpected snapshots: [%v]", snapshots)
+	}
+	if snapshots := bucketKeys(
+		t,
+		storage,
+		snapshotBucket,
+		keepID2.String(),
+	); len(snapshots) != 0 {
+		t.Errorf("unexpected snapshots: [%v]", snapshots)
+	}
+
+	migratedSigner := readAllSigners(t, storage)[keepID1.String()]
+	if migratedSigner == nil {
+		t.Fatal("migrated signer not found")
+	}
+	if migratedSigner.ChainName() != "ethereum" {
+		t.Errorf(
+			"unexpected chain name\nexpected: [%s]\nactual:   [%s]",
+			"ethereum",
+			migratedSigner.ChainName(),
+		)
+	}
+
+	if migratedCount, errs := storage.Migrate("ethereum"); migratedCount != 0 ||
+		len(errs) > 0 {
+		t.Errorf(
+			"unexpected second migration result: [%d] [%v]",
+			migratedCount,
+			errs,
+		)
+	}
+}
+
+func TestRekeyBoltStorage(t *testing.T) {
+	storageDir := newTestStorageDir(t)
+	defer os.RemoveAll(storageDir)
+
+	newTestDirectoryLayout(t, storageDir)
+	storage := openTestBoltStorage(t, storageDir)
+
+	oldEntries := [][]byte{}
+	err := storage.db.View(func(tx *bolt.Tx) error {
+		for _, bucketName := range [][]byte{
+			currentBucket,
+			snapshotBucket,
+			archiveBucket,
+		} {
+			signers, err := readBucket(tx, bucketName)
+			if err != nil {
+				return err
+			}
+			for _, stored := range signers {
+				oldEntries = append(oldEntries, stored.encrypted)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := storage.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := RekeyBoltStorage(
+		storageDir,
+		"wrong-password",
+		"new-password",
+	); err == nil {
+		t.Fatal("expected error")
+	}
+
+	rekeyedCount, err := RekeyBoltStorage(
+		storageDir,
+		testStoragePassword,
+		"new-password",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rekeyedCount != 3 {
+		t.Errorf(
+			"unexpected number of re-encrypted entries\nexpected: [%d]\nactual:   [%d]",
+			3,
+			rekeyedCount,
+		)
+	}
+
+	// Entries encrypted with the old password are not left in free pages of
+	// the database file.
+	databaseContent, err := ioutil.ReadFile(
+		filepath.Join(storageDir, BoltDatabaseFileName),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, oldEntry := range oldEntries {
+		if bytes.Contains(databaseContent, oldEntry) {
+			t.Errorf("entry encrypted with the old password left in database")
+		}
+	}
+
+	storage, err = NewBoltStorage(storageDir, "new-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer storage.Close()
+
+	assertSignerKeeps(
+		t,
+		[]string{keepID1.String()},
+		readAllSigners(t, storage),
+	)
+
+	err = storage.db.View(func(tx *bolt.Tx) error {
+		signers, err := readBucket(tx, archiveBucket)
+		if err != nil {
+			return err
+		}
+		for _, stored := range signers {
+			if _, err := storage.box.Decrypt(stored.encrypted); err != nil {
+				t.Errorf(
+					"could not decrypt archived signer [%s]: [%v]",
+					filepath.Join(stored.keepID, stored.key),
+					err,
+				)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
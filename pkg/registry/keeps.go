@@ -3,6 +3,7 @@ package registry
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/ipfs/go-log"
 	"github.com/keep-network/keep-common/pkg/persistence"
@@ -17,19 +18,32 @@ type Keeps struct {
 	myKeepsMutex *sync.RWMutex
 	myKeeps      map[chain.ID]*tss.ThresholdSigner
 
-	storage     storage
+	storage     Storage
 	unmarshalID func(string) (chain.ID, error)
 }
 
-// NewKeepsRegistry returns an empty keeps registry.
+// NewKeepsRegistry returns an empty keeps registry storing signers in files
+// of the given persistence handle.
 func NewKeepsRegistry(
 	persistence persistence.Handle,
 	unmarshalIDFunc func(string) (chain.ID, error),
+) *Keeps {
+	return NewKeepsRegistryWithStorage(
+		NewPersistentStorage(persistence),
+		unmarshalIDFunc,
+	)
+}
+
+// NewKeepsRegistryWithStorage returns an empty keeps registry storing signers
+// in the given storage.
+func NewKeepsRegistryWithStorage(
+	storage Storage,
+	unmarshalIDFunc func(string) (chain.ID, error),
 ) *Keeps {
 	return &Keeps{
 		myKeepsMutex: &sync.RWMutex{},
 		myKeeps:      make(map[chain.ID]*tss.ThresholdSigner),
-		storage:      newStorage(persistence),
+		storage:      storage,
 		unmarshalID:  unmarshalIDFunc,
 	}
 }
@@ -50,7 +64,7 @@ func (k *Keeps) RegisterSigner(
 		)
 	}
 
-	err := k.storage.save(keepID, signer)
+	err := k.storage.Save(keepID, signer)
 	if err != nil {
 		return fmt.Errorf(
 			"could not persist signer for keep [%s] in the storage: [%v]",
@@ -69,15 +83,16 @@ func (k *Keeps) SnapshotSigner(
 	keepID chain.ID,
 	signer *tss.ThresholdSigner,
 ) error {
-	return k.storage.snapshot(keepID, signer)
+	return k.storage.Snapshot(keepID, signer)
 }
 
 // UnregisterKeep archives threeshold signer info for the given keep address.
-func (k *Keeps) UnregisterKeep(keepID chain.ID) {
+// The reason determines for how long archived signers are retained.
+func (k *Keeps) UnregisterKeep(keepID chain.ID, reason ArchiveReason) {
 	k.myKeepsMutex.Lock()
 	defer k.myKeepsMutex.Unlock()
 
-	err := k.storage.archive(keepID)
+	err := k.storage.Archive(keepID, reason)
 	if err != nil {
 		logger.Errorf("could not archive keep to the storage: [%v]", err)
 	}
@@ -125,13 +140,113 @@ func (k *Keeps) GetKeepsIDs() []chain.ID {
 	return keepIDs
 }
 
+// MigrateSigners rewrites signers stored with an outdated format version
+// using the current format version, taking a snapshot of each file before
+// it is overwritten. Signers of archived keeps are not rewritten. Migrated
+// signers which do not record the name of the chain get the given chain name.
+// It returns the number of migrated signers and errors of signers which could
+// not be migrated.
+//
+// Signers are migrated to the current format version whenever they are
+// loaded, so rewriting them is not required for the client to work.
+func (k *Keeps) MigrateSigners(chainName string) (int, []error) {
+	k.myKeepsMutex.Lock()
+	defer k.myKeepsMutex.Unlock()
+
+	return k.storage.Migrate(chainName)
+}
+
+// ArchivedKeeps returns all keeps with archived signers.
+func (k *Keeps) ArchivedKeeps() ([]*ArchivedKeep, error) {
+	k.myKeepsMutex.RLock()
+	defer k.myKeepsMutex.RUnlock()
+
+	return k.storage.ArchivedKeeps()
+}
+
+// ConfirmRecovery records the operator confirmed funds held by the archived
+// keep have been recovered, so that archived signers of the keep can be
+// pruned once the retention period passes.
+func (k *Keeps) ConfirmRecovery(keepID string) error {
+	k.myKeepsMutex.Lock()
+	defer k.myKeepsMutex.Unlock()
+
+	return k.storage.ConfirmRecovery(keepID, time.Now())
+}
+
+// PruneArchives destroys archived signers of keeps whose retention period
+// determined by the policy has passed. Signers are recorded in the log of
+// the policy before they are destroyed, so no signer is destroyed without
+// a record. It returns keeps whose archived signers have been destroyed and
+// errors of keeps whose archived signers could not be destroyed.
+func (k *Keeps) PruneArchives(policy *PrunePolicy) ([]*ArchivedKeep, []error) {
+	k.myKeepsMutex.Lock()
+	defer k.myKeepsMutex.Unlock()
+
+	archivedKeeps, err := k.storage.ArchivedKeeps()
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	now := time.Now()
+
+	pruned := []*ArchivedKeep{}
+	errors := []error{}
+	for _, archivedKeep := range archivedKeeps {
+		expiresAt, expires := archivedKeep.ExpiresAt(policy.Retention)
+		if !expires || now.Before(expiresAt) {
+			continue
+		}
+
+		entries, err := k.storage.ArchiveEntries(archivedKeep.KeepID)
+		if err != nil {
+			errors = append(errors, fmt.Errorf(
+				"could not read archived signers of keep [%s]: [%v]",
+				archivedKeep.KeepID,
+				err,
+			))
+			continue
+		}
+
+		if err := policy.Log.Append(&PruneRecord{
+			PrunedAt:            now.UTC(),
+			KeepID:              archivedKeep.KeepID,
+			Reason:              archivedKeep.Reason,
+			ArchivedAt:          archivedKeep.ArchivedAt,
+			RecoveryConfirmedAt: archivedKeep.RecoveryConfirmedAt,
+			Entries:             entries,
+		}); err != nil {
+			errors = append(errors, fmt.Errorf(
+				"archived signers of keep [%s] could not be recorded in "+
+					"the prune log and have not been destroyed: [%v]",
+				archivedKeep.KeepID,
+				err,
+			))
+			continue
+		}
+
+		if err := k.storage.DestroyArchive(archivedKeep.KeepID); err != nil {
+			errors = append(errors, fmt.Errorf(
+				"could not destroy archived signers of keep [%s]: [%v]",
+				archivedKeep.KeepID,
+				err,
+			))
+			continue
+		}
+
+		pruned = append(pruned, archivedKeep)
+	}
+
+	return pruned, errors
+}
+
 // LoadExistingKeeps iterates over all signers stored on disk and loads them
 // into memory
 func (k *Keeps) LoadExistingKeeps() {
 	k.myKeepsMutex.Lock()
 	defer k.myKeepsMutex.Unlock()
 
-	keepSignersChannel, errorsChannel := k.storage.readAll(k.unmarshalID)
+	keepSignersChannel, errorsChannel := k.storage.ReadAll(k.unmarshalID)
 
 	// Two goroutines read from signers and errors channels and either adds
 	// signers to the keeps registry or outputs an error to stderr.
@@ -144,16 +259,16 @@ func (k *Keeps) LoadExistingKeeps() {
 
 	go func() {
 		for keepSigner := range keepSignersChannel {
-			if _, exists := k.myKeeps[keepSigner.keepID]; exists {
+			if _, exists := k.myKeeps[keepSigner.KeepID]; exists {
 				logger.Errorf(
 					"signer for keep [%s] already loaded; "+
 						"possible duplicate in the storage layer",
-					keepSigner.keepID.String(),
+					keepSigner.KeepID.String(),
 				)
 				continue
 			}
 
-			k.myKeeps[keepSigner.keepID] = keepSigner.signer
+			k.myKeeps[keepSigner.KeepID] = keepSigner.Signer
 		}
 
 		wg.Done()
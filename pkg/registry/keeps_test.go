@@ -187,7 +187,7 @@ func TestUnregisterSigner(t *testing.T) {
 		t.Fatalf("failed to register signer: [%v]", err)
 	}
 
-	kr.UnregisterKeep(keepID1)
+	kr.UnregisterKeep(keepID1, ArchiveReasonClosed)
 
 	if len(persistenceMock.PersistedGroups) != 0 {
 		t.Errorf(
@@ -316,6 +316,96 @@ func TestLoadExistingGroups(t *testing.T) {
 	}
 }
 
+func TestMigrateSigners(t *testing.T) {
+	persistenceMock := testhelper.NewPersistenceHandleMock(2)
+
+	legacySignerBytes, err := newLegacyTestSignerBytes(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	persistenceMock.MockFile("/membership_0", keepID1.String(), legacySignerBytes)
+
+	signer2, err := newTestSigner(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := persistenceMock.MockSigner(1, keepID2.String(), signer2); err != nil {
+		t.Fatal(err)
+	}
+
+	kr := NewKeepsRegistry(persistenceMock, localChain.UnmarshalID)
+
+	migratedCount, errs := kr.MigrateSigners("ethereum")
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: [%v]", errs)
+	}
+	if migratedCount != 1 {
+		t.Errorf(
+			"unexpected number of migrated signers\nexpected: [%d]\nactual:   [%d]",
+			1,
+			migratedCount,
+		)
+	}
+
+	expectedSnapshot := &testhelper.TestFileInfo{
+		Data:      legacySignerBytes,
+		Directory: keepID1.String(),
+		Name:      "/membership_0",
+	}
+	if len(persistenceMock.Snapshots) != 1 ||
+		!reflect.DeepEqual(expectedSnapshot, persistenceMock.Snapshots[0]) {
+		t.Errorf(
+			"unexpected snapshots\nexpected: [%+v]\nactual:   [%+v]",
+			[]*testhelper.TestFileInfo{expectedSnapshot},
+			persistenceMock.Snapshots,
+		)
+	}
+
+	if len(persistenceMock.PersistedGroups) != 1 {
+		t.Fatalf(
+			"unexpected number of persisted files\nexpected: [%d]\nactual:   [%d]",
+			1,
+			len(persistenceMock.PersistedGroups),
+		)
+	}
+
+	persistedFile := persistenceMock.PersistedGroups[0]
+	if persistedFile.Directory != keepID1.String() ||
+		persistedFile.Name != "/membership_0" {
+		t.Errorf(
+			"unexpected persisted file\nexpected: [%v/%v]\nactual:   [%v/%v]",
+			keepID1.String(),
+			"/membership_0",
+			persistedFile.Directory,
+			persistedFile.Name,
+		)
+	}
+
+	formatVersion, err := tss.EncodedSignerFormatVersion(persistedFile.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if formatVersion != tss.SignerFormatVersion {
+		t.Errorf(
+			"unexpected format version\nexpected: [%d]\nactual:   [%d]",
+			tss.SignerFormatVersion,
+			formatVersion,
+		)
+	}
+
+	migratedSigner := &tss.ThresholdSigner{}
+	if err := migratedSigner.Unmarshal(persistedFile.Data); err != nil {
+		t.Fatal(err)
+	}
+	if migratedSigner.ChainName() != "ethereum" {
+		t.Errorf(
+			"unexpected chain name\nexpected: [%s]\nactual:   [%s]",
+			"ethereum",
+			migratedSigner.ChainName(),
+		)
+	}
+}
+
 func testSigners() ([]*tss.ThresholdSigner, error) {
 	signers := make([]*tss.ThresholdSigner, len(groupMemberIDs))
 
@@ -330,6 +420,24 @@ func testSigners() ([]*tss.ThresholdSigner, error) {
 }
 
 func newTestSigner(memberIndex int) (*tss.ThresholdSigner, error) {
+	bytes, err := newLegacyTestSignerBytes(memberIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := &tss.ThresholdSigner{}
+
+	err = signer.Unmarshal(bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal signer: [%v]", err)
+	}
+
+	return signer, nil
+}
+
+// newLegacyTestSignerBytes returns a test signer marshalled with the legacy
+// format version.
+func newLegacyTestSignerBytes(memberIndex int) ([]byte, error) {
 	testData, err := testdata.LoadKeygenTestFixtures(1)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load key gen test fixtures: [%v]", err)
@@ -341,8 +449,6 @@ func newTestSigner(memberIndex int) (*tss.ThresholdSigner, error) {
 		return nil, fmt.Errorf("failed to marshal threshold key: [%v]", err)
 	}
 
-	signer := &tss.ThresholdSigner{}
-
 	pbGroup := &pb.ThresholdSigner_GroupInfo{
 		GroupID:            "test-group-1",
 		MemberID:           groupMemberIDs[memberIndex],
@@ -359,10 +465,5 @@ func newTestSigner(memberIndex int) (*tss.ThresholdSigner, error) {
 		return nil, fmt.Errorf("failed to marshal signer: [%v]", err)
 	}
 
-	err = signer.Unmarshal(bytes)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal signer: [%v]", err)
-	}
-
-	return signer, nil
+	return bytes, nil
 }
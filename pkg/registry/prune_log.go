@@ -0,0 +1,75 @@
+package registry
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/keep-network/keep-ecdsa/pkg/utils/hashchain"
+)
+
+// PruneLogFileName is the name of the prune log file in the storage
+// directory.
+const PruneLogFileName = "prune_log.jsonl"
+
+// PruneRecord records all copies of archived signers of a keep, appended to
+// the log before the copies are destroyed. Each record holds the hash of
+// the previous record, so removing or modifying any record breaks the chain of
+// hashes of all following records.
+type PruneRecord struct {
+	PrunedAt            time.Time         `json:"prunedAt"`
+	KeepID              string            `json:"keepId"`
+	Reason              ArchiveReason     `json:"reason"`
+	ArchivedAt          time.Time         `json:"archivedAt"`
+	RecoveryConfirmedAt time.Time         `json:"recoveryConfirmedAt"`
+	Entries             []*DestroyedEntry `json:"entries"`
+	hashchain.Link
+}
+
+// PruneLog is an append-only log of destroyed archived signers, kept as
+// a file with one JSON-encoded record per line.
+type PruneLog struct {
+	file *hashchain.File
+}
+
+// OpenPruneLog opens the prune log in the given storage directory. Records
+// already in the log are verified; the log is not opened if the chain of
+// hashes of its records is broken.
+func OpenPruneLog(storageDir string) (*PruneLog, error) {
+	pruneLog := &PruneLog{
+		file: hashchain.NewFile(filepath.Join(storageDir, PruneLogFileName)),
+	}
+
+	if _, err := pruneLog.Records(); err != nil {
+		return nil, err
+	}
+
+	return pruneLog, nil
+}
+
+// Records reads and verifies all records of the log.
+func (pl *PruneLog) Records() ([]*PruneRecord, error) {
+	records, err := pl.file.Records(
+		func() hashchain.Record { return &PruneRecord{} },
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not read prune log: [%v]", err)
+	}
+
+	pruneRecords := make([]*PruneRecord, len(records))
+	for i, record := range records {
+		pruneRecords[i] = record.(*PruneRecord)
+	}
+
+	return pruneRecords, nil
+}
+
+// Append chains the record to the last record of the log and writes it to
+// the log file.
+func (pl *PruneLog) Append(record *PruneRecord) error {
+	if err := pl.file.Append(record); err != nil {
+		return fmt.Errorf("could not append record to prune log: [%v]", err)
+	}
+
+	return nil
+}
@@ -1,32 +1,115 @@
 package registry
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/keep-network/keep-common/pkg/persistence"
 	"github.com/keep-network/keep-ecdsa/pkg/chain"
 	"github.com/keep-network/keep-ecdsa/pkg/ecdsa/tss"
 )
 
-type storage interface {
-	save(keepID chain.ID, signer *tss.ThresholdSigner) error
-	snapshot(keepID chain.ID, signer *tss.ThresholdSigner) error
-	readAll(unmarshalIDFunc func(string) (chain.ID, error)) (<-chan *keepSigner, <-chan error)
-	archive(keepID chain.ID) error
+// Storage persists signers of keeps the client is a member of.
+type Storage interface {
+	// Save stores the signer of the keep, replacing the signer of the same
+	// member of the keep if it has been already stored.
+	Save(keepID chain.ID, signer *tss.ThresholdSigner) error
+	// Snapshot stores a copy of the signer of the keep aside of the current
+	// signers. Snapshots are never read back by the client; they let the
+	// operator recover the signer manually.
+	Snapshot(keepID chain.ID, signer *tss.ThresholdSigner) error
+	// ReadAll reads signers of all keeps which have not been archived.
+	ReadAll(
+		unmarshalIDFunc func(string) (chain.ID, error),
+	) (<-chan *KeepSigner, <-chan error)
+	// Archive moves signers of the keep to the archive so that they are no
+	// longer read by ReadAll, recording the reason and time of archiving.
+	Archive(keepID chain.ID, reason ArchiveReason) error
+	// Migrate rewrites signers stored with an outdated format version using
+	// the current format version. It returns the number of migrated signers
+	// and errors of signers which could not be migrated.
+	Migrate(chainName string) (int, []error)
+	// ArchivedKeeps returns all keeps with archived signers.
+	ArchivedKeeps() ([]*ArchivedKeep, error)
+	// ConfirmRecovery records the operator confirmed funds held by
+	// the archived keep have been recovered.
+	ConfirmRecovery(keepID string, confirmedAt time.Time) error
+	// ArchiveEntries describes all copies of archived signers of the keep
+	// which are destroyed by DestroyArchive, including snapshots of the
+	// signers.
+	ArchiveEntries(keepID string) ([]*DestroyedEntry, error)
+	// DestroyArchive irrecoverably removes all copies of archived signers
+	// of the keep, including snapshots of the signers.
+	DestroyArchive(keepID string) error
 }
 
+// errArchiveManagementUnsupported is returned by storages which can archive
+// signers but can not manage archived signers.
+var errArchiveManagementUnsupported = fmt.Errorf(
+	"storage does not support management of archived signers",
+)
+
+// KeepSigner is a signer read from the storage together with the ID of its
+// keep.
+type KeepSigner struct {
+	KeepID chain.ID
+	Signer *tss.ThresholdSigner
+}
+
+// archivedKeepsFileName is the name of the file in the storage directory of
+// the disk storage holding descriptions of archived keeps.
+const archivedKeepsFileName = "archived_keeps.json"
+
+// persistentStorage stores signers in files of the persistence handle, one
+// directory for each keep. Archived signers can be managed only if
+// the storage directory of the handle is known.
 type persistentStorage struct {
-	handle persistence.Handle
+	handle     persistence.Handle
+	storageDir string
+
+	archivedKeepsMutex sync.Mutex
 }
 
-func newStorage(persistence persistence.Handle) storage {
+// NewPersistentStorage creates a storage keeping signers in files of the
+// given persistence handle. The storage does not manage archived signers.
+func NewPersistentStorage(persistence persistence.Handle) Storage {
 	return &persistentStorage{
 		handle: persistence,
 	}
 }
 
-func (ps *persistentStorage) save(
+// NewDiskStorage creates a storage keeping signers encrypted with the given
+// password in files of the given storage directory. It fails if the storage
+// directory holds a database of the bolt storage, as signers stored in
+// the database would not be read; the database has to be exported with
+// ExportBoltStorage first.
+func NewDiskStorage(storageDir string, password string) (Storage, error) {
+	databasePath := filepath.Join(storageDir, BoltDatabaseFileName)
+	if _, err := os.Stat(databasePath); err == nil {
+		return nil, fmt.Errorf(
+			"storage directory holds database [%s] of the bolt storage; "+
+				"export the database before using the disk storage",
+			databasePath,
+		)
+	}
+
+	handle, err := persistence.NewDiskHandle(storageDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &persistentStorage{
+		handle:     persistence.NewEncryptedPersistence(handle, password),
+		storageDir: storageDir,
+	}, nil
+}
+
+func (ps *persistentStorage) Save(
 	keepID chain.ID,
 	signer *tss.ThresholdSigner,
 ) error {
@@ -44,7 +127,7 @@ func (ps *persistentStorage) save(
 	)
 }
 
-func (ps *persistentStorage) snapshot(
+func (ps *persistentStorage) Snapshot(
 	keepID chain.ID,
 	signer *tss.ThresholdSigner,
 ) error {
@@ -62,15 +145,10 @@ func (ps *persistentStorage) snapshot(
 	)
 }
 
-type keepSigner struct {
-	keepID chain.ID
-	signer *tss.ThresholdSigner
-}
-
-func (ps *persistentStorage) readAll(
+func (ps *persistentStorage) ReadAll(
 	unmarshalIDFunc func(string) (chain.ID, error),
-) (<-chan *keepSigner, <-chan error) {
-	outputKeepSigner := make(chan *keepSigner)
+) (<-chan *KeepSigner, <-chan error) {
+	outputKeepSigner := make(chan *KeepSigner)
 	outputErrors := make(chan error)
 
 	inputData, inputErrors := ps.handle.ReadAll()
@@ -141,9 +219,9 @@ func (ps *persistentStorage) readAll(
 				continue
 			}
 
-			outputKeepSigner <- &keepSigner{
-				keepID: keepID,
-				signer: signer,
+			outputKeepSigner <- &KeepSigner{
+				KeepID: keepID,
+				Signer: signer,
 			}
 		}
 
@@ -153,6 +231,360 @@ func (ps *persistentStorage) readAll(
 	return outputKeepSigner, outputErrors
 }
 
-func (ps *persistentStorage) archive(keepID chain.ID) error {
-	return ps.handle.Archive(keepID.String())
+func (ps *persistentStorage) Archive(
+	keepID chain.ID,
+	reason ArchiveReason,
+) error {
+	if err := ps.handle.Archive(keepID.String()); err != nil {
+		return err
+	}
+
+	if ps.storageDir == "" {
+		return nil
+	}
+
+	return ps.updateArchivedKeeps(func(archivedKeeps map[string]*ArchivedKeep) error {
+		archivedKeeps[keepID.String()] = &ArchivedKeep{
+			KeepID:     keepID.String(),
+			Reason:     reason,
+			ArchivedAt: time.Now().UTC(),
+		}
+		return nil
+	})
+}
+
+// readArchivedKeeps reads descriptions of archived keeps mapped by keep IDs
+// from the archived keeps file of the storage directory.
+func readArchivedKeeps(storageDir string) (map[string]*ArchivedKeep, error) {
+	path := filepath.Join(storageDir, archivedKeepsFileName)
+
+	archivedKeeps := map[string]*ArchivedKeep{}
+
+	// #nosec G304 (file path provided as taint input)
+	// The path is a file of the client storage.
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return archivedKeeps, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read file [%s]: [%v]", path, err)
+	}
+
+	if err := json.Unmarshal(content, &archivedKeeps); err != nil {
+		return nil, fmt.Errorf("could not decode file [%s]: [%v]", path, err)
+	}
+
+	return archivedKeeps, nil
+}
+
+// updateArchivedKeeps applies the update to descriptions of archived keeps and
+// replaces the archived keeps file with the updated descriptions.
+func (ps *persistentStorage) updateArchivedKeeps(
+	update func(archivedKeeps map[string]*ArchivedKeep) error,
+) error {
+	ps.archivedKeepsMutex.Lock()
+	defer ps.archivedKeepsMutex.Unlock()
+
+	archivedKeeps, err := readArchivedKeeps(ps.storageDir)
+	if err != nil {
+		return err
+	}
+
+	if err := update(archivedKeeps); err != nil {
+		return err
+	}
+
+	return writeArchivedKeeps(ps.storageDir, archivedKeeps)
+}
+
+// writeArchivedKeeps replaces the archived keeps file of the storage directory
+// with the given descriptions of archived keeps.
+func writeArchivedKeeps(
+	storageDir string,
+	archivedKeeps map[string]*ArchivedKeep,
+) error {
+	content, err := json.MarshalIndent(archivedKeeps, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode archived keeps: [%v]", err)
+	}
+
+	path := filepath.Join(storageDir, archivedKeepsFileName)
+	temporaryPath := path + ".tmp"
+
+	if err := ioutil.WriteFile(temporaryPath, content, 0600); err != nil {
+		return fmt.Errorf("could not write file [%s]: [%v]", temporaryPath, err)
+	}
+
+	if err := os.Rename(temporaryPath, path); err != nil {
+		return fmt.Errorf("could not replace file [%s]: [%v]", path, err)
+	}
+
+	return nil
+}
+
+// archiveDirPath returns the path of the archive directory of the keep. It
+// fails if the keep ID can not be a name of a directory in the archive.
+func (ps *persistentStorage) archiveDirPath(keepID string) (string, error) {
+	if err := validateKeepDirName(keepID); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(ps.storageDir, "archive", keepID), nil
+}
+
+// checkArchived fails if the keep has no archive directory.
+func (ps *persistentStorage) checkArchived(keepID string) error {
+	dirPath, err := ps.archiveDirPath(keepID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(dirPath); err != nil {
+		return fmt.Errorf("keep [%s] has not been archived: [%v]", keepID, err)
+	}
+
+	return nil
+}
+
+// ArchivedKeeps returns keeps with directories in the archive directory. Keeps
+// archived before archives were described are returned with the unknown
+// reason.
+func (ps *persistentStorage) ArchivedKeeps() ([]*ArchivedKeep, error) {
+	if ps.storageDir == "" {
+		return nil, errArchiveManagementUnsupported
+	}
+
+	ps.archivedKeepsMutex.Lock()
+	defer ps.archivedKeepsMutex.Unlock()
+
+	archivedKeeps, err := readArchivedKeeps(ps.storageDir)
+	if err != nil {
+		return nil, err
+	}
+
+	archiveDir := filepath.Join(ps.storageDir, "archive")
+	entries, err := ioutil.ReadDir(archiveDir)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not read directory [%s]: [%v]",
+			archiveDir,
+			err,
+		)
+	}
+
+	result := []*ArchivedKeep{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		archivedKeep, ok := archivedKeeps[entry.Name()]
+		if !ok {
+			archivedKeep = &ArchivedKeep{KeepID: entry.Name()}
+		}
+
+		result = append(result, archivedKeep)
+	}
+
+	return result, nil
+}
+
+// ConfirmRecovery records the recovery confirmation in the description of
+// the archived keep.
+func (ps *persistentStorage) ConfirmRecovery(
+	keepID string,
+	confirmedAt time.Time,
+) error {
+	if ps.storageDir == "" {
+		return errArchiveManagementUnsupported
+	}
+
+	dirPath, err := ps.archiveDirPath(keepID)
+	if err != nil {
+		return err
+	}
+
+	if info, err := os.Stat(dirPath); err != nil || !info.IsDir() {
+		return fmt.Errorf("keep [%s] has not been archived", keepID)
+	}
+
+	return ps.updateArchivedKeeps(func(archivedKeeps map[string]*ArchivedKeep) error {
+		archivedKeep, ok := archivedKeeps[keepID]
+		if !ok {
+			archivedKeep = &ArchivedKeep{KeepID: keepID}
+			archivedKeeps[keepID] = archivedKeep
+		}
+
+		archivedKeep.RecoveryConfirmedAt = confirmedAt.UTC()
+		return nil
+	})
+}
+
+// archiveDirs are directories of the storage directory holding copies of
+// archived signers of a keep.
+var archiveDirs = []string{"archive", "snapshot"}
+
+// ArchiveEntries describes archived signer files and snapshot files of
+// the keep.
+func (ps *persistentStorage) ArchiveEntries(
+	keepID string,
+) ([]*DestroyedEntry, error) {
+	if ps.storageDir == "" {
+		return nil, errArchiveManagementUnsupported
+	}
+
+	if err := ps.checkArchived(keepID); err != nil {
+		return nil, err
+	}
+
+	paths, err := keepFiles(ps.storageDir, keepID, archiveDirs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return fileEntries(ps.storageDir, paths)
+}
+
+// DestroyArchive overwrites each archived signer file and snapshot file of
+// the keep with random bytes before removing it, then removes the archive and
+// snapshot directories of the keep and its description.
+func (ps *persistentStorage) DestroyArchive(keepID string) error {
+	if ps.storageDir == "" {
+		return errArchiveManagementUnsupported
+	}
+
+	if err := ps.checkArchived(keepID); err != nil {
+		return err
+	}
+
+	paths, err := keepFiles(ps.storageDir, keepID, archiveDirs...)
+	if err != nil {
+		return err
+	}
+
+	if err := destroyKeepFiles(
+		ps.storageDir,
+		keepID,
+		paths,
+		archiveDirs...,
+	); err != nil {
+		return err
+	}
+
+	return ps.updateArchivedKeeps(func(archivedKeeps map[string]*ArchivedKeep) error {
+		delete(archivedKeeps, keepID)
+		return nil
+	})
+}
+
+// Migrate rewrites signers stored with an outdated format version using
+// the current format version. The original content of each rewritten file is
+// stored as a snapshot before the file is overwritten. Migrated signers which
+// do not record the name of the chain get the given chain name. It returns
+// the number of migrated signers and errors of signers which could not be
+// migrated.
+func (ps *persistentStorage) Migrate(chainName string) (int, []error) {
+	migratedCount := 0
+	errors := []error{}
+	errorsMutex := &sync.Mutex{}
+
+	appendError := func(err error) {
+		errorsMutex.Lock()
+		defer errorsMutex.Unlock()
+
+		errors = append(errors, err)
+	}
+
+	inputData, inputErrors := ps.handle.ReadAll()
+
+	// Data and errors channels are read at the same time for the same reason
+	// as in ReadAll.
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		for err := range inputErrors {
+			appendError(err)
+		}
+		wg.Done()
+	}()
+
+	go func() {
+		for descriptor := range inputData {
+			migrated, err := ps.migrateFile(descriptor, chainName)
+			if err != nil {
+				appendError(fmt.Errorf(
+					"failed to migrate signer from file [%v] in directory [%v]: [%v]",
+					descriptor.Name(),
+					descriptor.Directory(),
+					err,
+				))
+				continue
+			}
+
+			if migrated {
+				migratedCount++
+			}
+		}
+		wg.Done()
+	}()
+
+	wg.Wait()
+
+	return migratedCount, errors
+}
+
+// migrateFile rewrites the signer from the given file if it is stored with
+// an outdated format version. It returns true if the file has been rewritten.
+func (ps *persistentStorage) migrateFile(
+	descriptor persistence.DataDescriptor,
+	chainName string,
+) (bool, error) {
+	content, err := descriptor.Content()
+	if err != nil {
+		return false, fmt.Errorf("failed to decode content: [%v]", err)
+	}
+
+	formatVersion, err := tss.EncodedSignerFormatVersion(content)
+	if err != nil {
+		return false, err
+	}
+
+	if formatVersion == tss.SignerFormatVersion {
+		return false, nil
+	}
+
+	signer := &tss.ThresholdSigner{}
+	if err := signer.Unmarshal(content); err != nil {
+		return false, fmt.Errorf("failed to unmarshal signer: [%v]", err)
+	}
+
+	if signer.ChainName() == "" {
+		signer.SetChainName(chainName)
+	}
+
+	signerBytes, err := signer.Marshal()
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal signer: [%v]", err)
+	}
+
+	// Keep the original content so that the signer can be restored if the
+	// migrated file turns out to be unusable.
+	if err := ps.handle.Snapshot(
+		content,
+		descriptor.Directory(),
+		descriptor.Name(),
+	); err != nil {
+		return false, fmt.Errorf("failed to make snapshot: [%v]", err)
+	}
+
+	if err := ps.handle.Save(
+		signerBytes,
+		descriptor.Directory(),
+		descriptor.Name(),
+	); err != nil {
+		return false, fmt.Errorf("failed to save migrated signer: [%v]", err)
+	}
+
+	return true, nil
 }
@@ -0,0 +1,455 @@
+// Package rekey re-encrypts key shares stored by the client with a new
+// password.
+package rekey
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-common/pkg/encryption"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+)
+
+var logger = log.Logger("keep-rekey")
+
+const (
+	// Directories of the client storage holding encrypted files, as created
+	// by the disk persistence.
+	currentDir  = "current"
+	snapshotDir = "snapshot"
+	archiveDir  = "archive"
+
+	// stagingDirName is the name of the directory in the data directory
+	// holding files re-encrypted with the new password before they replace
+	// the original files.
+	stagingDirName = ".rekey-staging"
+	// backupDirName is the name of the directory in the data directory
+	// holding the original files while they are being replaced.
+	backupDirName = ".rekey-backup"
+)
+
+// Result summarizes re-encrypted storage.
+type Result struct {
+	// StorageDirs are directories of the client storage found in the data
+	// directory, one for each chain the client has operated on.
+	StorageDirs []string
+	// FilesCount is the number of re-encrypted files.
+	FilesCount int
+	// DatabaseEntriesCount is the number of re-encrypted entries of
+	// registry databases.
+	DatabaseEntriesCount int
+}
+
+// Rekey re-encrypts all files of the client storage in the data directory,
+// including snapshots and archived keeps, with the new password. Storage
+// of every chain the client has operated on is re-encrypted, no matter if
+// the chain is still configured. Registry databases found in the storage
+// directories are re-encrypted as well.
+//
+// Files are replaced only if all of them have been decrypted with the old
+// password and re-encrypted with the new one. Re-encrypted files are written
+// to a staging directory first and then swapped with the original files one
+// by one. Databases are re-encrypted after files have been staged, each in
+// a single transaction. If re-encrypting any database or any swap fails,
+// already re-encrypted databases and swapped files are restored so that
+// the whole storage remains encrypted with the old password.
+//
+// The client must not be running while the storage is re-encrypted.
+func Rekey(dataDir string, oldPassword string, newPassword string) (*Result, error) {
+	if oldPassword == newPassword {
+		return nil, fmt.Errorf("new password is the same as the old password")
+	}
+
+	stagingDir := filepath.Join(dataDir, stagingDirName)
+	backupDir := filepath.Join(dataDir, backupDirName)
+
+	for _, dir := range []string{stagingDir, backupDir} {
+		if _, err := os.Stat(dir); !os.IsNotExist(err) {
+			return nil, fmt.Errorf(
+				"directory [%s] exists; previous rekey has been interrupted, "+
+					"inspect and remove the directory before retrying",
+				dir,
+			)
+		}
+	}
+
+	storageDirs, err := findStorageDirs(dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := listFiles(dataDir, storageDirs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := stage(
+		dataDir,
+		stagingDir,
+		files,
+		newBox(oldPassword),
+		newBox(newPassword),
+	); err != nil {
+		if removeErr := os.RemoveAll(stagingDir); removeErr != nil {
+			logger.Errorf(
+				"could not remove staging directory [%s]: [%v]",
+				stagingDir,
+				removeErr,
+			)
+		}
+		return nil, err
+	}
+
+	databaseEntriesCount, rekeyedDatabases, err := rekeyDatabases(
+		storageDirs,
+		oldPassword,
+		newPassword,
+	)
+	if err != nil {
+		if removeErr := os.RemoveAll(stagingDir); removeErr != nil {
+			logger.Errorf(
+				"could not remove staging directory [%s]: [%v]",
+				stagingDir,
+				removeErr,
+			)
+		}
+		return nil, err
+	}
+
+	if err := swap(dataDir, stagingDir, backupDir, files); err != nil {
+		if _, _, restoreErr := rekeyDatabases(
+			rekeyedDatabases,
+			newPassword,
+			oldPassword,
+		); restoreErr != nil {
+			return nil, fmt.Errorf(
+				"%v; restoring the old password of databases failed: [%v]",
+				err,
+				restoreErr,
+			)
+		}
+		return nil, err
+	}
+
+	for _, dir := range []string{stagingDir, backupDir} {
+		if err := os.RemoveAll(dir); err != nil {
+			return nil, fmt.Errorf(
+				"storage has been re-encrypted but directory [%s] "+
+					"could not be removed: [%v]",
+				dir,
+				err,
+			)
+		}
+	}
+
+	return &Result{
+		StorageDirs:          storageDirs,
+		FilesCount:           len(files),
+		DatabaseEntriesCount: databaseEntriesCount,
+	}, nil
+}
+
+// rekeyDatabases re-encrypts registry databases of the given storage
+// directories with the new password. It returns the number of re-encrypted
+// entries and storage directories of re-encrypted databases. If any database
+// could not be re-encrypted, databases which have already been re-encrypted
+// are restored to the old password.
+func rekeyDatabases(
+	storageDirs []string,
+	oldPassword string,
+	newPassword string,
+) (int, []string, error) {
+	entriesCount := 0
+	rekeyedDatabases := []string{}
+
+	for _, storageDir := range storageDirs {
+		if !hasDatabase(storageDir) {
+			continue
+		}
+
+		count, err := registry.RekeyBoltStorage(
+			storageDir,
+			oldPassword,
+			newPassword,
+		)
+		if err != nil {
+			// The database which has been re-encrypted but could not be
+			// compacted is restored as well.
+			if count > 0 {
+				rekeyedDatabases = append(rekeyedDatabases, storageDir)
+			}
+
+			if _, _, restoreErr := rekeyDatabases(
+				rekeyedDatabases,
+				newPassword,
+				oldPassword,
+			); restoreErr != nil {
+				return 0, nil, fmt.Errorf(
+					"%v; restoring the old password of databases "+
+						"failed: [%v]",
+					err,
+					restoreErr,
+				)
+			}
+			return 0, nil, err
+		}
+
+		entriesCount += count
+		rekeyedDatabases = append(rekeyedDatabases, storageDir)
+	}
+
+	return entriesCount, rekeyedDatabases, nil
+}
+
+// hasDatabase returns true if the storage directory holds a registry database.
+func hasDatabase(storageDir string) bool {
+	info, err := os.Stat(filepath.Join(storageDir, registry.BoltDatabaseFileName))
+	return err == nil && info.Mode().IsRegular()
+}
+
+// newBox creates a box encrypting files the same way the encrypted
+// persistence does.
+func newBox(password string) encryption.Box {
+	return encryption.NewBox(sha256.Sum256([]byte(password)))
+}
+
+// findStorageDirs returns storage directories in the data directory. Storage
+// of the Ethereum chain is kept directly in the data directory, storage of
+// other chains is kept in subdirectories named after the chains. A storage
+// directory holds the current directory of the disk persistence, a registry
+// database, or both.
+func findStorageDirs(dataDir string) ([]string, error) {
+	candidates := []string{dataDir}
+
+	entries, err := ioutil.ReadDir(dataDir)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not read data directory [%s]: [%v]",
+			dataDir,
+			err,
+		)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+			candidates = append(candidates, filepath.Join(dataDir, entry.Name()))
+		}
+	}
+
+	storageDirs := []string{}
+	for _, candidate := range candidates {
+		info, err := os.Stat(filepath.Join(candidate, currentDir))
+		if (err == nil && info.IsDir()) || hasDatabase(candidate) {
+			storageDirs = append(storageDirs, candidate)
+		}
+	}
+
+	return storageDirs, nil
+}
+
+// listFiles returns paths of all files in the current, snapshot and archive
+// directories of the given storage directories, relative to the data
+// directory.
+func listFiles(dataDir string, storageDirs []string) ([]string, error) {
+	files := []string{}
+
+	for _, storageDir := range storageDirs {
+		for _, dir := range []string{currentDir, snapshotDir, archiveDir} {
+			root := filepath.Join(storageDir, dir)
+			if _, err := os.Stat(root); os.IsNotExist(err) {
+				continue
+			}
+
+			err := filepath.Walk(
+				root,
+				func(path string, info os.FileInfo, err error) error {
+					if err != nil {
+						return err
+					}
+
+					if !info.Mode().IsRegular() {
+						return nil
+					}
+
+					relativePath, err := filepath.Rel(dataDir, path)
+					if err != nil {
+						return err
+					}
+
+					files = append(files, relativePath)
+					return nil
+				},
+			)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"could not list files of [%s]: [%v]",
+					root,
+					err,
+				)
+			}
+		}
+	}
+
+	return files, nil
+}
+
+// stage decrypts all files with the old box and writes them re-encrypted with
+// the new box to the staging directory.
+func stage(
+	dataDir string,
+	stagingDir string,
+	files []string,
+	oldBox encryption.Box,
+	newBox encryption.Box,
+) error {
+	for _, file := range files {
+		path := filepath.Join(dataDir, file)
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("could not read file [%s]: [%v]", path, err)
+		}
+
+		// #nosec G304 (file path provided as taint input)
+		// The path is a file of the client storage.
+		encrypted, err := ioutil.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("could not read file [%s]: [%v]", path, err)
+		}
+
+		content, err := oldBox.Decrypt(encrypted)
+		if err != nil {
+			return fmt.Errorf(
+				"could not decrypt file [%s] with the old password: [%v]",
+				path,
+				err,
+			)
+		}
+
+		reencrypted, err := newBox.Encrypt(content)
+		if err != nil {
+			return fmt.Errorf("could not encrypt file [%s]: [%v]", path, err)
+		}
+
+		stagedPath := filepath.Join(stagingDir, file)
+		if err := os.MkdirAll(filepath.Dir(stagedPath), 0700); err != nil {
+			return fmt.Errorf(
+				"could not create staging directory for file [%s]: [%v]",
+				path,
+				err,
+			)
+		}
+
+		if err := writeFile(stagedPath, reencrypted, info.Mode().Perm()); err != nil {
+			return fmt.Errorf(
+				"could not write staged file [%s]: [%v]",
+				stagedPath,
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// swap replaces original files with staged files, moving original files to
+// the backup directory. If any file could not be replaced, original files are
+// restored from the backup directory.
+func swap(
+	dataDir string,
+	stagingDir string,
+	backupDir string,
+	files []string,
+) error {
+	// Files moved to the backup directory, including the file being swapped
+	// when the swap fails.
+	backedUp := []string{}
+
+	for _, file := range files {
+		path := filepath.Join(dataDir, file)
+		backupPath := filepath.Join(backupDir, file)
+
+		err := os.MkdirAll(filepath.Dir(backupPath), 0700)
+		if err == nil {
+			err = os.Rename(path, backupPath)
+		}
+		if err == nil {
+			backedUp = append(backedUp, file)
+			err = os.Rename(filepath.Join(stagingDir, file), path)
+		}
+
+		if err != nil {
+			rollbackErr := rollback(dataDir, backupDir, backedUp)
+			if rollbackErr != nil {
+				return fmt.Errorf(
+					"could not replace file [%s]: [%v]; restoring original "+
+						"files failed: [%v]; original files remain in [%s]",
+					path,
+					err,
+					rollbackErr,
+					backupDir,
+				)
+			}
+
+			if removeErr := os.RemoveAll(stagingDir); removeErr != nil {
+				logger.Errorf(
+					"could not remove staging directory [%s]: [%v]",
+					stagingDir,
+					removeErr,
+				)
+			}
+
+			return fmt.Errorf(
+				"could not replace file [%s]: [%v]; original files "+
+					"have been restored",
+				path,
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// rollback moves the given files back from the backup directory, replacing
+// staged files which have already been moved in their place.
+func rollback(dataDir string, backupDir string, files []string) error {
+	for i := len(files) - 1; i >= 0; i-- {
+		if err := os.Rename(
+			filepath.Join(backupDir, files[i]),
+			filepath.Join(dataDir, files[i]),
+		); err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(backupDir)
+}
+
+// writeFile writes and syncs the file so that its content is on disk before
+// it replaces the original file.
+func writeFile(path string, data []byte, perm os.FileMode) error {
+	// #nosec G304 (file path provided as taint input)
+	// The path is a file in the staging directory.
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
@@ -0,0 +1,365 @@
+package rekey
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/keep-network/keep-common/pkg/persistence"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+)
+
+const (
+	oldPassword = "old-password"
+	newPassword = "new-password"
+)
+
+// storageFiles maps paths of files written by the test storage, relative to
+// the data directory, to their content.
+type storageFiles map[string]string
+
+// newTestStorage writes current, snapshot and archived files to the Ethereum
+// storage kept directly in the data directory and to the storage of another
+// chain kept in a subdirectory. It also writes an unencrypted file of another
+// client component which must not be re-encrypted.
+func newTestStorage(t *testing.T, dataDir string) storageFiles {
+	files := storageFiles{}
+
+	for _, chainDir := range []string{"", "sepolia"} {
+		storageDir := filepath.Join(dataDir, chainDir)
+		if err := os.MkdirAll(storageDir, 0700); err != nil {
+			t.Fatal(err)
+		}
+
+		diskHandle, err := persistence.NewDiskHandle(storageDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		handle := persistence.NewEncryptedPersistence(diskHandle, oldPassword)
+
+		prefix := chainDir + "-"
+
+		if err := handle.Save(
+			[]byte(prefix+"signer-1"),
+			"0x01",
+			"membership_1",
+		); err != nil {
+			t.Fatal(err)
+		}
+		files[filepath.Join(chainDir, "current", "0x01", "membership_1")] =
+			prefix + "signer-1"
+
+		if err := handle.Save(
+			[]byte(prefix+"signer-2"),
+			"0x02",
+			"membership_2",
+		); err != nil {
+			t.Fatal(err)
+		}
+		if err := handle.Archive("0x02"); err != nil {
+			t.Fatal(err)
+		}
+		files[filepath.Join(chainDir, "archive", "0x02", "membership_2")] =
+			prefix + "signer-2"
+
+		if err := handle.Snapshot(
+			[]byte(prefix+"snapshot-1"),
+			"0x01",
+			"membership_1",
+		); err != nil {
+			t.Fatal(err)
+		}
+		snapshots, err := ioutil.ReadDir(
+			filepath.Join(storageDir, "snapshot", "0x01"),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[filepath.Join(chainDir, "snapshot", "0x01", snapshots[0].Name())] =
+			prefix + "snapshot-1"
+	}
+
+	if err := os.MkdirAll(filepath.Join(dataDir, "ledger"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(
+		filepath.Join(dataDir, "ledger", "transactions.jsonl"),
+		[]byte("unencrypted"),
+		0600,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	return files
+}
+
+// newTestDatabase creates a registry database in the storage directory,
+// importing files of the storage directory encrypted with the old password.
+func newTestDatabase(t *testing.T, storageDir string) {
+	storage, err := registry.NewBoltStorage(storageDir, oldPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// assertDatabasePassword checks that all entries of the registry database in
+// the storage directory can be decrypted with the given password.
+func assertDatabasePassword(t *testing.T, storageDir string, password string) {
+	// Re-encrypting the database with the same password leaves it unchanged
+	// but decrypts all its entries.
+	if _, err := registry.RekeyBoltStorage(
+		storageDir,
+		password,
+		password,
+	); err != nil {
+		t.Errorf(
+			"could not decrypt database of [%s] with password [%s]: [%v]",
+			storageDir,
+			password,
+			err,
+		)
+	}
+}
+
+// assertStorageFiles checks that all storage files can be decrypted with
+// the given password and have the expected content.
+func assertStorageFiles(
+	t *testing.T,
+	dataDir string,
+	password string,
+	expectedFiles storageFiles,
+) {
+	box := newBox(password)
+
+	for file, expectedContent := range expectedFiles {
+		encrypted, err := ioutil.ReadFile(filepath.Join(dataDir, file))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		content, err := box.Decrypt(encrypted)
+		if err != nil {
+			t.Errorf("could not decrypt file [%s]: [%v]", file, err)
+			continue
+		}
+
+		if string(content) != expectedContent {
+			t.Errorf(
+				"unexpected content of file [%s]\nexpected: [%s]\nactual:   [%s]",
+				file,
+				expectedContent,
+				content,
+			)
+		}
+	}
+}
+
+func TestRekey(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "rekey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dataDir)
+
+	files := newTestStorage(t, dataDir)
+	newTestDatabase(t, filepath.Join(dataDir, "sepolia"))
+
+	result, err := Rekey(dataDir, oldPassword, newPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedStorageDirs := []string{dataDir, filepath.Join(dataDir, "sepolia")}
+	if !reflect.DeepEqual(expectedStorageDirs, result.StorageDirs) {
+		t.Errorf(
+			"unexpected storage directories\nexpected: [%v]\nactual:   [%v]",
+			expectedStorageDirs,
+			result.StorageDirs,
+		)
+	}
+
+	if result.FilesCount != len(files) {
+		t.Errorf(
+			"unexpected number of files\nexpected: [%v]\nactual:   [%v]",
+			len(files),
+			result.FilesCount,
+		)
+	}
+
+	// The database holds copies of the current, snapshot and archived files.
+	if result.DatabaseEntriesCount != 3 {
+		t.Errorf(
+			"unexpected number of database entries\nexpected: [%v]\nactual:   [%v]",
+			3,
+			result.DatabaseEntriesCount,
+		)
+	}
+
+	assertStorageFiles(t, dataDir, newPassword, files)
+	assertDatabasePassword(t, filepath.Join(dataDir, "sepolia"), newPassword)
+
+	ledgerContent, err := ioutil.ReadFile(
+		filepath.Join(dataDir, "ledger", "transactions.jsonl"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(ledgerContent) != "unencrypted" {
+		t.Errorf("unexpected ledger content: [%s]", ledgerContent)
+	}
+
+	for _, dir := range []string{stagingDirName, backupDirName} {
+		if _, err := os.Stat(filepath.Join(dataDir, dir)); !os.IsNotExist(err) {
+			t.Errorf("directory [%s] has not been removed", dir)
+		}
+	}
+}
+
+func TestRekey_WrongOldPassword(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "rekey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dataDir)
+
+	files := newTestStorage(t, dataDir)
+
+	_, err = Rekey(dataDir, "wrong-password", newPassword)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "with the old password") {
+		t.Errorf("unexpected error: [%v]", err)
+	}
+
+	assertStorageFiles(t, dataDir, oldPassword, files)
+
+	if _, err := os.Stat(filepath.Join(dataDir, stagingDirName)); !os.IsNotExist(err) {
+		t.Errorf("staging directory has not been removed")
+	}
+}
+
+func TestRekey_DatabaseFailure(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "rekey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dataDir)
+
+	files := newTestStorage(t, dataDir)
+	newTestDatabase(t, dataDir)
+
+	// The database of the second chain is encrypted with another password,
+	// so it fails to be re-encrypted after the first database has been.
+	sepoliaDir := filepath.Join(dataDir, "sepolia")
+	newTestDatabase(t, sepoliaDir)
+	if _, err := registry.RekeyBoltStorage(
+		sepoliaDir,
+		oldPassword,
+		"other-password",
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = Rekey(dataDir, oldPassword, newPassword)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "with the old password") {
+		t.Errorf("unexpected error: [%v]", err)
+	}
+
+	assertStorageFiles(t, dataDir, oldPassword, files)
+	assertDatabasePassword(t, dataDir, oldPassword)
+	assertDatabasePassword(t, sepoliaDir, "other-password")
+
+	if _, err := os.Stat(filepath.Join(dataDir, stagingDirName)); !os.IsNotExist(err) {
+		t.Errorf("staging directory has not been removed")
+	}
+}
+
+func TestRekey_InterruptedRekey(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "rekey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dataDir)
+
+	files := newTestStorage(t, dataDir)
+
+	if err := os.Mkdir(filepath.Join(dataDir, backupDirName), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = Rekey(dataDir, oldPassword, newPassword)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "previous rekey has been interrupted") {
+		t.Errorf("unexpected error: [%v]", err)
+	}
+
+	assertStorageFiles(t, dataDir, oldPassword, files)
+}
+
+func TestSwap_Rollback(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "rekey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dataDir)
+
+	files := newTestStorage(t, dataDir)
+
+	storageDirs, err := findStorageDirs(dataDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paths, err := listFiles(dataDir, storageDirs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(paths)
+
+	stagingDir := filepath.Join(dataDir, stagingDirName)
+	backupDir := filepath.Join(dataDir, backupDirName)
+
+	if err := stage(
+		dataDir,
+		stagingDir,
+		paths,
+		newBox(oldPassword),
+		newBox(newPassword),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	// Swap of the last file fails as its staged copy is missing.
+	if err := os.Remove(filepath.Join(stagingDir, paths[len(paths)-1])); err != nil {
+		t.Fatal(err)
+	}
+
+	err = swap(dataDir, stagingDir, backupDir, paths)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "original files have been restored") {
+		t.Errorf("unexpected error: [%v]", err)
+	}
+
+	assertStorageFiles(t, dataDir, oldPassword, files)
+
+	for _, dir := range []string{stagingDir, backupDir} {
+		if _, err := os.Stat(dir); !os.IsNotExist(err) {
+			t.Errorf("directory [%s] has not been removed", dir)
+		}
+	}
+}
@@ -0,0 +1,336 @@
+// Package reputation implements a local record of how reliably peer operators
+// take part in TSS protocols executed with the client. For each operator it
+// keeps track of protocol participations, response latency and failures, so
+// unreliable co-signers can be spotted before they put keeps at risk.
+package reputation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-common/pkg/persistence"
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/utils/fileutil"
+)
+
+var logger = log.Logger("keep-reputation")
+
+const (
+	directoryName = "reputation"
+	fileName      = "peers.json"
+)
+
+// persistDelay is the time after the first of not persisted updates the
+// reputation is persisted after. Updates are recorded for every protocol
+// message, so they are batched instead of rewriting the store file after
+// each of them.
+const persistDelay = 10 * time.Second
+
+const (
+	// unreliableFailuresThreshold is the minimum number of failures after
+	// which a peer can be considered unreliable.
+	unreliableFailuresThreshold = 3
+	// unreliableFailureRateThreshold is the minimum ratio of failures to all
+	// recorded protocol outcomes of a peer after which the peer is considered
+	// unreliable.
+	unreliableFailureRateThreshold = 0.5
+)
+
+// FailureKind determines the kind of a failure attributed to a peer.
+type FailureKind int
+
+const (
+	// AnnounceTimeout is a failure to announce presence in the announce
+	// protocol before the timeout.
+	AnnounceTimeout FailureKind = iota
+	// ReadinessTimeout is a failure to signal readiness before the timeout.
+	ReadinessTimeout
+	// ProtocolTimeout is a failure to deliver protocol messages before the
+	// key generation or signing timeout.
+	ProtocolTimeout
+	// BadMessage is a failure caused by a message the protocol identified
+	// as invalid.
+	BadMessage
+)
+
+func (fk FailureKind) String() string {
+	switch fk {
+	case AnnounceTimeout:
+		return "announce timeout"
+	case ReadinessTimeout:
+		return "readiness timeout"
+	case ProtocolTimeout:
+		return "protocol timeout"
+	case BadMessage:
+		return "bad message"
+	default:
+		return "unknown"
+	}
+}
+
+// Peer holds the reputation of a single peer operator.
+type Peer struct {
+	Operator string `json:"operator"`
+	Chain    string `json:"chain"`
+
+	// Participations is the number of successful protocol executions the
+	// peer took part in.
+	Participations uint64 `json:"participations"`
+	// Responses is the number of observed responses of the peer and
+	// TotalLatency is the sum of their latencies.
+	Responses    uint64        `json:"responses"`
+	TotalLatency time.Duration `json:"totalLatency"`
+
+	AnnounceTimeouts  uint64 `json:"announceTimeouts"`
+	ReadinessTimeouts uint64 `json:"readinessTimeouts"`
+	ProtocolTimeouts  uint64 `json:"protocolTimeouts"`
+	BadMessages       uint64 `json:"badMessages"`
+
+	// Unreachable is the number of reachability probes before a protocol
+	// the peer could not be dialed in. A peer behind NAT or a relay can not
+	// be dialed directly but can still take part in protocols, so it is not
+	// counted as a failure.
+	Unreachable uint64 `json:"unreachable"`
+
+	LastSeen    time.Time `json:"lastSeen,omitempty"`
+	LastFailure time.Time `json:"lastFailure,omitempty"`
+}
+
+// AverageLatency returns the average latency of the peer responses.
+func (p *Peer) AverageLatency() time.Duration {
+	if p.Responses == 0 {
+		return 0
+	}
+
+	return p.TotalLatency / time.Duration(p.Responses)
+}
+
+// Failures returns the number of all failures attributed to the peer.
+func (p *Peer) Failures() uint64 {
+	return p.AnnounceTimeouts +
+		p.ReadinessTimeouts +
+		p.ProtocolTimeouts +
+		p.BadMessages
+}
+
+// FailureRate returns the ratio of failures to all recorded protocol outcomes
+// of the peer.
+func (p *Peer) FailureRate() float64 {
+	failures := p.Failures()
+	if failures == 0 {
+		return 0
+	}
+
+	return float64(failures) / float64(failures+p.Participations)
+}
+
+// IsUnreliable returns true if the peer failed repeatedly and at least as
+// often as it successfully took part in protocols.
+func (p *Peer) IsUnreliable() bool {
+	return p.Failures() >= unreliableFailuresThreshold &&
+		p.FailureRate() >= unreliableFailureRateThreshold
+}
+
+// Store keeps reputation of peers in memory and persists it in a file under
+// the data directory. Updates are persisted in batches, at most persistDelay
+// after they are recorded; updates not persisted yet are lost if the client
+// stops before, unless the store is flushed.
+type Store struct {
+	filePath string
+
+	mutex            sync.RWMutex
+	peers            map[string]*Peer // chain name and operator -> peer
+	persistScheduled bool
+}
+
+// Open opens the store located in the given data directory, creating the
+// store directory if it does not exist yet, and loads the persisted reputation
+// of peers.
+func Open(dataDir string) (*Store, error) {
+	directory, err := fileutil.EnsureStoreDirectory(dataDir, directoryName)
+	if err != nil {
+		return nil, err
+	}
+
+	store := &Store{
+		filePath: filepath.Join(directory, fileName),
+		peers:    make(map[string]*Peer),
+	}
+
+	data, err := persistence.Read(store.filePath)
+	if os.IsNotExist(err) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read reputation file: [%v]", err)
+	}
+
+	peers := []*Peer{}
+	if err := json.Unmarshal(data, &peers); err != nil {
+		return nil, fmt.Errorf("could not unmarshal reputation: [%v]", err)
+	}
+
+	for _, peer := range peers {
+		store.peers[peerKey(peer.Chain, peer.Operator)] = peer
+	}
+
+	return store, nil
+}
+
+// RecordParticipation records a successful protocol execution the operator
+// took part in.
+func (s *Store) RecordParticipation(operator chain.ID) {
+	s.update(operator, func(peer *Peer) {
+		peer.Participations++
+		peer.LastSeen = time.Now().UTC()
+	})
+}
+
+// RecordResponse records a response of the operator observed with the given
+// latency.
+func (s *Store) RecordResponse(operator chain.ID, latency time.Duration) {
+	s.update(operator, func(peer *Peer) {
+		peer.Responses++
+		peer.TotalLatency += latency
+		peer.LastSeen = time.Now().UTC()
+	})
+}
+
+// RecordFailure records a failure of the given kind attributed to the
+// operator.
+func (s *Store) RecordFailure(operator chain.ID, kind FailureKind) {
+	s.update(operator, func(peer *Peer) {
+		switch kind {
+		case AnnounceTimeout:
+			peer.AnnounceTimeouts++
+		case ReadinessTimeout:
+			peer.ReadinessTimeouts++
+		case ProtocolTimeout:
+			peer.ProtocolTimeouts++
+		case BadMessage:
+			peer.BadMessages++
+		}
+		peer.LastFailure = time.Now().UTC()
+	})
+}
+
+// RecordUnreachable records that the operator could not be dialed in
+// a reachability probe.
+func (s *Store) RecordUnreachable(operator chain.ID) {
+	s.update(operator, func(peer *Peer) {
+		peer.Unreachable++
+	})
+}
+
+// Peers returns copies of reputation of all known peers, sorted by chain and
+// operator.
+func (s *Store) Peers() []Peer {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.sortedPeers()
+}
+
+// PeersCount returns the number of peers with a recorded reputation.
+func (s *Store) PeersCount() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return len(s.peers)
+}
+
+// UnreliablePeersCount returns the number of peers considered unreliable.
+func (s *Store) UnreliablePeersCount() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	count := 0
+	for _, peer := range s.peers {
+		if peer.IsUnreliable() {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (s *Store) update(operator chain.ID, updateFn func(peer *Peer)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := peerKey(operator.ChainName(), operator.String())
+
+	peer, ok := s.peers[key]
+	if !ok {
+		peer = &Peer{
+			Operator: strings.ToLower(operator.String()),
+			Chain:    strings.ToLower(operator.ChainName()),
+		}
+		s.peers[key] = peer
+	}
+
+	updateFn(peer)
+
+	if !s.persistScheduled {
+		s.persistScheduled = true
+		time.AfterFunc(persistDelay, func() {
+			if err := s.Flush(); err != nil {
+				logger.Errorf("could not persist peers reputation: [%v]", err)
+			}
+		})
+	}
+}
+
+// Flush persists reputation updates recorded so far.
+func (s *Store) Flush() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.persistScheduled = false
+
+	return s.persist()
+}
+
+// persist writes reputation of all peers to a temporary file and replaces
+// the store file with it, so the store file is never left partially written.
+// It has to be called with the mutex held.
+func (s *Store) persist() error {
+	data, err := json.MarshalIndent(s.sortedPeers(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshal reputation: [%v]", err)
+	}
+
+	tempFilePath := s.filePath + ".tmp"
+	if err := persistence.Write(tempFilePath, data); err != nil {
+		return fmt.Errorf("could not write reputation file: [%v]", err)
+	}
+
+	return os.Rename(tempFilePath, s.filePath)
+}
+
+func (s *Store) sortedPeers() []Peer {
+	peers := make([]Peer, 0, len(s.peers))
+	for _, peer := range s.peers {
+		peers = append(peers, *peer)
+	}
+
+	sort.Slice(peers, func(i, j int) bool {
+		if peers[i].Chain != peers[j].Chain {
+			return peers[i].Chain < peers[j].Chain
+		}
+		return peers[i].Operator < peers[j].Operator
+	})
+
+	return peers
+}
+
+func peerKey(chainName string, operator string) string {
+	return strings.ToLower(chainName) + "/" + strings.ToLower(operator)
+}
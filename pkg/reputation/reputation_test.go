@@ -0,0 +1,200 @@
+package reputation
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+)
+
+type testOperatorID string
+
+func (toi testOperatorID) ChainName() string {
+	return "test"
+}
+
+func (toi testOperatorID) String() string {
+	return string(toi)
+}
+
+func (toi testOperatorID) IsForChain(handle chain.Handle) bool {
+	return false
+}
+
+func TestStore_RecordAndRead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "reputation")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if store.PeersCount() != 0 {
+		t.Fatalf("expected empty store, has [%v] peers", store.PeersCount())
+	}
+
+	operatorA := testOperatorID("0xA")
+	operatorB := testOperatorID("0xB")
+
+	store.RecordResponse(operatorB, 3*time.Second)
+	store.RecordResponse(operatorB, 1*time.Second)
+	store.RecordParticipation(operatorB)
+	store.RecordUnreachable(operatorB)
+
+	store.RecordResponse(operatorA, 2*time.Second)
+	store.RecordFailure(operatorA, AnnounceTimeout)
+	store.RecordFailure(operatorA, ReadinessTimeout)
+	store.RecordFailure(operatorA, ProtocolTimeout)
+	store.RecordFailure(operatorA, BadMessage)
+
+	if err := store.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Open the store again to make sure reputation is persisted.
+	store, err = Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	peers := store.Peers()
+	if len(peers) != 2 {
+		t.Fatalf(
+			"unexpected number of peers\nexpected: [%v]\nactual:   [%v]",
+			2,
+			len(peers),
+		)
+	}
+
+	expectedPeerA := Peer{
+		Operator:          "0xa",
+		Chain:             "test",
+		Responses:         1,
+		TotalLatency:      2 * time.Second,
+		AnnounceTimeouts:  1,
+		ReadinessTimeouts: 1,
+		ProtocolTimeouts:  1,
+		BadMessages:       1,
+	}
+	expectedPeerB := Peer{
+		Operator:       "0xb",
+		Chain:          "test",
+		Participations: 1,
+		Responses:      2,
+		TotalLatency:   4 * time.Second,
+		Unreachable:    1,
+	}
+
+	for i, expectedPeer := range []Peer{expectedPeerA, expectedPeerB} {
+		peer := peers[i]
+		if peer.LastSeen.IsZero() {
+			t.Errorf("last seen time of peer [%v] is not set", peer.Operator)
+		}
+
+		// Timestamps are set by the store; the rest of fields is compared.
+		peer.LastSeen = time.Time{}
+		peer.LastFailure = time.Time{}
+
+		if !reflect.DeepEqual(expectedPeer, peer) {
+			t.Errorf(
+				"unexpected peer\nexpected: [%+v]\nactual:   [%+v]",
+				expectedPeer,
+				peer,
+			)
+		}
+	}
+
+	if peers[0].LastFailure.IsZero() {
+		t.Errorf("last failure time of peer [%v] is not set", peers[0].Operator)
+	}
+	if !peers[1].LastFailure.IsZero() {
+		t.Errorf("unexpected last failure time of peer [%v]", peers[1].Operator)
+	}
+
+	if store.UnreliablePeersCount() != 1 {
+		t.Errorf(
+			"unexpected number of unreliable peers\nexpected: [%v]\nactual:   [%v]",
+			1,
+			store.UnreliablePeersCount(),
+		)
+	}
+}
+
+func TestPeer(t *testing.T) {
+	var tests = map[string]struct {
+		peer                   Peer
+		expectedAverageLatency time.Duration
+		expectedFailureRate    float64
+		expectedUnreliable     bool
+	}{
+		"no recorded outcomes": {
+			peer: Peer{},
+		},
+		"only participations": {
+			peer: Peer{
+				Participations: 5,
+				Responses:      4,
+				TotalLatency:   10 * time.Second,
+			},
+			expectedAverageLatency: 2500 * time.Millisecond,
+		},
+		"failures below the threshold": {
+			peer: Peer{
+				ReadinessTimeouts: 2,
+			},
+			expectedFailureRate: 1,
+		},
+		"failures rate below the threshold": {
+			peer: Peer{
+				Participations:   4,
+				AnnounceTimeouts: 1,
+				ProtocolTimeouts: 2,
+			},
+			expectedFailureRate: 3.0 / 7.0,
+		},
+		"unreliable": {
+			peer: Peer{
+				Participations:   3,
+				AnnounceTimeouts: 1,
+				BadMessages:      2,
+			},
+			expectedFailureRate: 0.5,
+			expectedUnreliable:  true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			if test.peer.AverageLatency() != test.expectedAverageLatency {
+				t.Errorf(
+					"unexpected average latency\nexpected: [%v]\nactual:   [%v]",
+					test.expectedAverageLatency,
+					test.peer.AverageLatency(),
+				)
+			}
+
+			if test.peer.FailureRate() != test.expectedFailureRate {
+				t.Errorf(
+					"unexpected failure rate\nexpected: [%v]\nactual:   [%v]",
+					test.expectedFailureRate,
+					test.peer.FailureRate(),
+				)
+			}
+
+			if test.peer.IsUnreliable() != test.expectedUnreliable {
+				t.Errorf(
+					"unexpected unreliability\nexpected: [%v]\nactual:   [%v]",
+					test.expectedUnreliable,
+					test.peer.IsUnreliable(),
+				)
+			}
+		})
+	}
+}
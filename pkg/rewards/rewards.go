@@ -0,0 +1,192 @@
+// Package rewards withdraws the operator's ETH rewards held by keeps the
+// operator has been a member of.
+package rewards
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+)
+
+var logger = log.Logger("keep-rewards")
+
+// Outcome describes what has been done with the reward held by a keep.
+type Outcome string
+
+const (
+	// Withdrawn means the reward withdrawal transaction has been submitted.
+	Withdrawn Outcome = "withdrawn"
+	// WouldWithdraw means the reward would be withdrawn if it was not a dry
+	// run.
+	WouldWithdraw Outcome = "would withdraw"
+	// BelowThreshold means the reward is zero or lower than the threshold.
+	BelowThreshold Outcome = "below threshold"
+	// FeeExceedsReward means the estimated fee of the withdrawal is not
+	// lower than the reward.
+	FeeExceedsReward Outcome = "fee exceeds reward"
+)
+
+// Result describes the reward held by a single keep.
+type Result struct {
+	KeepID chain.ID
+	// Balance is the operator's reward balance held by the keep.
+	Balance *big.Int
+	// Fee is the estimated fee of the withdrawal. It is nil if the fee has
+	// not been estimated because the balance is below the threshold.
+	Fee     *big.Int
+	Outcome Outcome
+}
+
+// KeepIDs returns IDs of keeps the operator holds signers for in the registry
+// along with IDs of keeps whose signers have been archived or pruned. Rewards
+// stay in closed and terminated keeps until they are withdrawn, so archived
+// keeps are swept as well. Pruned keeps are read from the prune log, if it is
+// not nil, as withdrawing rewards does not need the keep's signer.
+func KeepIDs(
+	hostChain chain.Handle,
+	keepsRegistry *registry.Keeps,
+	pruneLog *registry.PruneLog,
+) ([]chain.ID, []error) {
+	keepIDs := keepsRegistry.GetKeepsIDs()
+
+	seen := make(map[string]bool)
+	for _, keepID := range keepIDs {
+		seen[keepID.String()] = true
+	}
+
+	var errs []error
+	addKeep := func(kind string, keepIDString string) {
+		if seen[keepIDString] {
+			return
+		}
+		seen[keepIDString] = true
+
+		keepID, err := hostChain.UnmarshalID(keepIDString)
+		if err != nil {
+			errs = append(errs, fmt.Errorf(
+				"failed to unmarshal ID of %s keep [%s]: [%v]",
+				kind,
+				keepIDString,
+				err,
+			))
+			return
+		}
+
+		keepIDs = append(keepIDs, keepID)
+	}
+
+	archivedKeeps, err := keepsRegistry.ArchivedKeeps()
+	if err != nil {
+		errs = append(
+			errs,
+			fmt.Errorf("failed to read archived keeps: [%v]", err),
+		)
+	}
+	for _, archivedKeep := range archivedKeeps {
+		addKeep("archived", archivedKeep.KeepID)
+	}
+
+	if pruneLog != nil {
+		pruneRecords, err := pruneLog.Records()
+		if err != nil {
+			errs = append(
+				errs,
+				fmt.Errorf("failed to read pruned keeps: [%v]", err),
+			)
+		}
+		for _, pruneRecord := range pruneRecords {
+			addKeep("pruned", pruneRecord.KeepID)
+		}
+	}
+
+	return keepIDs, errs
+}
+
+// Withdraw withdraws the operator's rewards held by keeps with the given IDs
+// to the operator's beneficiary. The reward of a keep is withdrawn only if
+// it is not lower than the threshold and the estimated fee of the withdrawal
+// is lower than the reward. If dryRun is set, no withdrawal is submitted.
+// Keeps which could not be checked or withdrawn from are reported as errors
+// and do not stop the sweep.
+func Withdraw(
+	hostChain chain.Handle,
+	keepIDs []chain.ID,
+	threshold *big.Int,
+	dryRun bool,
+) ([]*Result, []error) {
+	var results []*Result
+	var errs []error
+
+	for _, keepID := range keepIDs {
+		result, err := withdraw(hostChain, keepID, threshold, dryRun)
+		if err != nil {
+			errs = append(errs, fmt.Errorf(
+				"failed to withdraw reward from keep [%s]: [%v]",
+				keepID,
+				err,
+			))
+			continue
+		}
+
+		results = append(results, result)
+	}
+
+	return results, errs
+}
+
+func withdraw(
+	hostChain chain.Handle,
+	keepID chain.ID,
+	threshold *big.Int,
+	dryRun bool,
+) (*Result, error) {
+	keep, err := hostChain.GetKeepWithID(keepID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get keep: [%v]", err)
+	}
+
+	balance, err := keep.GetMemberETHBalance()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance: [%v]", err)
+	}
+
+	result := &Result{KeepID: keepID, Balance: balance}
+
+	if balance.Sign() == 0 || balance.Cmp(threshold) < 0 {
+		result.Outcome = BelowThreshold
+		return result, nil
+	}
+
+	fee, err := keep.WithdrawETHRewardFeeEstimate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate fee: [%v]", err)
+	}
+	result.Fee = fee
+
+	if fee.Cmp(balance) >= 0 {
+		logger.Debugf(
+			"not withdrawing reward [%v] from keep [%s]; "+
+				"estimated fee [%v] exceeds the reward",
+			balance,
+			keepID,
+			fee,
+		)
+		result.Outcome = FeeExceedsReward
+		return result, nil
+	}
+
+	if dryRun {
+		result.Outcome = WouldWithdraw
+		return result, nil
+	}
+
+	if err := keep.WithdrawETHReward(); err != nil {
+		return nil, err
+	}
+	result.Outcome = Withdrawn
+
+	return result, nil
+}
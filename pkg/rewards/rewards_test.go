@@ -0,0 +1,207 @@
+package rewards
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/keep-network/keep-ecdsa/pkg/chain"
+	"github.com/keep-network/keep-ecdsa/pkg/chain/local"
+	"github.com/keep-network/keep-ecdsa/pkg/registry"
+)
+
+func TestWithdraw(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	localChain := local.Connect(ctx)
+
+	threshold := big.NewInt(local.WithdrawETHRewardFee / 2)
+
+	var tests = map[string]struct {
+		balance         int64
+		expectedOutcome Outcome
+	}{
+		"zero balance": {
+			balance:         0,
+			expectedOutcome: BelowThreshold,
+		},
+		"balance below threshold": {
+			balance:         threshold.Int64() - 1,
+			expectedOutcome: BelowThreshold,
+		},
+		"fee equal to balance": {
+			balance:         local.WithdrawETHRewardFee,
+			expectedOutcome: FeeExceedsReward,
+		},
+		"fee lower than balance": {
+			balance:         local.WithdrawETHRewardFee + 1,
+			expectedOutcome: Withdrawn,
+		},
+	}
+
+	keepIndex := int64(0)
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			keepIndex++
+			keepAddress := common.BigToAddress(big.NewInt(keepIndex))
+
+			keep := localChain.OpenKeep(
+				keepAddress,
+				common.Address{},
+				[]common.Address{localChain.OperatorAddress()},
+			)
+
+			if test.balance > 0 {
+				err := localChain.DistributeETHReward(
+					keepAddress,
+					big.NewInt(test.balance),
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			results, errs := Withdraw(
+				localChain,
+				[]chain.ID{keep.ID()},
+				threshold,
+				false,
+			)
+			if len(errs) != 0 {
+				t.Fatalf("unexpected errors: [%v]", errs)
+			}
+
+			if results[0].Outcome != test.expectedOutcome {
+				t.Errorf(
+					"unexpected outcome\nexpected: [%v]\nactual:   [%v]",
+					test.expectedOutcome,
+					results[0].Outcome,
+				)
+			}
+
+			balance, err := keep.GetMemberETHBalance()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expectedBalance := big.NewInt(test.balance)
+			if test.expectedOutcome == Withdrawn {
+				expectedBalance = big.NewInt(0)
+			}
+			if balance.Cmp(expectedBalance) != 0 {
+				t.Errorf(
+					"unexpected balance\nexpected: [%v]\nactual:   [%v]",
+					expectedBalance,
+					balance,
+				)
+			}
+		})
+	}
+}
+
+func TestWithdrawDryRun(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	localChain := local.Connect(ctx)
+
+	keepAddress := common.BigToAddress(big.NewInt(1))
+	keep := localChain.OpenKeep(
+		keepAddress,
+		common.Address{},
+		[]common.Address{localChain.OperatorAddress()},
+	)
+
+	balance := big.NewInt(2 * local.WithdrawETHRewardFee)
+	if err := localChain.DistributeETHReward(keepAddress, balance); err != nil {
+		t.Fatal(err)
+	}
+
+	results, errs := Withdraw(
+		localChain,
+		[]chain.ID{keep.ID()},
+		big.NewInt(0),
+		true,
+	)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: [%v]", errs)
+	}
+
+	if results[0].Outcome != WouldWithdraw {
+		t.Errorf(
+			"unexpected outcome\nexpected: [%v]\nactual:   [%v]",
+			WouldWithdraw,
+			results[0].Outcome,
+		)
+	}
+
+	currentBalance, err := keep.GetMemberETHBalance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if currentBalance.Cmp(balance) != 0 {
+		t.Errorf(
+			"unexpected balance\nexpected: [%v]\nactual:   [%v]",
+			balance,
+			currentBalance,
+		)
+	}
+}
+
+func TestKeepIDsIncludesPrunedKeeps(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	localChain := local.Connect(ctx)
+
+	storageDir := t.TempDir()
+
+	storage, err := registry.NewDiskStorage(storageDir, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keepsRegistry := registry.NewKeepsRegistryWithStorage(
+		storage,
+		localChain.UnmarshalID,
+	)
+
+	pruneLog, err := registry.OpenPruneLog(storageDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keep := localChain.OpenKeep(
+		common.BigToAddress(big.NewInt(1)),
+		common.Address{},
+		[]common.Address{localChain.OperatorAddress()},
+	)
+	for i := 0; i < 2; i++ {
+		if err := pruneLog.Append(&registry.PruneRecord{
+			KeepID: keep.ID().String(),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	keepIDs, errs := KeepIDs(localChain, keepsRegistry, nil)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: [%v]", errs)
+	}
+	if len(keepIDs) != 0 {
+		t.Errorf("unexpected keeps without prune log: [%v]", keepIDs)
+	}
+
+	keepIDs, errs = KeepIDs(localChain, keepsRegistry, pruneLog)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: [%v]", errs)
+	}
+	if len(keepIDs) != 1 || keepIDs[0].String() != keep.ID().String() {
+		t.Errorf(
+			"unexpected keeps\nexpected: [%v]\nactual:   [%v]",
+			[]chain.ID{keep.ID()},
+			keepIDs,
+		)
+	}
+}
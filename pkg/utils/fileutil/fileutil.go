@@ -0,0 +1,39 @@
+// Package fileutil provides helper utilities for stores keeping their data in
+// files under the client's data directory.
+package fileutil
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-common/pkg/persistence"
+)
+
+var logger = log.Logger("keep-fileutil")
+
+// EnsureStoreDirectory checks whether the client can use the data directory
+// and creates the store directory with the given name in it if it does not
+// exist yet. It returns the path of the store directory.
+func EnsureStoreDirectory(dataDir string, directoryName string) (string, error) {
+	err := persistence.CheckStoragePermission(dataDir)
+	if err != nil {
+		return "", err
+	}
+
+	err = persistence.EnsureDirectoryExists(dataDir, directoryName)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dataDir, directoryName), nil
+}
+
+// CloseFile closes the file and logs the error if the file could not be
+// closed. It is meant to be deferred after opening a file.
+func CloseFile(file *os.File) {
+	err := file.Close()
+	if err != nil {
+		logger.Errorf("could not close file [%v]: [%v]", file.Name(), err)
+	}
+}
@@ -0,0 +1,267 @@
+// Package hashchain implements append-only files of JSON-encoded records,
+// one record per line. Each record holds the hash of the previous record, so
+// removing or modifying any record breaks the chain of hashes of all records
+// following it.
+package hashchain
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"syscall"
+
+	"github.com/keep-network/keep-ecdsa/pkg/utils/fileutil"
+)
+
+// Link holds hashes chaining a record to the previous record of the file. It
+// is meant to be embedded as the last field of records, so that the hashes
+// are encoded after the content of the record.
+type Link struct {
+	PreviousHash string `json:"previousHash"`
+	Hash         string `json:"hash"`
+}
+
+// ChainLink returns the link of the record embedding it.
+func (l *Link) ChainLink() *Link {
+	return l
+}
+
+// Record is a record of a hash chain file.
+type Record interface {
+	ChainLink() *Link
+}
+
+// computeHash returns the hex-encoded SHA-256 digest of the record encoded
+// without its hash.
+func computeHash(record Record) (string, error) {
+	link := record.ChainLink()
+
+	hash := link.Hash
+	link.Hash = ""
+	encoded, err := json.Marshal(record)
+	link.Hash = hash
+
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha256.Sum256(encoded)
+	return hex.EncodeToString(digest[:]), nil
+}
+
+// File is a hash chain file. The file may be appended by several processes;
+// the file is locked while a record is appended.
+type File struct {
+	path  string
+	mutex sync.Mutex
+
+	// lastHash is the hash of the last record of the file when the file had
+	// lastHashSize bytes. The file is scanned for the last hash only if its
+	// size changed, which happens when another process appended a record.
+	lastHash     string
+	lastHashSize int64
+}
+
+// NewFile creates a hash chain kept in the file of the given path. The file
+// is created when the first record is appended.
+func NewFile(path string) *File {
+	return &File{path: path}
+}
+
+// Append chains the record to the last record of the file and writes it to
+// the file. The file is exclusively locked from reading the last record
+// until the record is written, so records appended at the same time by other
+// processes are chained as well.
+func (f *File) Append(record Record) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	file, err := os.OpenFile(
+		f.path,
+		os.O_APPEND|os.O_CREATE|os.O_WRONLY,
+		0600,
+	)
+	if err != nil {
+		return fmt.Errorf("could not open file [%s]: [%v]", f.path, err)
+	}
+	// Closing the file releases the lock.
+	defer fileutil.CloseFile(file)
+
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX); err != nil {
+		return fmt.Errorf("could not lock file [%s]: [%v]", f.path, err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("could not read file [%s]: [%v]", f.path, err)
+	}
+
+	if info.Size() != f.lastHashSize {
+		lastHash, err := f.scanLastHash()
+		if err != nil {
+			return err
+		}
+
+		f.lastHash = lastHash
+		f.lastHashSize = info.Size()
+	}
+
+	link := record.ChainLink()
+	link.PreviousHash = f.lastHash
+
+	hash, err := computeHash(record)
+	if err != nil {
+		return fmt.Errorf("could not compute record hash: [%v]", err)
+	}
+	link.Hash = hash
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("could not encode record: [%v]", err)
+	}
+	line = append(line, '\n')
+
+	if _, err := file.Write(line); err != nil {
+		// The record may have been written partially, so the file is
+		// scanned again on the next append.
+		f.lastHashSize = -1
+		return fmt.Errorf("could not write record: [%v]", err)
+	}
+
+	f.lastHash = hash
+	f.lastHashSize = info.Size() + int64(len(line))
+
+	return file.Sync()
+}
+
+// scanLastHash returns the hash of the last record of the file or an empty
+// string if the file has no records yet.
+func (f *File) scanLastHash() (string, error) {
+	lastHash := ""
+
+	err := f.scan(
+		func() Record { return &Link{} },
+		func(line int, record Record) error {
+			lastHash = record.ChainLink().Hash
+			return nil
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return lastHash, nil
+}
+
+// Records reads and verifies all records of the file, in the order they were
+// appended. Each record is decoded to a new record returned by the given
+// function. It fails if the chain of hashes of the records is broken. The
+// file is locked with a shared lock while it is read, so records being
+// appended by other processes are not read partially.
+func (f *File) Records(newRecord func() Record) ([]Record, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	records := []Record{}
+
+	// #nosec G304 (file path provided as taint input)
+	// The path is a file of the client data directory.
+	file, err := os.Open(f.path)
+	if os.IsNotExist(err) {
+		return records, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not open file [%s]: [%v]", f.path, err)
+	}
+	// Closing the file releases the lock.
+	defer fileutil.CloseFile(file)
+
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_SH); err != nil {
+		return nil, fmt.Errorf("could not lock file [%s]: [%v]", f.path, err)
+	}
+
+	previousHash := ""
+
+	err = f.scanFile(file, newRecord, func(line int, record Record) error {
+		hash, err := computeHash(record)
+		if err != nil {
+			return err
+		}
+
+		link := record.ChainLink()
+		if link.PreviousHash != previousHash || link.Hash != hash {
+			return fmt.Errorf(
+				"file [%s] has been tampered with; "+
+					"chain of hashes is broken at line [%d]",
+				f.path,
+				line,
+			)
+		}
+
+		records = append(records, record)
+		previousHash = link.Hash
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// scan decodes records of the file line by line, passing them to the
+// handler. Lines are numbered from one. A file that does not exist yet has no
+// records. The file is not locked; the caller is expected to hold the lock.
+func (f *File) scan(
+	newRecord func() Record,
+	handle func(line int, record Record) error,
+) error {
+	// #nosec G304 (file path provided as taint input)
+	// The path is a file of the client data directory.
+	file, err := os.Open(f.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not open file [%s]: [%v]", f.path, err)
+	}
+	defer fileutil.CloseFile(file)
+
+	return f.scanFile(file, newRecord, handle)
+}
+
+// scanFile decodes records of the opened file line by line, passing them to
+// the handler. Lines are numbered from one.
+func (f *File) scanFile(
+	file *os.File,
+	newRecord func() Record,
+	handle func(line int, record Record) error,
+) error {
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		record := newRecord()
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return fmt.Errorf(
+				"could not decode record at line [%d] of file [%s]: [%v]",
+				line,
+				f.path,
+				err,
+			)
+		}
+
+		if err := handle(line, record); err != nil {
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("could not read file [%s]: [%v]", f.path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,308 @@
+package hashchain
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+type testRecord struct {
+	Value string `json:"value"`
+	Link
+}
+
+func newTestRecord() Record {
+	return &testRecord{}
+}
+
+func newTestFile(t *testing.T) (*File, string) {
+	dir, err := ioutil.TempDir("", "hashchain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, "records.jsonl")
+
+	return NewFile(path), path
+}
+
+func appendTestRecords(t *testing.T, file *File, values ...string) {
+	for _, value := range values {
+		if err := file.Append(&testRecord{Value: value}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func readLines(t *testing.T, path string) [][]byte {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return bytes.SplitAfter(content, []byte("\n"))
+}
+
+func writeLines(t *testing.T, path string, lines [][]byte) {
+	if err := ioutil.WriteFile(path, bytes.Join(lines, nil), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFile_Records(t *testing.T) {
+	file, _ := newTestFile(t)
+
+	records, err := file.Records(newTestRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 0 {
+		t.Fatalf("expected no records, has [%v]", len(records))
+	}
+
+	appendTestRecords(t, file, "a", "b", "c")
+
+	// Records appended through another handle, like the one of another
+	// process, are chained as well.
+	appendTestRecords(t, NewFile(file.path), "d")
+	appendTestRecords(t, file, "e")
+
+	records, err = file.Records(newTestRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedValues := []string{"a", "b", "c", "d", "e"}
+	if len(records) != len(expectedValues) {
+		t.Fatalf(
+			"unexpected number of records\nexpected: [%v]\nactual:   [%v]",
+			len(expectedValues),
+			len(records),
+		)
+	}
+
+	previousHash := ""
+	for i, record := range records {
+		testRecord := record.(*testRecord)
+		if testRecord.Value != expectedValues[i] {
+			t.Errorf(
+				"unexpected value of record [%v]\nexpected: [%v]\nactual:   [%v]",
+				i,
+				expectedValues[i],
+				testRecord.Value,
+			)
+		}
+		if testRecord.PreviousHash != previousHash {
+			t.Errorf(
+				"unexpected previous hash of record [%v]\n"+
+					"expected: [%v]\nactual:   [%v]",
+				i,
+				previousHash,
+				testRecord.PreviousHash,
+			)
+		}
+		previousHash = testRecord.Hash
+	}
+}
+
+func TestFile_Records_Tampered(t *testing.T) {
+	var tests = map[string]struct {
+		tamper        func(lines [][]byte) [][]byte
+		expectedError string
+	}{
+		"modified record": {
+			tamper: func(lines [][]byte) [][]byte {
+				lines[1] = bytes.Replace(
+					lines[1],
+					[]byte(`"value":"b"`),
+					[]byte(`"value":"x"`),
+					1,
+				)
+				return lines
+			},
+			expectedError: "chain of hashes is broken at line [2]",
+		},
+		"removed record": {
+			tamper: func(lines [][]byte) [][]byte {
+				return append(lines[:1], lines[2:]...)
+			},
+			expectedError: "chain of hashes is broken at line [2]",
+		},
+		"truncated tail": {
+			tamper: func(lines [][]byte) [][]byte {
+				lastLine := len(lines) - 2
+				lines[lastLine] = lines[lastLine][:len(lines[lastLine])/2]
+				return lines
+			},
+			expectedError: "could not decode record at line [3]",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			file, path := newTestFile(t)
+
+			appendTestRecords(t, file, "a", "b", "c")
+
+			writeLines(t, path, test.tamper(readLines(t, path)))
+
+			_, err := file.Records(newTestRecord)
+			if err == nil || !strings.Contains(err.Error(), test.expectedError) {
+				t.Fatalf(
+					"unexpected error\nexpected: [%v]\nactual:   [%v]",
+					test.expectedError,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func TestFile_Records_WaitsForAppend(t *testing.T) {
+	file, path := newTestFile(t)
+
+	appendTestRecords(t, file, "a", "b")
+
+	lines := readLines(t, path)
+	writeLines(t, path, lines[:1])
+
+	// Write the second record partially while holding the lock, like
+	// another process appending the record does.
+	appendingFile, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer appendingFile.Close()
+
+	if err := syscall.Flock(
+		int(appendingFile.Fd()),
+		syscall.LOCK_EX,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	half := len(lines[1]) / 2
+	if _, err := appendingFile.Write(lines[1][:half]); err != nil {
+		t.Fatal(err)
+	}
+
+	type result struct {
+		records []Record
+		err     error
+	}
+	resultChan := make(chan result, 1)
+	go func() {
+		records, err := file.Records(newTestRecord)
+		resultChan <- result{records, err}
+	}()
+
+	select {
+	case result := <-resultChan:
+		t.Fatalf(
+			"records read while the file is locked: [%v], [%v]",
+			len(result.records),
+			result.err,
+		)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	if _, err := appendingFile.Write(lines[1][half:]); err != nil {
+		t.Fatal(err)
+	}
+	if err := syscall.Flock(
+		int(appendingFile.Fd()),
+		syscall.LOCK_UN,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case result := <-resultChan:
+		if result.err != nil {
+			t.Fatal(result.err)
+		}
+		if len(result.records) != 2 {
+			t.Fatalf(
+				"unexpected number of records\nexpected: [2]\nactual:   [%v]",
+				len(result.records),
+			)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("records not read after the file has been unlocked")
+	}
+}
+
+func TestFile_ConcurrentAppends(t *testing.T) {
+	file, path := newTestFile(t)
+
+	const handlesCount = 4
+	const recordsCount = 25
+
+	// Each handle stands for another process appending to the same file, so
+	// the appends are serialized only by the file lock. The file is read at
+	// the same time to ensure no record is read partially.
+	var wg sync.WaitGroup
+	errs := make(chan error, handlesCount*recordsCount*2)
+	for i := 0; i < handlesCount; i++ {
+		wg.Add(2)
+
+		go func(handle *File, i int) {
+			defer wg.Done()
+			for j := 0; j < recordsCount; j++ {
+				value := fmt.Sprintf("%v-%v", i, j)
+				if err := handle.Append(&testRecord{Value: value}); err != nil {
+					errs <- err
+				}
+			}
+		}(NewFile(path), i)
+
+		go func(handle *File) {
+			defer wg.Done()
+			for j := 0; j < recordsCount; j++ {
+				if _, err := handle.Records(newTestRecord); err != nil {
+					errs <- err
+				}
+			}
+		}(NewFile(path))
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+
+	records, err := file.Records(newTestRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(records) != handlesCount*recordsCount {
+		t.Fatalf(
+			"unexpected number of records\nexpected: [%v]\nactual:   [%v]",
+			handlesCount*recordsCount,
+			len(records),
+		)
+	}
+
+	values := map[string]bool{}
+	for _, record := range records {
+		values[record.(*testRecord).Value] = true
+	}
+	if len(values) != handlesCount*recordsCount {
+		t.Fatalf(
+			"unexpected number of distinct records\n"+
+				"expected: [%v]\nactual:   [%v]",
+			handlesCount*recordsCount,
+			len(values),
+		)
+	}
+}